@@ -1,9 +1,16 @@
+import { useEffect, useState } from 'react'
 import PracticeSession from './components/PracticeSession'
+import LoginForm from './components/LoginForm'
+import { getToken, installAuthInterceptors } from './auth'
 
 function App() {
+  const [signedIn, setSignedIn] = useState(() => getToken() !== null)
+
+  useEffect(() => installAuthInterceptors(() => setSignedIn(false)), [])
+
   return (
     <div className="min-h-screen bg-gray-100">
-      <PracticeSession />
+      {signedIn ? <PracticeSession /> : <LoginForm onLogin={() => setSignedIn(true)} />}
     </div>
   )
 }
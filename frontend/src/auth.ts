@@ -0,0 +1,47 @@
+import axios from 'axios';
+
+// Tokens are issued by the BFF; the practice API identifies the user from them
+const AUTH_URL = import.meta.env.VITE_AUTH_URL || 'http://localhost:8081';
+const TOKEN_KEY = 'access_token';
+
+export const getToken = (): string | null => localStorage.getItem(TOKEN_KEY);
+
+export const clearToken = () => localStorage.removeItem(TOKEN_KEY);
+
+export const login = async (email: string, password: string): Promise<string> => {
+  const baseUrl = AUTH_URL.endsWith('/') ? AUTH_URL.slice(0, -1) : AUTH_URL;
+  const res = await axios.post(`${baseUrl}/api/v1/auth/login`, { email, password });
+  const token: string = res.data.access_token;
+  localStorage.setItem(TOKEN_KEY, token);
+  return token;
+};
+
+export const register = async (email: string, username: string, password: string) => {
+  const baseUrl = AUTH_URL.endsWith('/') ? AUTH_URL.slice(0, -1) : AUTH_URL;
+  await axios.post(`${baseUrl}/api/v1/auth/register`, { email, username, password });
+};
+
+// Attach the token to every request, and drop it once the API rejects it
+export const installAuthInterceptors = (onUnauthorized: () => void) => {
+  const request = axios.interceptors.request.use((config) => {
+    const token = getToken();
+    if (token) {
+      config.headers.Authorization = `Bearer ${token}`;
+    }
+    return config;
+  });
+  const response = axios.interceptors.response.use(
+    (res) => res,
+    (err) => {
+      if (err.response?.status === 401 && getToken()) {
+        clearToken();
+        onUnauthorized();
+      }
+      return Promise.reject(err);
+    }
+  );
+  return () => {
+    axios.interceptors.request.eject(request);
+    axios.interceptors.response.eject(response);
+  };
+};
@@ -0,0 +1,85 @@
+import React, { useState } from 'react';
+import { login, register } from '../auth';
+
+interface LoginFormProps {
+  onLogin: () => void;
+}
+
+const LoginForm: React.FC<LoginFormProps> = ({ onLogin }) => {
+  const [mode, setMode] = useState<'login' | 'register'>('login');
+  const [email, setEmail] = useState('');
+  const [username, setUsername] = useState('');
+  const [password, setPassword] = useState('');
+  const [error, setError] = useState<string | null>(null);
+  const [loading, setLoading] = useState(false);
+
+  const submit = async (e: React.FormEvent) => {
+    e.preventDefault();
+    setError(null);
+    setLoading(true);
+    try {
+      if (mode === 'register') {
+        await register(email, username, password);
+      }
+      await login(email, password);
+      onLogin();
+    } catch (err: any) {
+      setError(err.response?.data?.error || 'Sign in failed');
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  return (
+    <div className="max-w-sm mx-auto pt-24 px-4">
+      <form onSubmit={submit} className="bg-white rounded-xl shadow p-6 space-y-4">
+        <h1 className="text-2xl font-bold text-gray-800">
+          {mode === 'login' ? 'Sign in' : 'Create account'}
+        </h1>
+        <input
+          type="email"
+          required
+          placeholder="Email"
+          value={email}
+          onChange={(e) => setEmail(e.target.value)}
+          className="w-full border rounded-lg px-3 py-2"
+        />
+        {mode === 'register' && (
+          <input
+            required
+            minLength={3}
+            placeholder="Username"
+            value={username}
+            onChange={(e) => setUsername(e.target.value)}
+            className="w-full border rounded-lg px-3 py-2"
+          />
+        )}
+        <input
+          type="password"
+          required
+          placeholder="Password"
+          value={password}
+          onChange={(e) => setPassword(e.target.value)}
+          className="w-full border rounded-lg px-3 py-2"
+        />
+        {error && <p className="text-sm text-red-600">{error}</p>}
+        <button
+          type="submit"
+          disabled={loading}
+          className="w-full bg-blue-600 text-white rounded-lg py-2 font-semibold disabled:opacity-50"
+        >
+          {mode === 'login' ? 'Sign in' : 'Register'}
+        </button>
+        <button
+          type="button"
+          onClick={() => setMode(mode === 'login' ? 'register' : 'login')}
+          className="w-full text-sm text-blue-600"
+        >
+          {mode === 'login' ? 'No account? Register' : 'Have an account? Sign in'}
+        </button>
+      </form>
+    </div>
+  );
+};
+
+export default LoginForm;
@@ -359,10 +359,8 @@ export default function PracticeSession() {
     setTotalQuestions(questionCount);
 
     try {
-      // Use a fixed user ID for demo purposes
-      const userId = '123e4567-e89b-12d3-a456-426614174000';
+      // The session belongs to the signed-in user named by the access token
       const response = await axios.post(getApiUrl('/sessions'), {
-        user_id: userId,
         topic_id: topicName, // Backend handles string topic lookup if needed or ID
         level: levelName,
         language: language,
@@ -447,7 +445,8 @@ export default function PracticeSession() {
     try {
       const res = await axios.post(getApiUrl(`/questions/${question.id}/suggest`), {
         content: answer,
-        language: language
+        language: language,
+        level: session?.level || selectedLevel
       });
       setAiSuggestion(res.data);
     } catch (err: any) {
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_practice_attempts_parent_attempt_id;
+ALTER TABLE practice_attempts DROP COLUMN IF EXISTS follow_up_id;
+ALTER TABLE practice_attempts DROP COLUMN IF EXISTS parent_attempt_id;
+
+DROP TABLE IF EXISTS practice_follow_ups;
@@ -0,0 +1,16 @@
+CREATE TABLE practice_follow_ups (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    session_id UUID NOT NULL REFERENCES practice_sessions(id) ON DELETE CASCADE,
+    parent_attempt_id UUID NOT NULL REFERENCES practice_attempts(id) ON DELETE CASCADE,
+    question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_practice_follow_ups_session_id ON practice_follow_ups(session_id);
+CREATE INDEX idx_practice_follow_ups_parent_attempt_id ON practice_follow_ups(parent_attempt_id);
+
+ALTER TABLE practice_attempts ADD COLUMN parent_attempt_id UUID REFERENCES practice_attempts(id) ON DELETE CASCADE;
+ALTER TABLE practice_attempts ADD COLUMN follow_up_id UUID REFERENCES practice_follow_ups(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_practice_attempts_parent_attempt_id ON practice_attempts(parent_attempt_id);
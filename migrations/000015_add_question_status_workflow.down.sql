@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_questions_status;
+
+ALTER TABLE questions DROP CONSTRAINT IF EXISTS chk_questions_status;
+ALTER TABLE questions ALTER COLUMN status DROP NOT NULL;
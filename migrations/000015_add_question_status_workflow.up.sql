@@ -0,0 +1,6 @@
+UPDATE questions SET status = 'published' WHERE status IS NULL OR status NOT IN ('draft', 'review', 'published', 'archived');
+
+ALTER TABLE questions ALTER COLUMN status SET NOT NULL;
+ALTER TABLE questions ADD CONSTRAINT chk_questions_status CHECK (status IN ('draft', 'review', 'published', 'archived'));
+
+CREATE INDEX idx_questions_status ON questions(status);
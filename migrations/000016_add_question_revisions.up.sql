@@ -0,0 +1,15 @@
+CREATE TABLE question_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    revision_number INT NOT NULL,
+    title VARCHAR(255),
+    content TEXT NOT NULL,
+    level VARCHAR(50),
+    hint TEXT,
+    correct_answer TEXT,
+    author_id UUID REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (question_id, revision_number)
+);
+
+CREATE INDEX idx_question_revisions_question_id ON question_revisions(question_id);
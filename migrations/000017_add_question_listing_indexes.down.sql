@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_questions_created_by;
+DROP INDEX IF EXISTS idx_questions_updated_at_id;
+DROP INDEX IF EXISTS idx_questions_created_at_id;
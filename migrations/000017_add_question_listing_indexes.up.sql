@@ -0,0 +1,4 @@
+-- Keyset pagination orders by (sort column, id)
+CREATE INDEX idx_questions_created_at_id ON questions(created_at, id);
+CREATE INDEX idx_questions_updated_at_id ON questions(updated_at, id);
+CREATE INDEX idx_questions_created_by ON questions(created_by);
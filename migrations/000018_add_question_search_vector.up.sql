@@ -0,0 +1,25 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent() is only STABLE; generated columns need an IMMUTABLE wrapper with a fixed dictionary
+CREATE OR REPLACE FUNCTION immutable_unaccent(text) RETURNS text AS $$
+    SELECT public.unaccent('public.unaccent', $1)
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- English rows use the stemming 'english' config. Other languages (vi) have no Postgres dictionary,
+-- so they use 'simple' tokens both as written and without diacritics, so "bo nho dem" finds "bộ nhớ đệm".
+ALTER TABLE questions ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    CASE WHEN language = 'en' THEN
+        setweight(to_tsvector('english', COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(content, '')), 'B') ||
+        setweight(to_tsvector('english', COALESCE(correct_answer, '')), 'C')
+    ELSE
+        setweight(to_tsvector('simple', COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector('simple', immutable_unaccent(COALESCE(title, ''))), 'A') ||
+        setweight(to_tsvector('simple', COALESCE(content, '')), 'B') ||
+        setweight(to_tsvector('simple', immutable_unaccent(COALESCE(content, ''))), 'B') ||
+        setweight(to_tsvector('simple', COALESCE(correct_answer, '')), 'C') ||
+        setweight(to_tsvector('simple', immutable_unaccent(COALESCE(correct_answer, ''))), 'C')
+    END
+) STORED;
+
+CREATE INDEX idx_questions_search_vector ON questions USING GIN (search_vector);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_questions_content_trgm;
+DROP INDEX IF EXISTS idx_questions_document_trgm;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,6 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Near-duplicate detection compares lower-cased title + content (question-service)
+-- and lower-cased content alone (practice-service, whose questions have no real title)
+CREATE INDEX idx_questions_document_trgm ON questions USING GIN ((lower(COALESCE(title, '') || ' ' || content)) gin_trgm_ops);
+CREATE INDEX idx_questions_content_trgm ON questions USING GIN ((lower(content)) gin_trgm_ops);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_topics_aliases;
+ALTER TABLE topics DROP COLUMN IF EXISTS aliases;
+DROP INDEX IF EXISTS idx_topics_slug;
+ALTER TABLE topics DROP COLUMN IF EXISTS slug;
+DROP INDEX IF EXISTS idx_topics_parent_id;
+ALTER TABLE topics DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,19 @@
+-- Topics form a tree: selecting a parent topic also selects its descendants
+ALTER TABLE topics ADD COLUMN parent_id UUID REFERENCES topics(id) ON DELETE SET NULL;
+CREATE INDEX idx_topics_parent_id ON topics(parent_id);
+
+-- URL-safe name, kept in sync with the name ("System Design" → "system-design", "C#" → "c-sharp")
+ALTER TABLE topics ADD COLUMN slug VARCHAR(255) GENERATED ALWAYS AS (
+    trim(BOTH '-' FROM regexp_replace(lower(replace(replace(name, '+', ' plus '), '#', ' sharp ')), '[^[:alnum:]]+', '-', 'g'))
+) STORED;
+CREATE UNIQUE INDEX idx_topics_slug ON topics(slug);
+
+-- Lower-cased alternative names used to resolve stack names and user input ("go" → Golang)
+ALTER TABLE topics ADD COLUMN aliases TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX idx_topics_aliases ON topics USING GIN (aliases);
+
+-- Aliases that used to be hard-coded in practice-service's GetRandomQuestionID
+UPDATE topics SET aliases = ARRAY['go'] WHERE name = 'Golang';
+UPDATE topics SET aliases = ARRAY['node.js', 'node'] WHERE name = 'NodeJS';
+UPDATE topics SET aliases = ARRAY['postgresql', 'mongodb', 'redis'] WHERE name = 'Data Layer';
+UPDATE topics SET aliases = ARRAY['typescript'] WHERE name = 'JavaScript';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_questions_external_id;
+ALTER TABLE questions DROP COLUMN IF EXISTS external_id;
@@ -0,0 +1,3 @@
+-- Stable identifier from an import source, used to upsert questions on re-import
+ALTER TABLE questions ADD COLUMN external_id VARCHAR(255);
+CREATE UNIQUE INDEX idx_questions_external_id ON questions(external_id) WHERE external_id IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_questions_translation_group_language;
+ALTER TABLE questions DROP COLUMN IF EXISTS translation_group_id;
@@ -0,0 +1,7 @@
+-- Questions that translate one another share a translation group, one question per language.
+-- The group is named after its canonical question, so every existing question starts as the
+-- canonical (and only) member of its own group; the seeder links its English/Vietnamese pairs.
+ALTER TABLE questions ADD COLUMN translation_group_id UUID;
+UPDATE questions SET translation_group_id = id;
+ALTER TABLE questions ALTER COLUMN translation_group_id SET NOT NULL;
+CREATE UNIQUE INDEX idx_questions_translation_group_language ON questions(translation_group_id, language);
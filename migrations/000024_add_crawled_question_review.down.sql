@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_crawled_questions_status_created;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS question_id;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS rejection_reason;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS language;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS correct_answer;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS hint;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS topic_id;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS role;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS level;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS content;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS title;
@@ -0,0 +1,20 @@
+-- Review state for crawled questions. Reviewers edit the question before approving it; edits are
+-- stored beside the raw_* and detected_* columns (NULL = not edited) so the crawled data is kept.
+ALTER TABLE crawled_questions ADD COLUMN title TEXT;
+ALTER TABLE crawled_questions ADD COLUMN content TEXT;
+ALTER TABLE crawled_questions ADD COLUMN level VARCHAR(50);
+ALTER TABLE crawled_questions ADD COLUMN role VARCHAR(100);
+ALTER TABLE crawled_questions ADD COLUMN topic_id UUID REFERENCES topics(id) ON DELETE SET NULL;
+ALTER TABLE crawled_questions ADD COLUMN hint TEXT;
+ALTER TABLE crawled_questions ADD COLUMN correct_answer TEXT;
+ALTER TABLE crawled_questions ADD COLUMN language VARCHAR(10) NOT NULL DEFAULT 'en';
+
+ALTER TABLE crawled_questions ADD COLUMN rejection_reason TEXT;
+ALTER TABLE crawled_questions ADD COLUMN reviewed_by UUID;
+ALTER TABLE crawled_questions ADD COLUMN reviewed_at TIMESTAMP WITH TIME ZONE;
+-- The question an approved item was promoted to
+ALTER TABLE crawled_questions ADD COLUMN question_id UUID REFERENCES questions(id) ON DELETE SET NULL;
+ALTER TABLE crawled_questions ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+UPDATE crawled_questions SET updated_at = created_at;
+
+CREATE INDEX idx_crawled_questions_status_created ON crawled_questions(status, created_at);
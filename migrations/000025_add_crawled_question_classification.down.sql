@@ -0,0 +1,8 @@
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS classified_at;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS classified_by;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS role_confidence;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS level_confidence;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS topic_confidence;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS suggested_role;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS suggested_level;
+ALTER TABLE crawled_questions DROP COLUMN IF EXISTS suggested_topic_id;
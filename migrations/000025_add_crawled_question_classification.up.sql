@@ -0,0 +1,10 @@
+-- Topic, level and role proposed by the question-service classifier, with a confidence in [0, 1] each.
+-- Proposals rank between reviewer edits and the crawler's detected_* guesses.
+ALTER TABLE crawled_questions ADD COLUMN suggested_topic_id UUID REFERENCES topics(id) ON DELETE SET NULL;
+ALTER TABLE crawled_questions ADD COLUMN suggested_level VARCHAR(50);
+ALTER TABLE crawled_questions ADD COLUMN suggested_role VARCHAR(100);
+ALTER TABLE crawled_questions ADD COLUMN topic_confidence REAL;
+ALTER TABLE crawled_questions ADD COLUMN level_confidence REAL;
+ALTER TABLE crawled_questions ADD COLUMN role_confidence REAL;
+ALTER TABLE crawled_questions ADD COLUMN classified_by VARCHAR(20);
+ALTER TABLE crawled_questions ADD COLUMN classified_at TIMESTAMP WITH TIME ZONE;
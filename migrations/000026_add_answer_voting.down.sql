@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_answers_question_score;
+DROP INDEX IF EXISTS idx_votes_answer_id;
+ALTER TABLE votes ALTER COLUMN value DROP NOT NULL;
+ALTER TABLE votes DROP COLUMN IF EXISTS created_at;
+ALTER TABLE answers ALTER COLUMN vote_count DROP NOT NULL;
+ALTER TABLE answers DROP COLUMN IF EXISTS updated_at;
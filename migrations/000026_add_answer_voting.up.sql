@@ -0,0 +1,14 @@
+-- Answer voting. answer-service writes votes and answers.vote_count in one transaction;
+-- recount once so existing rows start consistent.
+ALTER TABLE answers ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+UPDATE answers SET updated_at = created_at;
+
+UPDATE answers a SET vote_count = COALESCE((SELECT SUM(v.value) FROM votes v WHERE v.answer_id = a.id), 0);
+ALTER TABLE answers ALTER COLUMN vote_count SET NOT NULL;
+
+ALTER TABLE votes ADD COLUMN created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE votes ALTER COLUMN value SET NOT NULL;
+
+CREATE INDEX idx_votes_answer_id ON votes(answer_id);
+-- Listing a question's answers by score
+CREATE INDEX idx_answers_question_score ON answers(question_id, vote_count DESC, created_at DESC);
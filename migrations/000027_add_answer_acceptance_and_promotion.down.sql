@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_answers_one_promoted;
+ALTER TABLE answers DROP COLUMN IF EXISTS previous_correct_answer;
+ALTER TABLE answers DROP COLUMN IF EXISTS promoted_at;
+DROP INDEX IF EXISTS idx_answers_one_accepted;
+ALTER TABLE answers ALTER COLUMN is_accepted DROP NOT NULL;
@@ -0,0 +1,15 @@
+-- At most one accepted answer per question; keep the newest where there are several
+UPDATE answers a SET is_accepted = FALSE
+WHERE a.is_accepted AND EXISTS (
+    SELECT 1 FROM answers b
+    WHERE b.question_id = a.question_id AND b.is_accepted AND (b.created_at, b.id) > (a.created_at, a.id)
+);
+UPDATE answers SET is_accepted = FALSE WHERE is_accepted IS NULL;
+ALTER TABLE answers ALTER COLUMN is_accepted SET NOT NULL;
+CREATE UNIQUE INDEX idx_answers_one_accepted ON answers(question_id) WHERE is_accepted;
+
+-- A community answer promoted to canonical replaces questions.correct_answer. The value it replaced
+-- is kept so demoting the answer can put it back.
+ALTER TABLE answers ADD COLUMN promoted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE answers ADD COLUMN previous_correct_answer TEXT;
+CREATE UNIQUE INDEX idx_answers_one_promoted ON answers(question_id) WHERE promoted_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS answer_revisions;
+ALTER TABLE answers DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE answers DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,17 @@
+-- Soft deletion: deleted answers stay in the table for moderation history but are hidden everywhere
+ALTER TABLE answers ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE answers ADD COLUMN deleted_by UUID REFERENCES users(id);
+
+-- Content history of answers, one row per edit (revision 1 is the content as posted)
+CREATE TABLE answer_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    answer_id UUID NOT NULL REFERENCES answers(id) ON DELETE CASCADE,
+    revision_number INT NOT NULL,
+    content TEXT NOT NULL,
+    author_id UUID REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (answer_id, revision_number)
+);
+
+INSERT INTO answer_revisions (answer_id, revision_number, content, author_id, created_at)
+SELECT id, 1, content, created_by, COALESCE(created_at, CURRENT_TIMESTAMP) FROM answers;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_answers_one_generated_suggestion;
+ALTER TABLE answers DROP COLUMN IF EXISTS attribution;
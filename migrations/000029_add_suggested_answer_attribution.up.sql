@@ -0,0 +1,6 @@
+-- AI-generated sample answers are published to answer-service as suggested answers without an author.
+-- attribution names where one came from; at most one is published per question and level target,
+-- and a deleted one is not published again.
+ALTER TABLE answers ADD COLUMN attribution VARCHAR(255);
+CREATE UNIQUE INDEX idx_answers_one_generated_suggestion ON answers(question_id, level_target)
+    WHERE answer_type = 'suggested' AND created_by IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_bookmarks_user_created;
+DROP TABLE IF EXISTS bookmark_collection_items;
+DROP TABLE IF EXISTS bookmark_collections;
@@ -0,0 +1,28 @@
+-- Named collections of a user's bookmarks. A collection holds only its owner's bookmarks, so removing
+-- a bookmark takes the question out of every collection. Shared collections are readable by any
+-- signed-in user and can back their practice sessions.
+CREATE TABLE bookmark_collections (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    is_shared BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name),
+    -- Target of the items' composite key, which ties each item to its owner's bookmark
+    UNIQUE (id, user_id)
+);
+
+CREATE TABLE bookmark_collection_items (
+    collection_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    question_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (collection_id, question_id),
+    FOREIGN KEY (collection_id, user_id) REFERENCES bookmark_collections(id, user_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id, question_id) REFERENCES bookmarks(user_id, question_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_bookmark_collection_items_bookmark ON bookmark_collection_items(user_id, question_id);
+CREATE INDEX idx_bookmarks_user_created ON bookmarks(user_id, created_at DESC);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_practice_sessions_user_id;
@@ -0,0 +1,2 @@
+-- Weakest-questions sessions read all of a user's attempts through their sessions
+CREATE INDEX idx_practice_sessions_user_id ON practice_sessions(user_id);
@@ -0,0 +1,2 @@
+-- Backfilled revisions are indistinguishable from revisions written at creation; keep them
+SELECT 1;
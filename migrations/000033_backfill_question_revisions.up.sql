@@ -0,0 +1,7 @@
+-- Questions created before question_revisions existed have no history; record their current
+-- content as revision 1, as 000028 did for answers. Questions edited since then already start
+-- from their first snapshot and are left alone.
+INSERT INTO question_revisions (question_id, revision_number, title, content, level, hint, correct_answer, author_id, created_at)
+SELECT q.id, 1, q.title, q.content, q.level, q.hint, q.correct_answer, q.created_by, COALESCE(q.created_at, CURRENT_TIMESTAMP)
+FROM questions q
+WHERE NOT EXISTS (SELECT 1 FROM question_revisions r WHERE r.question_id = q.id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_answers_one_generated_suggestion;
+CREATE UNIQUE INDEX idx_answers_one_generated_suggestion ON answers(question_id, level_target)
+    WHERE answer_type = 'suggested' AND created_by IS NULL;
@@ -0,0 +1,6 @@
+-- Generated suggested answers can be promoted to canonical and demoted back. A promoted one keeps its
+-- question and level target slot, so no second suggestion is published while it is canonical and
+-- demoting it never collides with another.
+DROP INDEX IF EXISTS idx_answers_one_generated_suggestion;
+CREATE UNIQUE INDEX idx_answers_one_generated_suggestion ON answers(question_id, level_target)
+    WHERE created_by IS NULL AND answer_type IN ('suggested', 'canonical');
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_practice_attempts_translation_group;
+ALTER TABLE practice_attempts DROP COLUMN IF EXISTS translation_group_id;
@@ -0,0 +1,9 @@
+-- Practice attempts remember their question's translation group, so the practice service can pick
+-- unseen and weak questions without reading the question catalog's tables.
+ALTER TABLE practice_attempts ADD COLUMN IF NOT EXISTS translation_group_id UUID;
+
+UPDATE practice_attempts pa SET translation_group_id = q.translation_group_id
+FROM questions q
+WHERE q.id = pa.question_id AND pa.translation_group_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_practice_attempts_translation_group ON practice_attempts(translation_group_id);
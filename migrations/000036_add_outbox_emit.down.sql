@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_outbox_events_pending_aggregate;
+DROP FUNCTION IF EXISTS outbox_emit(VARCHAR, UUID, VARCHAR, JSONB);
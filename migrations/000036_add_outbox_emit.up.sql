@@ -0,0 +1,13 @@
+-- outbox_emit appends an event to the outbox and returns its id. Services call it in the transaction of
+-- the change the event describes, so the outbox's columns and defaults live here rather than in each
+-- service. clock_timestamp() keeps the events of one transaction in the order they were emitted.
+CREATE OR REPLACE FUNCTION outbox_emit(p_aggregate_type VARCHAR, p_aggregate_id UUID, p_event_type VARCHAR, p_payload JSONB)
+RETURNS UUID AS $$
+    INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload, occurred_at)
+    VALUES (p_aggregate_type, p_aggregate_id, p_event_type, p_payload, clock_timestamp())
+    RETURNING id
+$$ LANGUAGE sql;
+
+-- The relay holds back an aggregate's later events while an earlier one is pending
+CREATE INDEX idx_outbox_events_pending_aggregate ON outbox_events(aggregate_type, aggregate_id, occurred_at)
+    WHERE published_at IS NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_practice_attempts_follow_up_id;
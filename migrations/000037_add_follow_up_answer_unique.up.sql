@@ -0,0 +1,8 @@
+-- A follow-up takes a single answer; keep the earliest of any repeats
+DELETE FROM practice_attempts pa
+USING practice_attempts earlier
+WHERE pa.follow_up_id IS NOT NULL
+  AND earlier.follow_up_id = pa.follow_up_id
+  AND (earlier.created_at, earlier.id) < (pa.created_at, pa.id);
+
+CREATE UNIQUE INDEX idx_practice_attempts_follow_up_id ON practice_attempts(follow_up_id) WHERE follow_up_id IS NOT NULL;
@@ -0,0 +1 @@
+UPDATE practice_attempts SET score = 0 WHERE score IS NULL;
@@ -0,0 +1,3 @@
+-- Attempts the AI did not grade store no score; before this they were stored as 0 and drilled as weak
+UPDATE practice_attempts SET score = NULL
+WHERE score = 0 AND feedback IN ('AI unavailable.', 'Standard answer provided (AI disabled).', 'Follow-up answer recorded (AI disabled).');
@@ -0,0 +1,15 @@
+ALTER TABLE questions
+    ADD COLUMN sample_answer TEXT,
+    ADD COLUMN sample_feedback TEXT,
+    ADD COLUMN sample_suggestions JSONB,
+    ADD COLUMN sample_source VARCHAR(20) DEFAULT 'seed';
+
+UPDATE questions q
+SET sample_answer = s.sample_answer,
+    sample_feedback = s.sample_feedback,
+    sample_suggestions = s.sample_suggestions,
+    sample_source = s.sample_source
+FROM practice_question_samples s
+WHERE s.question_id = q.id;
+
+DROP TABLE IF EXISTS practice_question_samples;
@@ -0,0 +1,20 @@
+-- The sample answer cache is practice-service's, so it moves out of question-service's questions table
+CREATE TABLE practice_question_samples (
+    question_id UUID PRIMARY KEY REFERENCES questions(id) ON DELETE CASCADE,
+    sample_answer TEXT,
+    sample_feedback TEXT,
+    sample_suggestions JSONB,
+    sample_source VARCHAR(20) NOT NULL DEFAULT 'seed',
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO practice_question_samples (question_id, sample_answer, sample_feedback, sample_suggestions, sample_source)
+SELECT id, sample_answer, sample_feedback, sample_suggestions, COALESCE(sample_source, 'seed')
+FROM questions
+WHERE sample_answer IS NOT NULL OR sample_feedback IS NOT NULL OR sample_suggestions IS NOT NULL;
+
+ALTER TABLE questions
+    DROP COLUMN sample_answer,
+    DROP COLUMN sample_feedback,
+    DROP COLUMN sample_suggestions,
+    DROP COLUMN sample_source;
@@ -1,5 +1,5 @@
 from fastapi import APIRouter, HTTPException, Depends
-from app.models.schemas import EvaluationRequest, EvaluationResponse, GenerationRequest, GenerationResponse, GeneratedQuestion
+from app.models.schemas import EvaluationRequest, EvaluationResponse, GenerationRequest, GenerationResponse, GeneratedQuestion, FollowUpRequest, FollowUpResponse, ClassificationRequest, ClassificationResponse
 from app.services.evaluator import AnswerEvaluator
 
 router = APIRouter()
@@ -45,3 +45,41 @@ async def generate_questions(
         return GenerationResponse(questions=[GeneratedQuestion(**q) for q in questions])
     except Exception as e:
         raise HTTPException(status_code=500, detail=str(e))
+
+@router.post("/follow-ups", response_model=FollowUpResponse)
+async def generate_follow_ups(
+    request: FollowUpRequest,
+    evaluator: AnswerEvaluator = Depends(get_evaluator)
+):
+    """
+    Generate probing follow-up questions from a candidate's answer.
+    """
+    try:
+        follow_ups = await evaluator.generate_follow_ups(
+            question=request.question_content,
+            user_answer=request.user_answer,
+            feedback=request.feedback,
+            topic=request.topic,
+            level=request.level,
+            language=request.language
+        )
+        return FollowUpResponse(follow_ups=follow_ups)
+    except Exception as e:
+        raise HTTPException(status_code=500, detail=str(e))
+
+@router.post("/classify", response_model=ClassificationResponse)
+async def classify_question(
+    request: ClassificationRequest,
+    evaluator: AnswerEvaluator = Depends(get_evaluator)
+):
+    """
+    Propose a topic, level and role for a crawled question, with a confidence for each.
+    """
+    try:
+        return await evaluator.classify_question(
+            title=request.title,
+            content=request.content or "",
+            topics=request.topics
+        )
+    except Exception as e:
+        raise HTTPException(status_code=500, detail=str(e))
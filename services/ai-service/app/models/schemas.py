@@ -28,3 +28,27 @@ class GeneratedQuestion(BaseModel):
 
 class GenerationResponse(BaseModel):
     questions: List[GeneratedQuestion]
+
+class FollowUpRequest(BaseModel):
+    question_content: str = Field(..., description="The content of the question asked")
+    user_answer: str = Field(..., description="The answer provided by the user")
+    feedback: Optional[str] = Field(None, description="Feedback already given on the answer")
+    topic: Optional[str] = Field(None, description="Topic of the question")
+    level: Optional[str] = Field(None, description="Difficulty level")
+    language: Optional[str] = Field("en", description="Language for follow-ups (en or vi)")
+
+class FollowUpResponse(BaseModel):
+    follow_ups: List[str] = Field(..., description="One or two probing follow-up questions")
+
+class ClassificationRequest(BaseModel):
+    title: str = Field(..., description="Title of the crawled question")
+    content: Optional[str] = Field("", description="Body of the crawled question")
+    topics: List[str] = Field(..., description="Topic names the question can be filed under")
+
+class ClassificationResponse(BaseModel):
+    topic: str = Field("", description="One of the given topics, or empty when none fits")
+    topic_confidence: float = Field(0, ge=0, le=1)
+    level: str = Field("", description="Fresher, Junior, Mid or Senior")
+    level_confidence: float = Field(0, ge=0, le=1)
+    role: str = Field("", description="FrontEnd, BackEnd, DevOps, Data Engineer, or empty")
+    role_confidence: float = Field(0, ge=0, le=1)
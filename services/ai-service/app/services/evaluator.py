@@ -14,7 +14,7 @@ from langchain_core.prompts import PromptTemplate
 from langchain_core.output_parsers import JsonOutputParser
 from pydantic import BaseModel, Field
 from app.core.config import settings
-from app.models.schemas import EvaluationResponse
+from app.models.schemas import EvaluationResponse, ClassificationResponse
 from typing import List, Optional
 
 # Define the internal Pydantic model for LangChain parser (v1 compatible if needed, but let's try to match schema)
@@ -152,3 +152,100 @@ class AnswerEvaluator:
         except Exception as e:
             print(f"Error generating questions: {e}")
             return []
+
+    async def generate_follow_ups(self, question: str, user_answer: str, feedback: str = None, topic: str = "General", level: str = "Medium", language: str = "en") -> List[str]:
+        try:
+            lang_map = {"vi": "Vietnamese", "en": "English"}
+            full_lang = lang_map.get(language, "English")
+
+            follow_up_parser = JsonOutputParser()
+            follow_up_prompt = PromptTemplate(
+                template="""
+                You are an expert technical interviewer. The candidate just answered an interview question.
+                Ask one or two short follow-up questions that dig deeper into their answer, the way a real
+                interviewer would: probe gaps, vague claims or trade-offs they mentioned.
+
+                Question: {question}
+                Topic: {topic}
+                Level: {level}
+
+                Candidate's Answer: {user_answer}
+
+                Feedback already given: {feedback}
+
+                The follow-up questions MUST be in {language} language.
+
+                Return the result as a JSON array of strings, for example:
+                ["Why would you choose X over Y here?"]
+                """,
+                input_variables=["question", "topic", "level", "user_answer", "feedback", "language"],
+            )
+
+            chain = follow_up_prompt | self.llm | follow_up_parser
+
+            result = await chain.ainvoke({
+                "question": question,
+                "topic": topic if topic else "General",
+                "level": level if level else "Medium",
+                "user_answer": user_answer,
+                "feedback": feedback if feedback else "N/A",
+                "language": full_lang
+            })
+
+            if isinstance(result, dict) and "follow_ups" in result:
+                result = result["follow_ups"]
+            if not isinstance(result, list):
+                return []
+            return [str(q) for q in result if str(q).strip()][:2]
+
+        except Exception as e:
+            print(f"Error generating follow-ups: {e}")
+            return []
+
+    async def classify_question(self, title: str, content: str, topics: List[str]) -> ClassificationResponse:
+        classify_parser = JsonOutputParser()
+        classify_prompt = PromptTemplate(
+            template="""
+            You are an expert technical interviewer curating a question bank. Classify the following
+            interview question.
+
+            Title: {title}
+            Content: {content}
+
+            Pick the topic from this list only, or "" if none fits: {topics}
+            Pick the level from: Fresher, Junior, Mid, Senior.
+            Pick the role from: FrontEnd, BackEnd, DevOps, Data Engineer, or "" if the question is not specific to one.
+            Give each choice a confidence between 0 and 1. Be conservative: use a high confidence only
+            when the question clearly belongs there.
+
+            Return the result as a JSON object, for example:
+            {{"topic": "Go", "topic_confidence": 0.9, "level": "Mid", "level_confidence": 0.7, "role": "BackEnd", "role_confidence": 0.8}}
+            """,
+            input_variables=["title", "content", "topics"],
+        )
+
+        chain = classify_prompt | self.llm | classify_parser
+
+        result = await chain.ainvoke({
+            "title": title,
+            "content": content if content else "N/A",
+            "topics": ", ".join(topics)
+        })
+        if not isinstance(result, dict):
+            raise ValueError("classification is not a JSON object")
+
+        def confidence(key: str) -> float:
+            try:
+                return max(0.0, min(1.0, float(result.get(key) or 0)))
+            except (TypeError, ValueError):
+                return 0.0
+
+        topic = str(result.get("topic") or "")
+        return ClassificationResponse(
+            topic=topic if topic in topics else "",
+            topic_confidence=confidence("topic_confidence") if topic in topics else 0,
+            level=str(result.get("level") or ""),
+            level_confidence=confidence("level_confidence"),
+            role=str(result.get("role") or ""),
+            role_confidence=confidence("role_confidence"),
+        )
@@ -13,6 +13,9 @@ import (
 	"github.com/question-interviewer/answer-service/internal/services"
 )
 
+// minServiceKeyLength is the shortest SERVICE_API_KEY accepted, the length of 16 random bytes in hex
+const minServiceKeyLength = 32
+
 func main() {
 	log.Println("Starting Answer Service...")
 
@@ -55,9 +58,27 @@ func main() {
 		log.Println("Connected to Database")
 	}
 
+	// JWT Config (tokens are issued by the BFF with the same secret)
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET is required")
+	}
+
+	// Service key for routes other services call (practice-service publishes AI sample answers);
+	// without one those routes are not served
+	serviceKey := os.Getenv("SERVICE_API_KEY")
+	switch {
+	case serviceKey == "":
+		log.Println("SERVICE_API_KEY not set, not accepting suggested answers from other services")
+	case serviceKey == "dev-service-key-change-me" || len(serviceKey) < minServiceKeyLength:
+		// The port is published, so a guessable key would let anyone publish suggested answers
+		log.Fatalf("SERVICE_API_KEY must be a random key of at least %d characters", minServiceKeyLength)
+	}
+
 	// Dependency Injection
 	repo := postgres.NewAnswerRepository(db)
-	svc := services.NewAnswerService(repo)
+	revisionRepo := postgres.NewAnswerRevisionRepository(db)
+	svc := services.NewAnswerService(repo, revisionRepo, postgres.NewTransactor(db))
 	handler := http_adapter.NewAnswerHandler(svc)
 
 	// Router Setup
@@ -75,11 +96,18 @@ func main() {
 		c.Next()
 	})
 
+	// Auth Middleware (injects the authenticated user ID)
+	r.Use(http_adapter.Authenticate([]byte(jwtSecret)))
+	r.Use(http_adapter.Authorize(http_adapter.RoutePolicy, postgres.NewUserRoleRepository(db)))
+
 	r.GET("/health", func(c *gin.Context) {
 		c.String(200, "OK")
 	})
 
 	handler.RegisterRoutes(r)
+	if serviceKey != "" {
+		handler.RegisterServiceRoutes(r, serviceKey)
+	}
 
 	// Start Server
 	log.Println("Server listening on :8080")
@@ -1,8 +1,10 @@
 package http_adapter
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -24,7 +26,8 @@ type CreateAnswerRequest struct {
 	QuestionID string `json:"question_id" binding:"required,uuid"`
 	Content    string `json:"content" binding:"required"`
 	AnswerType string `json:"answer_type" binding:"required"`
-	AuthorID   string `json:"author_id" binding:"required,uuid"` // TODO: Get from context/auth
+	// LevelTarget is junior, mid (default) or senior
+	LevelTarget string `json:"level_target"`
 }
 
 // CreateAnswer godoc
@@ -49,9 +52,9 @@ func (h *AnswerHandler) CreateAnswer(c *gin.Context) {
 		return
 	}
 
-	authorID, err := uuid.Parse(req.AuthorID)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid author_id"})
+	authorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
 		return
 	}
 
@@ -61,7 +64,21 @@ func (h *AnswerHandler) CreateAnswer(c *gin.Context) {
 		answerType = domain.AnswerTypeCommunity
 	}
 
-	answer, err := h.service.CreateAnswer(c.Request.Context(), questionID, authorID, req.Content, answerType)
+	// Canonical answers are curated; only moderators may write them directly
+	if answerType == domain.AnswerTypeCanonical && !roleFromContext(c).IsModerator() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only moderators can create canonical answers"})
+		return
+	}
+
+	var levelTarget domain.LevelTarget
+	if req.LevelTarget != "" {
+		if levelTarget, err = domain.ParseLevelTarget(req.LevelTarget); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	answer, err := h.service.CreateAnswer(c.Request.Context(), questionID, authorID, req.Content, answerType, levelTarget)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -70,6 +87,54 @@ func (h *AnswerHandler) CreateAnswer(c *gin.Context) {
 	c.JSON(http.StatusCreated, answer)
 }
 
+type PublishSuggestedAnswerRequest struct {
+	QuestionID  string `json:"question_id" binding:"required,uuid"`
+	Content     string `json:"content" binding:"required"`
+	LevelTarget string `json:"level_target"`
+	// Attribution names the generator, such as the AI service and model
+	Attribution string `json:"attribution" binding:"required"`
+}
+
+// PublishSuggestedAnswer godoc
+// @Summary Publish a generated suggested answer
+// @Description For other services: store an AI-generated sample answer as a suggested answer without an author. Each question takes one per level target; later ones get 409. Requires the X-Service-Key header.
+// @Tags answers
+// @Accept json
+// @Produce json
+// @Param answer body PublishSuggestedAnswerRequest true "Suggested answer"
+// @Success 201 {object} domain.Answer
+// @Failure 409 {object} map[string]interface{}
+// @Router /answers/suggested [post]
+func (h *AnswerHandler) PublishSuggestedAnswer(c *gin.Context) {
+	var req PublishSuggestedAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	questionID, err := uuid.Parse(req.QuestionID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question_id"})
+		return
+	}
+
+	var levelTarget domain.LevelTarget
+	if req.LevelTarget != "" {
+		if levelTarget, err = domain.ParseLevelTarget(req.LevelTarget); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	answer, err := h.service.PublishSuggestedAnswer(c.Request.Context(), questionID, req.Content, levelTarget, req.Attribution)
+	if err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, answer)
+}
+
 // GetAnswer godoc
 // @Summary Get an answer by ID
 // @Description Get an answer by ID
@@ -96,6 +161,111 @@ func (h *AnswerHandler) GetAnswer(c *gin.Context) {
 	c.JSON(http.StatusOK, answer)
 }
 
+type UpdateAnswerRequest struct {
+	Content     *string `json:"content"`
+	LevelTarget *string `json:"level_target"`
+}
+
+// UpdateAnswer godoc
+// @Summary Edit an answer
+// @Description Edit an answer's content or level target. Allowed to its author and moderators; canonical answers need a moderator. Every edit is kept as a revision.
+// @Tags answers
+// @Accept json
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Param answer body UpdateAnswerRequest true "Fields to change"
+// @Success 200 {object} domain.Answer
+// @Router /answers/{id} [put]
+func (h *AnswerHandler) UpdateAnswer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req UpdateAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	patch := domain.AnswerPatch{Content: req.Content}
+	if req.LevelTarget != nil {
+		levelTarget, err := domain.ParseLevelTarget(*req.LevelTarget)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		patch.LevelTarget = &levelTarget
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	answer, err := h.service.UpdateAnswer(c.Request.Context(), id, patch, actorID, roleFromContext(c))
+	if err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, answer)
+}
+
+// DeleteAnswer godoc
+// @Summary Delete an answer
+// @Description Soft-delete an answer. Allowed to its author and moderators.
+// @Tags answers
+// @Param id path string true "Answer ID"
+// @Success 204
+// @Router /answers/{id} [delete]
+func (h *AnswerHandler) DeleteAnswer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	if err := h.service.DeleteAnswer(c.Request.Context(), id, actorID, roleFromContext(c)); err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListRevisions godoc
+// @Summary List answer revisions
+// @Description An answer's content history, oldest first
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {array} domain.AnswerRevision
+// @Router /answers/{id}/revisions [get]
+func (h *AnswerHandler) ListRevisions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	revisions, err := h.service.ListRevisions(c.Request.Context(), id)
+	if err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
 // ListAnswers godoc
 // @Summary List answers for a question
 // @Description List answers for a question with pagination
@@ -103,6 +273,9 @@ func (h *AnswerHandler) GetAnswer(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param question_id query string true "Question ID"
+// @Param level_target query string false "junior, mid or senior"
+// @Param answer_type query string false "canonical, community or suggested"
+// @Param sort query string false "score (default), newest or oldest"
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
 // @Success 200 {array} domain.Answer
@@ -120,13 +293,42 @@ func (h *AnswerHandler) ListAnswers(c *gin.Context) {
 		return
 	}
 
+	sort, err := domain.ParseAnswerSort(c.Query("sort"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sort"})
+		return
+	}
+
+	var levelTarget domain.LevelTarget
+	if v := c.Query("level_target"); v != "" {
+		if levelTarget, err = domain.ParseLevelTarget(v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid level_target"})
+			return
+		}
+	}
+
+	var answerType domain.AnswerType
+	if v := c.Query("answer_type"); v != "" {
+		if answerType, err = domain.ParseAnswerType(v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid answer_type"})
+			return
+		}
+	}
+
 	limitStr := c.DefaultQuery("limit", "10")
 	offsetStr := c.DefaultQuery("offset", "0")
 
 	limit, _ := strconv.Atoi(limitStr)
 	offset, _ := strconv.Atoi(offsetStr)
 
-	answers, err := h.service.ListAnswersForQuestion(c.Request.Context(), questionID, limit, offset)
+	answers, err := h.service.ListAnswersForQuestion(c.Request.Context(), domain.AnswerFilter{
+		QuestionID:  questionID,
+		LevelTarget: levelTarget,
+		AnswerType:  answerType,
+		Sort:        sort,
+		Limit:       limit,
+		Offset:      offset,
+	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -135,11 +337,220 @@ func (h *AnswerHandler) ListAnswers(c *gin.Context) {
 	c.JSON(http.StatusOK, answers)
 }
 
+// writeAnswerError maps answer service errors to HTTP status codes
+func writeAnswerError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "answer not found"), strings.HasPrefix(err.Error(), "question not found"):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "suggested answer already published"):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "invalid"):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// Upvote godoc
+// @Summary Upvote an answer
+// @Description Vote an answer up. Each user has one vote per answer; voting again replaces it.
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {object} domain.VoteResult
+// @Router /answers/{id}/upvote [post]
+func (h *AnswerHandler) Upvote(c *gin.Context) {
+	h.vote(c, domain.VoteUp)
+}
+
+// Downvote godoc
+// @Summary Downvote an answer
+// @Description Vote an answer down. Each user has one vote per answer; voting again replaces it.
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {object} domain.VoteResult
+// @Router /answers/{id}/downvote [post]
+func (h *AnswerHandler) Downvote(c *gin.Context) {
+	h.vote(c, domain.VoteDown)
+}
+
+// Unvote godoc
+// @Summary Remove a vote
+// @Description Remove the caller's vote on an answer
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {object} domain.VoteResult
+// @Router /answers/{id}/vote [delete]
+func (h *AnswerHandler) Unvote(c *gin.Context) {
+	h.vote(c, 0)
+}
+
+// vote applies value (0 removes the vote) for the authenticated user
+func (h *AnswerHandler) vote(c *gin.Context, value int) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	userID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	var result *domain.VoteResult
+	if value == 0 {
+		result, err = h.service.Unvote(c.Request.Context(), id, userID)
+	} else {
+		result, err = h.service.Vote(c.Request.Context(), id, userID, value)
+	}
+	if err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// AcceptAnswer godoc
+// @Summary Accept an answer
+// @Description Mark an answer as the question's accepted answer, replacing any earlier one. Allowed to the question's author and moderators.
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {object} domain.Answer
+// @Router /answers/{id}/accept [post]
+func (h *AnswerHandler) AcceptAnswer(c *gin.Context) {
+	h.setAccepted(c, true)
+}
+
+// UnacceptAnswer godoc
+// @Summary Remove an answer's acceptance
+// @Description Allowed to the question's author and moderators
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {object} domain.Answer
+// @Router /answers/{id}/accept [delete]
+func (h *AnswerHandler) UnacceptAnswer(c *gin.Context) {
+	h.setAccepted(c, false)
+}
+
+func (h *AnswerHandler) setAccepted(c *gin.Context, accepted bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	var answer *domain.Answer
+	if accepted {
+		answer, err = h.service.AcceptAnswer(c.Request.Context(), id, actorID, roleFromContext(c))
+	} else {
+		answer, err = h.service.UnacceptAnswer(c.Request.Context(), id, actorID, roleFromContext(c))
+	}
+	if err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, answer)
+}
+
+// PromoteAnswer godoc
+// @Summary Promote an answer to canonical
+// @Description Make a community or suggested answer canonical and copy it into the question's correct answer. A previously promoted answer goes back to community, or to suggested if it was generated. Moderators only.
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {object} domain.Answer
+// @Router /answers/{id}/promote [post]
+func (h *AnswerHandler) PromoteAnswer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	answer, err := h.service.PromoteAnswer(c.Request.Context(), id, actorID, roleFromContext(c))
+	if err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, answer)
+}
+
+// DemoteAnswer godoc
+// @Summary Demote a canonical answer
+// @Description Turn a canonical answer back into a community answer, or a suggested answer if it was generated, and restore the correct answer it replaced, unless that was edited since. Moderators only.
+// @Tags answers
+// @Produce json
+// @Param id path string true "Answer ID"
+// @Success 200 {object} domain.Answer
+// @Router /answers/{id}/demote [post]
+func (h *AnswerHandler) DemoteAnswer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	answer, err := h.service.DemoteAnswer(c.Request.Context(), id, actorID, roleFromContext(c))
+	if err != nil {
+		writeAnswerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, answer)
+}
+
 func (h *AnswerHandler) RegisterRoutes(router *gin.Engine) {
 	v1 := router.Group("/api/v1")
 	{
-		v1.POST("/answers", h.CreateAnswer)
+		v1.POST("/answers", RequireAuth(), h.CreateAnswer)
 		v1.GET("/answers/:id", h.GetAnswer)
+		v1.PUT("/answers/:id", RequireAuth(), h.UpdateAnswer)
+		v1.DELETE("/answers/:id", RequireAuth(), h.DeleteAnswer)
+		v1.GET("/answers/:id/revisions", h.ListRevisions)
 		v1.GET("/answers", h.ListAnswers)
+		v1.POST("/answers/:id/upvote", RequireAuth(), h.Upvote)
+		v1.POST("/answers/:id/downvote", RequireAuth(), h.Downvote)
+		v1.DELETE("/answers/:id/vote", RequireAuth(), h.Unvote)
+		v1.POST("/answers/:id/accept", RequireAuth(), h.AcceptAnswer)
+		v1.DELETE("/answers/:id/accept", RequireAuth(), h.UnacceptAnswer)
+		v1.POST("/answers/:id/promote", RequireAuth(), h.PromoteAnswer)
+		v1.POST("/answers/:id/demote", RequireAuth(), h.DemoteAnswer)
+	}
+}
+
+// RegisterServiceRoutes adds the routes other services call, authenticated by the shared service key
+func (h *AnswerHandler) RegisterServiceRoutes(router *gin.Engine, serviceKey string) {
+	v1 := router.Group("/api/v1")
+	{
+		v1.POST("/answers/suggested", RequireServiceKey(serviceKey), h.PublishSuggestedAnswer)
 	}
 }
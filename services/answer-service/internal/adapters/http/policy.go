@@ -0,0 +1,69 @@
+package http_adapter
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/question-interviewer/answer-service/internal/domain"
+	"github.com/question-interviewer/answer-service/internal/ports"
+)
+
+// RoutePolicy maps "METHOD /route/pattern" to the roles allowed to call it.
+// Routes that are not listed are open to any caller.
+var RoutePolicy = map[string][]domain.Role{
+	"POST /api/v1/answers":              {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/answers/:id/upvote":   {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/answers/:id/downvote": {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"DELETE /api/v1/answers/:id/vote":   {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+
+	// Any signed-in user may try; the service allows the answer's author and moderators
+	"PUT /api/v1/answers/:id":    {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"DELETE /api/v1/answers/:id": {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+
+	// Any signed-in user may try; the service allows the question's author and moderators
+	"POST /api/v1/answers/:id/accept":   {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"DELETE /api/v1/answers/:id/accept": {domain.RoleUser, domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+
+	"POST /api/v1/answers/:id/promote": {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/answers/:id/demote":  {domain.RoleModerator, domain.RoleAdmin},
+}
+
+// Authorize enforces a route policy. The role in the token is only as fresh as the token, so for
+// listed routes the current role is read through roles and replaces it for the rest of the request;
+// a promotion or demotion applies to these routes at once. Unlisted routes keep the token's role
+// until it expires (JWT_TTL). It must be registered after Authenticate and before the routes it guards.
+func Authorize(policy map[string][]domain.Role, roles ports.RoleReader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, ok := policy[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		userID, ok := userIDFromContext(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+			return
+		}
+
+		role, err := roles.GetUserRole(c.Request.Context(), userID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "User no longer exists"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Set(userRoleKey, role)
+
+		for _, r := range allowed {
+			if role == r {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Role " + string(role) + " is not allowed to call this route"})
+	}
+}
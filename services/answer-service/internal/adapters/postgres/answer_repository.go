@@ -24,19 +24,21 @@ func NewAnswerRepository(db *sql.DB) ports.AnswerRepository {
 
 func (r *AnswerRepository) Create(ctx context.Context, answer *domain.Answer) error {
 	query := `
-		INSERT INTO answers (id, question_id, content, author_id, answer_type, vote_count, is_accepted, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO answers (id, question_id, content, created_by, answer_type, level_target, vote_count, is_accepted, created_at, updated_at, attribution)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''))
 	`
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
 		answer.ID,
 		answer.QuestionID,
 		answer.Content,
-		answer.AuthorID,
+		nullableAuthor(answer.AuthorID),
 		answer.AnswerType,
+		answer.LevelTarget,
 		answer.VoteCount,
 		answer.IsAccepted,
 		answer.CreatedAt,
 		answer.UpdatedAt,
+		answer.Attribution,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create answer: %w", err)
@@ -44,44 +46,106 @@ func (r *AnswerRepository) Create(ctx context.Context, answer *domain.Answer) er
 	return nil
 }
 
-func (r *AnswerRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Answer, error) {
+// CreateSuggested inserts a generated suggested answer unless the question already has one for the
+// answer's level target, deleted or not. It reports whether the answer was inserted.
+func (r *AnswerRepository) CreateSuggested(ctx context.Context, answer *domain.Answer) (bool, error) {
 	query := `
-		SELECT id, question_id, content, author_id, answer_type, vote_count, is_accepted, created_at, updated_at
-		FROM answers
-		WHERE id = $1
+		INSERT INTO answers (id, question_id, content, answer_type, level_target, vote_count, is_accepted, created_at, updated_at, attribution)
+		VALUES ($1, $2, $3, $4, $5, 0, FALSE, $6, $7, NULLIF($8, ''))
+		ON CONFLICT (question_id, level_target) WHERE created_by IS NULL AND answer_type IN ('suggested', 'canonical') DO NOTHING
 	`
-	row := r.db.QueryRowContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		answer.ID,
+		answer.QuestionID,
+		answer.Content,
+		answer.AnswerType,
+		answer.LevelTarget,
+		answer.CreatedAt,
+		answer.UpdatedAt,
+		answer.Attribution,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create suggested answer: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// answerColumns lists the columns scanAnswer reads. created_by is NULL for generated answers.
+const answerColumns = `id, question_id, content, created_by, COALESCE(answer_type, 'community'), COALESCE(level_target, 'mid'), vote_count,
+	is_accepted, promoted_at, COALESCE(attribution, ''), created_at, COALESCE(updated_at, created_at)`
 
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAnswer(row rowScanner) (*domain.Answer, error) {
 	var a domain.Answer
+	var authorID uuid.NullUUID
+	var promotedAt sql.NullTime
 	err := row.Scan(
 		&a.ID,
 		&a.QuestionID,
 		&a.Content,
-		&a.AuthorID,
+		&authorID,
 		&a.AnswerType,
+		&a.LevelTarget,
 		&a.VoteCount,
 		&a.IsAccepted,
+		&promotedAt,
+		&a.Attribution,
 		&a.CreatedAt,
 		&a.UpdatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	a.AuthorID = authorID.UUID
+	if promotedAt.Valid {
+		a.PromotedAt = &promotedAt.Time
+	}
+	return &a, nil
+}
+
+// nullableAuthor stores answers without an author (uuid.Nil) with a NULL created_by
+func nullableAuthor(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+func (r *AnswerRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Answer, error) {
+	query := `SELECT ` + answerColumns + ` FROM answers WHERE id = $1 AND deleted_at IS NULL`
+	a, err := scanAnswer(conn(ctx, r.db).QueryRowContext(ctx, query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("answer not found")
 		}
 		return nil, fmt.Errorf("failed to get answer: %w", err)
 	}
-	return &a, nil
+	return a, nil
 }
 
-func (r *AnswerRepository) ListByQuestionID(ctx context.Context, questionID uuid.UUID, limit, offset int) ([]*domain.Answer, error) {
-	query := `
-		SELECT id, question_id, content, author_id, answer_type, vote_count, is_accepted, created_at, updated_at
+// answerOrders maps each sort to its ORDER BY clause
+var answerOrders = map[domain.AnswerSort]string{
+	domain.SortByScore:  "vote_count DESC, created_at DESC",
+	domain.SortByNewest: "created_at DESC",
+	domain.SortByOldest: "created_at ASC",
+}
+
+func (r *AnswerRepository) ListByQuestionID(ctx context.Context, filter domain.AnswerFilter) ([]*domain.Answer, error) {
+	order, ok := answerOrders[filter.Sort]
+	if !ok {
+		order = answerOrders[domain.SortByScore]
+	}
+	query := `SELECT ` + answerColumns + `
 		FROM answers
-		WHERE question_id = $1
-		ORDER BY vote_count DESC, created_at DESC
-		LIMIT $2 OFFSET $3
+		WHERE question_id = $1 AND deleted_at IS NULL AND ($2 = '' OR level_target = $2) AND ($5 = '' OR answer_type = $5)
+		ORDER BY ` + order + `, id
+		LIMIT $3 OFFSET $4
 	`
-	rows, err := r.db.QueryContext(ctx, query, questionID, limit, offset)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, filter.QuestionID, filter.LevelTarget, filter.Limit, filter.Offset, filter.AnswerType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list answers: %w", err)
 	}
@@ -89,44 +153,68 @@ func (r *AnswerRepository) ListByQuestionID(ctx context.Context, questionID uuid
 
 	var answers []*domain.Answer
 	for rows.Next() {
-		var a domain.Answer
-		err := rows.Scan(
-			&a.ID,
-			&a.QuestionID,
-			&a.Content,
-			&a.AuthorID,
-			&a.AnswerType,
-			&a.VoteCount,
-			&a.IsAccepted,
-			&a.CreatedAt,
-			&a.UpdatedAt,
-		)
+		a, err := scanAnswer(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan answer: %w", err)
 		}
-		answers = append(answers, &a)
+		answers = append(answers, a)
 	}
 	return answers, nil
 }
 
-func (r *AnswerRepository) Update(ctx context.Context, answer *domain.Answer) error {
+// Update saves an answer's content and level target. Type, acceptance and votes have their own
+// operations and are never written back from the caller's copy, which may be stale. When the answer is promoted and the question's
+// correct answer is still its old content, the correct answer follows the edit as a question revision by actorID.
+func (r *AnswerRepository) Update(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error {
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		tx := conn(ctx, r.db)
+		// The question is locked before the answer, in the order promotion takes them
+		current, err := lockQuestion(ctx, tx, answer.QuestionID)
+		if err != nil {
+			return err
+		}
+		var previous string
+		var promotedAt sql.NullTime
+		err = tx.QueryRowContext(ctx, `SELECT content, promoted_at FROM answers WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`,
+			answer.ID).Scan(&previous, &promotedAt)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("answer not found")
+			}
+			return fmt.Errorf("failed to lock answer: %w", err)
+		}
+
+		answer.UpdatedAt = time.Now()
+		// The caller gets back the row as stored, so its type, acceptance and votes are current too
+		saved, err := scanAnswer(tx.QueryRowContext(ctx, `
+			UPDATE answers SET content = $2, level_target = $3, updated_at = $4 WHERE id = $1
+			RETURNING `+answerColumns,
+			answer.ID, answer.Content, answer.LevelTarget, answer.UpdatedAt))
+		if err != nil {
+			return fmt.Errorf("failed to update answer: %w", err)
+		}
+		if promotedAt.Valid && current.Valid && current.String == previous {
+			correctAnswer := sql.NullString{String: answer.Content, Valid: true}
+			if err := setCorrectAnswer(ctx, tx, answer.QuestionID, correctAnswer, actorID, answer.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		*answer = *saved
+		return nil
+	})
+}
+
+// Delete soft-deletes an answer. It loses any acceptance; its votes and revisions are kept.
+func (r *AnswerRepository) Delete(ctx context.Context, id, deletedBy uuid.UUID) error {
 	query := `
 		UPDATE answers
-		SET content = $1, answer_type = $2, is_accepted = $3, updated_at = $4
-		WHERE id = $5
+		SET deleted_at = $2, deleted_by = $3, is_accepted = FALSE
+		WHERE id = $1 AND deleted_at IS NULL
 	`
-	answer.UpdatedAt = time.Now()
-	result, err := r.db.ExecContext(ctx, query,
-		answer.Content,
-		answer.AnswerType,
-		answer.IsAccepted,
-		answer.UpdatedAt,
-		answer.ID,
-	)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, time.Now(), nullableAuthor(deletedBy))
 	if err != nil {
-		return fmt.Errorf("failed to update answer: %w", err)
+		return fmt.Errorf("failed to delete answer: %w", err)
 	}
-
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return fmt.Errorf("failed to check rows affected: %w", err)
@@ -137,18 +225,243 @@ func (r *AnswerRepository) Update(ctx context.Context, answer *domain.Answer) er
 	return nil
 }
 
-func (r *AnswerRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM answers WHERE id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+// SetVote records a user's vote on an answer (VoteUp, VoteDown, or 0 to remove it) and adjusts
+// answers.vote_count by the difference in the same transaction. The answer row is locked first,
+// so concurrent votes on one answer apply one after another and the count always equals the votes.
+func (r *AnswerRepository) SetVote(ctx context.Context, answerID, userID uuid.UUID, value int) (int, error) {
+	var voteCount int
+	err := withinTx(ctx, r.db, func(ctx context.Context) error {
+		tx := conn(ctx, r.db)
+		err := tx.QueryRowContext(ctx, `SELECT vote_count FROM answers WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, answerID).Scan(&voteCount)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("answer not found")
+			}
+			return fmt.Errorf("failed to lock answer: %w", err)
+		}
+
+		var previous int
+		err = tx.QueryRowContext(ctx, `SELECT value FROM votes WHERE user_id = $1 AND answer_id = $2`, userID, answerID).Scan(&previous)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to get vote: %w", err)
+		}
+		if previous == value {
+			return nil
+		}
+
+		if value == 0 {
+			_, err = tx.ExecContext(ctx, `DELETE FROM votes WHERE user_id = $1 AND answer_id = $2`, userID, answerID)
+		} else {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO votes (user_id, answer_id, value, created_at)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT (user_id, answer_id) DO UPDATE SET value = EXCLUDED.value, created_at = EXCLUDED.created_at
+			`, userID, answerID, value, time.Now())
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save vote: %w", err)
+		}
+
+		err = tx.QueryRowContext(ctx, `UPDATE answers SET vote_count = vote_count + $2 WHERE id = $1 RETURNING vote_count`,
+			answerID, value-previous).Scan(&voteCount)
+		if err != nil {
+			return fmt.Errorf("failed to update vote count: %w", err)
+		}
+		return nil
+	})
+	return voteCount, err
+}
+
+// GetQuestionAuthor returns who created a question, or uuid.Nil for questions without an author
+func (r *AnswerRepository) GetQuestionAuthor(ctx context.Context, questionID uuid.UUID) (uuid.UUID, error) {
+	var authorID uuid.NullUUID
+	err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT created_by FROM questions WHERE id = $1`, questionID).Scan(&authorID)
 	if err != nil {
-		return fmt.Errorf("failed to delete answer: %w", err)
+		if err == sql.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("question not found")
+		}
+		return uuid.Nil, fmt.Errorf("failed to get question: %w", err)
 	}
-	rowsAffected, err := result.RowsAffected()
+	return authorID.UUID, nil
+}
+
+// lockQuestion serializes acceptance and promotion changes for one question
+func lockQuestion(ctx context.Context, tx dbtx, questionID uuid.UUID) (sql.NullString, error) {
+	var correctAnswer sql.NullString
+	err := tx.QueryRowContext(ctx, `SELECT correct_answer FROM questions WHERE id = $1 FOR UPDATE`, questionID).Scan(&correctAnswer)
 	if err != nil {
-		return fmt.Errorf("failed to check rows affected: %w", err)
+		if err == sql.ErrNoRows {
+			return correctAnswer, fmt.Errorf("question not found")
+		}
+		return correctAnswer, fmt.Errorf("failed to lock question: %w", err)
 	}
-	if rowsAffected == 0 {
-		return fmt.Errorf("answer not found")
+	return correctAnswer, nil
+}
+
+// setCorrectAnswer writes a question's correct answer and, when it changed, records the question's new
+// state as a question revision by actorID and a question.updated event, as question-service does for its own edits
+func setCorrectAnswer(ctx context.Context, tx dbtx, questionID uuid.UUID, correctAnswer sql.NullString, actorID uuid.UUID, now time.Time) error {
+	var q domain.QuestionChanged
+	err := tx.QueryRowContext(ctx, `
+		UPDATE questions SET correct_answer = $2, updated_at = $3
+		WHERE id = $1 AND correct_answer IS DISTINCT FROM $2
+		RETURNING id, title, level, language, COALESCE(role, ''), topic_id, status, translation_group_id
+	`, questionID, correctAnswer, now).Scan(&q.QuestionID, &q.Title, &q.Level, &q.Language, &q.Role, &q.TopicID, &q.Status, &q.TranslationGroupID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("failed to update correct answer: %w", err)
 	}
-	return nil
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO question_revisions (question_id, revision_number, title, content, level, hint, correct_answer, author_id, created_at)
+		SELECT q.id, COALESCE((SELECT MAX(revision_number) FROM question_revisions WHERE question_id = q.id), 0) + 1,
+			q.title, q.content, q.level, q.hint, q.correct_answer, $2, $3
+		FROM questions q WHERE q.id = $1
+	`, questionID, nullableAuthor(actorID), now)
+	if err != nil {
+		return fmt.Errorf("failed to record question revision: %w", err)
+	}
+	return insertEvent(ctx, tx, domain.NewQuestionUpdatedEvent(q))
+}
+
+// SetAccepted marks an answer as the question's accepted answer, clearing any earlier one, or unmarks it.
+// The change is recorded as an answer.accepted or answer.unaccepted outbox event.
+func (r *AnswerRepository) SetAccepted(ctx context.Context, answer *domain.Answer, accepted bool) error {
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		tx := conn(ctx, r.db)
+		if _, err := lockQuestion(ctx, tx, answer.QuestionID); err != nil {
+			return err
+		}
+		var replacedID *uuid.UUID
+		if accepted {
+			var previous uuid.UUID
+			err := tx.QueryRowContext(ctx, `UPDATE answers SET is_accepted = FALSE WHERE question_id = $1 AND is_accepted AND id <> $2 RETURNING id`,
+				answer.QuestionID, answer.ID).Scan(&previous)
+			switch {
+			case err == nil:
+				replacedID = &previous
+			case err != sql.ErrNoRows:
+				return fmt.Errorf("failed to clear accepted answer: %w", err)
+			}
+		}
+		result, err := tx.ExecContext(ctx, `UPDATE answers SET is_accepted = $2 WHERE id = $1 AND deleted_at IS NULL`, answer.ID, accepted)
+		if err != nil {
+			return fmt.Errorf("failed to accept answer: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to check rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("answer not found")
+		}
+		return insertEvent(ctx, tx, domain.NewAnswerAcceptanceEvent(answer, accepted, replacedID))
+	})
+}
+
+// Promote makes a community or suggested answer canonical and copies its content into questions.correct_answer,
+// recorded as a question revision by actorID. The value it replaces is stored on the answer; if another
+// answer was promoted before, that one is demoted and hands over the value it had replaced,
+// so demoting restores the original.
+func (r *AnswerRepository) Promote(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error {
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		tx := conn(ctx, r.db)
+		previous, err := lockQuestion(ctx, tx, answer.QuestionID)
+		if err != nil {
+			return err
+		}
+
+		var promotedID uuid.UUID
+		var promotedPrevious sql.NullString
+		var demotedID *uuid.UUID
+		err = tx.QueryRowContext(ctx, `
+			SELECT id, previous_correct_answer FROM answers
+			WHERE question_id = $1 AND promoted_at IS NOT NULL AND id <> $2
+		`, answer.QuestionID, answer.ID).Scan(&promotedID, &promotedPrevious)
+		switch {
+		case err == nil:
+			previous, demotedID = promotedPrevious, &promotedID
+			_, err = tx.ExecContext(ctx, `
+				UPDATE answers SET answer_type = `+demotedType+`, promoted_at = NULL, previous_correct_answer = NULL WHERE id = $1
+			`, promotedID)
+			if err != nil {
+				return fmt.Errorf("failed to demote answer: %w", err)
+			}
+		case err != sql.ErrNoRows:
+			return fmt.Errorf("failed to get promoted answer: %w", err)
+		}
+
+		now := time.Now()
+		result, err := tx.ExecContext(ctx, `
+			UPDATE answers SET answer_type = $2, promoted_at = $3, previous_correct_answer = $4
+			WHERE id = $1 AND answer_type IN ($5, $6) AND deleted_at IS NULL
+		`, answer.ID, domain.AnswerTypeCanonical, now, previous, domain.AnswerTypeCommunity, domain.AnswerTypeSuggested)
+		if err != nil {
+			return fmt.Errorf("failed to promote answer: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to check rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("invalid promotion: only community and suggested answers can be promoted")
+		}
+
+		if err := setCorrectAnswer(ctx, tx, answer.QuestionID, sql.NullString{String: answer.Content, Valid: true}, actorID, now); err != nil {
+			return err
+		}
+		if err := insertEvent(ctx, tx, domain.NewAnswerPromotedEvent(answer, demotedID)); err != nil {
+			return err
+		}
+		answer.AnswerType, answer.PromotedAt = domain.AnswerTypeCanonical, &now
+		return nil
+	})
+}
+
+// demotedType is the answer_type a demoted canonical answer takes, as domain.Answer.DemotedType decides it
+const demotedType = `CASE WHEN created_by IS NULL THEN 'suggested' ELSE 'community' END`
+
+// Demote turns a canonical answer back into a community answer, or a suggestion if it was generated, recorded as an
+// answer.demoted event. If it was promoted, the correct answer it replaced is restored as a question revision by actorID,
+// unless the question's correct answer was edited since.
+func (r *AnswerRepository) Demote(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error {
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		tx := conn(ctx, r.db)
+		current, err := lockQuestion(ctx, tx, answer.QuestionID)
+		if err != nil {
+			return err
+		}
+
+		var promotedAt sql.NullTime
+		var previous sql.NullString
+		var content string
+		err = tx.QueryRowContext(ctx, `
+			SELECT promoted_at, previous_correct_answer, content FROM answers WHERE id = $1 AND answer_type = $2
+		`, answer.ID, domain.AnswerTypeCanonical).Scan(&promotedAt, &previous, &content)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("invalid demotion: answer is not canonical")
+			}
+			return fmt.Errorf("failed to get answer: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE answers SET answer_type = `+demotedType+`, promoted_at = NULL, previous_correct_answer = NULL WHERE id = $1
+		`, answer.ID)
+		if err != nil {
+			return fmt.Errorf("failed to demote answer: %w", err)
+		}
+		if promotedAt.Valid && current.Valid && current.String == content {
+			if err := setCorrectAnswer(ctx, tx, answer.QuestionID, previous, actorID, time.Now()); err != nil {
+				return err
+			}
+		}
+		if err := insertEvent(ctx, tx, domain.NewAnswerDemotedEvent(answer)); err != nil {
+			return err
+		}
+		answer.AnswerType, answer.PromotedAt = answer.DemotedType(), nil
+		return nil
+	})
 }
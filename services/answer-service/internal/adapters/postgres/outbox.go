@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/question-interviewer/answer-service/internal/domain"
+)
+
+// insertEvent appends an event to the outbox in the transaction of the change it describes
+func insertEvent(ctx context.Context, tx dbtx, event domain.Event) error {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event payload: %w", err)
+	}
+	// outbox_emit (migration 000036) assigns the event's ID and time
+	_, err = tx.ExecContext(ctx, `SELECT outbox_emit($1, $2, $3, $4)`, event.AggregateType, event.AggregateID, event.Type, payload)
+	if err != nil {
+		return fmt.Errorf("failed to write outbox event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/answer-service/internal/domain"
+	"github.com/question-interviewer/answer-service/internal/ports"
+)
+
+type AnswerRevisionRepository struct {
+	db *sql.DB
+}
+
+func NewAnswerRevisionRepository(db *sql.DB) ports.AnswerRevisionRepository {
+	return &AnswerRevisionRepository{
+		db: db,
+	}
+}
+
+func (r *AnswerRevisionRepository) Create(ctx context.Context, revision *domain.AnswerRevision) error {
+	// Revision numbers are sequential per answer; the unique constraint guards concurrent writers
+	query := `
+		INSERT INTO answer_revisions (id, answer_id, revision_number, content, author_id, created_at)
+		SELECT $1, $2, COALESCE(MAX(revision_number), 0) + 1, $3, $4, $5
+		FROM answer_revisions
+		WHERE answer_id = $2
+		RETURNING revision_number
+	`
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		revision.ID,
+		revision.AnswerID,
+		revision.Content,
+		nullableAuthor(revision.AuthorID),
+		revision.CreatedAt,
+	).Scan(&revision.RevisionNumber)
+	if err != nil {
+		return fmt.Errorf("failed to create answer revision: %w", err)
+	}
+	return nil
+}
+
+func (r *AnswerRevisionRepository) ListByAnswerID(ctx context.Context, answerID uuid.UUID) ([]*domain.AnswerRevision, error) {
+	query := `
+		SELECT id, answer_id, revision_number, content, author_id, created_at
+		FROM answer_revisions
+		WHERE answer_id = $1
+		ORDER BY revision_number ASC
+	`
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, answerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list answer revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []*domain.AnswerRevision{}
+	for rows.Next() {
+		var rev domain.AnswerRevision
+		var authorID uuid.NullUUID
+		if err := rows.Scan(&rev.ID, &rev.AnswerID, &rev.RevisionNumber, &rev.Content, &authorID, &rev.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan answer revision: %w", err)
+		}
+		rev.AuthorID = authorID.UUID
+		revisions = append(revisions, &rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return revisions, nil
+}
@@ -1,11 +1,17 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// ErrForbidden is returned when the caller may not change an answer
+var ErrForbidden = errors.New("not allowed to modify this answer")
+
 type AnswerType string
 
 const (
@@ -14,28 +20,108 @@ const (
 	AnswerTypeSuggested AnswerType = "suggested"
 )
 
+// ParseAnswerType validates an answer type
+func ParseAnswerType(s string) (AnswerType, error) {
+	switch t := AnswerType(strings.ToLower(strings.TrimSpace(s))); t {
+	case AnswerTypeCanonical, AnswerTypeCommunity, AnswerTypeSuggested:
+		return t, nil
+	default:
+		return "", fmt.Errorf("invalid answer_type: %s", s)
+	}
+}
+
+// LevelTarget is the seniority an answer is written for (answers.level_target)
+type LevelTarget string
+
+const (
+	LevelJunior LevelTarget = "junior"
+	LevelMid    LevelTarget = "mid"
+	LevelSenior LevelTarget = "senior"
+)
+
+// ParseLevelTarget maps a level target or a question level (Fresher, Junior, Mid, Senior) to a
+// LevelTarget, case-insensitively. Freshers read junior answers.
+func ParseLevelTarget(s string) (LevelTarget, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "fresher", "junior":
+		return LevelJunior, nil
+	case "mid":
+		return LevelMid, nil
+	case "senior":
+		return LevelSenior, nil
+	default:
+		return "", fmt.Errorf("invalid level_target: %s", s)
+	}
+}
+
 type Answer struct {
-	ID          uuid.UUID  `json:"id"`
-	QuestionID  uuid.UUID  `json:"question_id"`
-	Content     string     `json:"content"`
-	AuthorID    uuid.UUID  `json:"author_id"`
-	AnswerType  AnswerType `json:"answer_type"`
-	VoteCount   int        `json:"vote_count"`
-	IsAccepted  bool       `json:"is_accepted"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-}
-
-func NewAnswer(questionID, authorID uuid.UUID, content string, answerType AnswerType) *Answer {
+	ID          uuid.UUID   `json:"id"`
+	QuestionID  uuid.UUID   `json:"question_id"`
+	Content     string      `json:"content"`
+	AuthorID    uuid.UUID   `json:"author_id"`
+	AnswerType  AnswerType  `json:"answer_type"`
+	LevelTarget LevelTarget `json:"level_target"`
+	VoteCount   int         `json:"vote_count"`
+	IsAccepted  bool        `json:"is_accepted"`
+	PromotedAt  *time.Time  `json:"promoted_at,omitempty"` // Set while a community answer is promoted to canonical
+	Attribution string      `json:"attribution,omitempty"` // Source of a generated suggested answer, such as the AI service
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+func NewAnswer(questionID, authorID uuid.UUID, content string, answerType AnswerType, levelTarget LevelTarget) *Answer {
 	return &Answer{
-		ID:         uuid.New(),
-		QuestionID: questionID,
-		Content:    content,
-		AuthorID:   authorID,
-		AnswerType: answerType,
-		VoteCount:  0,
-		IsAccepted: false,
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+		ID:          uuid.New(),
+		QuestionID:  questionID,
+		Content:     content,
+		AuthorID:    authorID,
+		AnswerType:  answerType,
+		LevelTarget: levelTarget,
+		VoteCount:   0,
+		IsAccepted:  false,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 }
+
+// NewSuggestedAnswer creates a generated suggested answer, which has no author
+func NewSuggestedAnswer(questionID uuid.UUID, content string, levelTarget LevelTarget, attribution string) *Answer {
+	answer := NewAnswer(questionID, uuid.Nil, content, AnswerTypeSuggested, levelTarget)
+	answer.Attribution = attribution
+	return answer
+}
+
+// IsGenerated reports whether the answer was generated rather than written by a user
+func (a *Answer) IsGenerated() bool {
+	return a.AuthorID == uuid.Nil
+}
+
+// IsPromotable reports whether the answer can become canonical: community answers and suggestions can
+func (a *Answer) IsPromotable() bool {
+	return a.AnswerType == AnswerTypeCommunity || a.AnswerType == AnswerTypeSuggested
+}
+
+// DemotedType is the type a canonical answer returns to when demoted. Generated answers go back to
+// being suggestions, so each question still has at most one per level target; others become community.
+func (a *Answer) DemotedType() AnswerType {
+	if a.IsGenerated() {
+		return AnswerTypeSuggested
+	}
+	return AnswerTypeCommunity
+}
+
+// AnswerFilter selects a page of a question's answers
+type AnswerFilter struct {
+	QuestionID  uuid.UUID
+	LevelTarget LevelTarget // Empty for every level
+	AnswerType  AnswerType  // Empty for every type
+	Sort        AnswerSort
+	Limit       int
+	Offset      int
+}
+
+// AnswerPatch holds the fields of an answer to change; nil fields are left as they are
+type AnswerPatch struct {
+	Content     *string
+	LevelTarget *LevelTarget
+}
@@ -0,0 +1,121 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Event types answer-service appends to the outbox
+const (
+	EventAnswerAccepted   = "answer.accepted"
+	EventAnswerUnaccepted = "answer.unaccepted"
+	EventAnswerPromoted   = "answer.promoted"
+	EventAnswerDemoted    = "answer.demoted"
+	EventQuestionUpdated  = "question.updated" // When promoting, editing or demoting an answer changes the question's correct answer
+)
+
+// Event is a domain event written to the outbox in the same transaction as the change it describes.
+// The outbox assigns its ID and time.
+type Event struct {
+	AggregateType string
+	AggregateID   uuid.UUID
+	Type          string
+	Payload       interface{}
+}
+
+// AnswerAcceptance is the payload of answer.accepted and answer.unaccepted
+type AnswerAcceptance struct {
+	AnswerID   uuid.UUID  `json:"answer_id"`
+	QuestionID uuid.UUID  `json:"question_id"`
+	AuthorID   uuid.UUID  `json:"author_id"`
+	AnswerType AnswerType `json:"answer_type"`
+	// ReplacedAnswerID is the question's previously accepted answer, which accepting this one unaccepted
+	ReplacedAnswerID *uuid.UUID `json:"replaced_answer_id,omitempty"`
+}
+
+func NewAnswerAcceptanceEvent(answer *Answer, accepted bool, replacedAnswerID *uuid.UUID) Event {
+	eventType := EventAnswerAccepted
+	if !accepted {
+		eventType = EventAnswerUnaccepted
+	}
+	return Event{
+		AggregateType: "answer",
+		AggregateID:   answer.ID,
+		Type:          eventType,
+		Payload: AnswerAcceptance{
+			AnswerID:         answer.ID,
+			QuestionID:       answer.QuestionID,
+			AuthorID:         answer.AuthorID,
+			AnswerType:       answer.AnswerType,
+			ReplacedAnswerID: replacedAnswerID,
+		},
+	}
+}
+
+// AnswerPromotion is the payload of answer.promoted
+type AnswerPromotion struct {
+	AnswerID    uuid.UUID   `json:"answer_id"`
+	QuestionID  uuid.UUID   `json:"question_id"`
+	AuthorID    uuid.UUID   `json:"author_id"` // uuid.Nil for a generated suggestion
+	LevelTarget LevelTarget `json:"level_target"`
+	// DemotedAnswerID is the question's previously promoted answer, which promoting this one demoted
+	DemotedAnswerID *uuid.UUID `json:"demoted_answer_id,omitempty"`
+}
+
+func NewAnswerPromotedEvent(answer *Answer, demotedAnswerID *uuid.UUID) Event {
+	return Event{
+		AggregateType: "answer",
+		AggregateID:   answer.ID,
+		Type:          EventAnswerPromoted,
+		Payload: AnswerPromotion{
+			AnswerID:        answer.ID,
+			QuestionID:      answer.QuestionID,
+			AuthorID:        answer.AuthorID,
+			LevelTarget:     answer.LevelTarget,
+			DemotedAnswerID: demotedAnswerID,
+		},
+	}
+}
+
+// AnswerDemotion is the payload of answer.demoted
+type AnswerDemotion struct {
+	AnswerID   uuid.UUID  `json:"answer_id"`
+	QuestionID uuid.UUID  `json:"question_id"`
+	AuthorID   uuid.UUID  `json:"author_id"`   // uuid.Nil for a generated suggestion
+	AnswerType AnswerType `json:"answer_type"` // The type it was demoted to
+}
+
+func NewAnswerDemotedEvent(answer *Answer) Event {
+	return Event{
+		AggregateType: "answer",
+		AggregateID:   answer.ID,
+		Type:          EventAnswerDemoted,
+		Payload: AnswerDemotion{
+			AnswerID:   answer.ID,
+			QuestionID: answer.QuestionID,
+			AuthorID:   answer.AuthorID,
+			AnswerType: answer.DemotedType(),
+		},
+	}
+}
+
+// QuestionChanged is the payload of question.updated, matching question-service's
+type QuestionChanged struct {
+	QuestionID         uuid.UUID `json:"question_id"`
+	Title              string    `json:"title"`
+	Level              string    `json:"level"`
+	Language           string    `json:"language"`
+	Role               string    `json:"role"`
+	TopicID            uuid.UUID `json:"topic_id"`
+	Status             string    `json:"status"`
+	PreviousStatus     string    `json:"previous_status"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+}
+
+// NewQuestionUpdatedEvent describes a question whose correct answer changed; its status did not
+func NewQuestionUpdatedEvent(question QuestionChanged) Event {
+	question.PreviousStatus = question.Status
+	return Event{
+		AggregateType: "question",
+		AggregateID:   question.QuestionID,
+		Type:          EventQuestionUpdated,
+		Payload:       question,
+	}
+}
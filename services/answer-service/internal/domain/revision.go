@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnswerRevision is a snapshot of an answer's content, taken when it is posted and on every edit
+type AnswerRevision struct {
+	ID             uuid.UUID `json:"id"`
+	AnswerID       uuid.UUID `json:"answer_id"`
+	RevisionNumber int       `json:"revision_number"`
+	Content        string    `json:"content"`
+	AuthorID       uuid.UUID `json:"author_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// NewAnswerRevision snapshots the answer; the repository assigns RevisionNumber
+func NewAnswerRevision(a *Answer, authorID uuid.UUID) *AnswerRevision {
+	return &AnswerRevision{
+		ID:        uuid.New(),
+		AnswerID:  a.ID,
+		Content:   a.Content,
+		AuthorID:  authorID,
+		CreatedAt: time.Now(),
+	}
+}
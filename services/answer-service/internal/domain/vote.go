@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Vote values stored in votes.value; each user has at most one vote per answer
+const (
+	VoteUp   = 1
+	VoteDown = -1
+)
+
+// AnswerSort orders a question's answers
+type AnswerSort string
+
+const (
+	SortByScore  AnswerSort = "score" // Highest vote count first, newest first on a tie (default)
+	SortByNewest AnswerSort = "newest"
+	SortByOldest AnswerSort = "oldest"
+)
+
+// ParseAnswerSort maps a sort query parameter to an AnswerSort, defaulting to SortByScore
+func ParseAnswerSort(s string) (AnswerSort, error) {
+	switch sort := AnswerSort(s); sort {
+	case "":
+		return SortByScore, nil
+	case SortByScore, SortByNewest, SortByOldest:
+		return sort, nil
+	default:
+		return "", fmt.Errorf("invalid sort: %s", s)
+	}
+}
+
+// VoteResult is an answer's score after a user voted on it
+type VoteResult struct {
+	AnswerID  uuid.UUID `json:"answer_id"`
+	VoteCount int       `json:"vote_count"`
+	UserVote  int       `json:"user_vote"` // The user's vote now: 1, -1 or 0 when removed
+}
@@ -9,14 +9,53 @@ import (
 
 type AnswerRepository interface {
 	Create(ctx context.Context, answer *domain.Answer) error
+	CreateSuggested(ctx context.Context, answer *domain.Answer) (bool, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Answer, error)
-	ListByQuestionID(ctx context.Context, questionID uuid.UUID, limit, offset int) ([]*domain.Answer, error)
-	Update(ctx context.Context, answer *domain.Answer) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	ListByQuestionID(ctx context.Context, filter domain.AnswerFilter) ([]*domain.Answer, error)
+	// Update, Promote and Demote also update questions.correct_answer when it follows the answer,
+	// recording a question revision by actorID in the same transaction
+	Update(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error
+	// Delete soft-deletes; deleted answers are no longer returned
+	Delete(ctx context.Context, id, deletedBy uuid.UUID) error
+	// SetVote stores the user's vote (0 removes it) and returns the answer's new vote count
+	SetVote(ctx context.Context, answerID, userID uuid.UUID, value int) (int, error)
+	GetQuestionAuthor(ctx context.Context, questionID uuid.UUID) (uuid.UUID, error)
+	SetAccepted(ctx context.Context, answer *domain.Answer, accepted bool) error
+	Promote(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error
+	Demote(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error
+}
+
+// AnswerRevisionRepository stores the content history of answers
+type AnswerRevisionRepository interface {
+	Create(ctx context.Context, revision *domain.AnswerRevision) error
+	ListByAnswerID(ctx context.Context, answerID uuid.UUID) ([]*domain.AnswerRevision, error)
 }
 
 type AnswerService interface {
-	CreateAnswer(ctx context.Context, questionID, authorID uuid.UUID, content string, answerType domain.AnswerType) (*domain.Answer, error)
+	CreateAnswer(ctx context.Context, questionID, authorID uuid.UUID, content string, answerType domain.AnswerType, levelTarget domain.LevelTarget) (*domain.Answer, error)
+	// PublishSuggestedAnswer stores a generated answer for other services; it fails with "suggested answer
+	// already published" when the question has one for the level target, even a deleted or promoted one
+	PublishSuggestedAnswer(ctx context.Context, questionID uuid.UUID, content string, levelTarget domain.LevelTarget, attribution string) (*domain.Answer, error)
 	GetAnswer(ctx context.Context, id uuid.UUID) (*domain.Answer, error)
-	ListAnswersForQuestion(ctx context.Context, questionID uuid.UUID, limit, offset int) ([]*domain.Answer, error)
+	UpdateAnswer(ctx context.Context, id uuid.UUID, patch domain.AnswerPatch, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error)
+	DeleteAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) error
+	ListRevisions(ctx context.Context, id uuid.UUID) ([]*domain.AnswerRevision, error)
+	ListAnswersForQuestion(ctx context.Context, filter domain.AnswerFilter) ([]*domain.Answer, error)
+	Vote(ctx context.Context, answerID, userID uuid.UUID, value int) (*domain.VoteResult, error)
+	Unvote(ctx context.Context, answerID, userID uuid.UUID) (*domain.VoteResult, error)
+	AcceptAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error)
+	UnacceptAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error)
+	PromoteAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error)
+	DemoteAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error)
+}
+
+// Transactor runs fn in one transaction; repository calls made with the context fn receives
+// join it, and nothing fn wrote is kept if it returns an error
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// RoleReader reads a user's current role, so role changes apply before the user's token expires
+type RoleReader interface {
+	GetUserRole(ctx context.Context, userID uuid.UUID) (domain.Role, error)
 }
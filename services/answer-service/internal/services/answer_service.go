@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/question-interviewer/answer-service/internal/domain"
@@ -9,19 +11,59 @@ import (
 )
 
 type answerService struct {
-	repo ports.AnswerRepository
+	repo         ports.AnswerRepository
+	revisionRepo ports.AnswerRevisionRepository
+	tx           ports.Transactor
 }
 
-func NewAnswerService(repo ports.AnswerRepository) ports.AnswerService {
+func NewAnswerService(repo ports.AnswerRepository, revisionRepo ports.AnswerRevisionRepository, tx ports.Transactor) ports.AnswerService {
 	return &answerService{
-		repo: repo,
+		repo:         repo,
+		revisionRepo: revisionRepo,
+		tx:           tx,
 	}
 }
 
-func (s *answerService) CreateAnswer(ctx context.Context, questionID, authorID uuid.UUID, content string, answerType domain.AnswerType) (*domain.Answer, error) {
+// CreateAnswer posts an answer; answers without a level target are written for mid-level candidates
+func (s *answerService) CreateAnswer(ctx context.Context, questionID, authorID uuid.UUID, content string, answerType domain.AnswerType, levelTarget domain.LevelTarget) (*domain.Answer, error) {
 	// TODO: Validate questionID exists via QuestionService (gRPC/HTTP call)
-	answer := domain.NewAnswer(questionID, authorID, content, answerType)
-	if err := s.repo.Create(ctx, answer); err != nil {
+	if levelTarget == "" {
+		levelTarget = domain.LevelMid
+	}
+	answer := domain.NewAnswer(questionID, authorID, content, answerType, levelTarget)
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, answer); err != nil {
+			return err
+		}
+		return s.revisionRepo.Create(ctx, domain.NewAnswerRevision(answer, authorID))
+	}); err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+// PublishSuggestedAnswer stores a generated sample answer as a suggested answer without an author. Users vote
+// on it and moderators delete it or promote it to canonical. Each question gets at most one per level
+// target, counting deleted and promoted ones, so a removed suggestion is not published again.
+func (s *answerService) PublishSuggestedAnswer(ctx context.Context, questionID uuid.UUID, content string, levelTarget domain.LevelTarget, attribution string) (*domain.Answer, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("invalid answer: content is required")
+	}
+	if levelTarget == "" {
+		levelTarget = domain.LevelMid
+	}
+	answer := domain.NewSuggestedAnswer(questionID, content, levelTarget, strings.TrimSpace(attribution))
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		created, err := s.repo.CreateSuggested(ctx, answer)
+		if err != nil {
+			return err
+		}
+		if !created {
+			return fmt.Errorf("suggested answer already published")
+		}
+		return s.revisionRepo.Create(ctx, domain.NewAnswerRevision(answer, uuid.Nil))
+	}); err != nil {
 		return nil, err
 	}
 	return answer, nil
@@ -31,6 +73,172 @@ func (s *answerService) GetAnswer(ctx context.Context, id uuid.UUID) (*domain.An
 	return s.repo.GetByID(ctx, id)
 }
 
-func (s *answerService) ListAnswersForQuestion(ctx context.Context, questionID uuid.UUID, limit, offset int) ([]*domain.Answer, error) {
-	return s.repo.ListByQuestionID(ctx, questionID, limit, offset)
+// UpdateAnswer edits an answer's content or level target; new content is recorded as a revision. Authors
+// edit their own answers; canonical answers, which feed the question's correct answer, need a moderator.
+func (s *answerService) UpdateAnswer(ctx context.Context, id uuid.UUID, patch domain.AnswerPatch, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error) {
+	if patch.Content != nil {
+		content := strings.TrimSpace(*patch.Content)
+		if content == "" {
+			return nil, fmt.Errorf("invalid answer: content is required")
+		}
+		patch.Content = &content
+	}
+	answer, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !actorRole.IsModerator() {
+		if answer.AuthorID != actorID {
+			return nil, fmt.Errorf("%w: only the author or a moderator can edit an answer", domain.ErrForbidden)
+		}
+		if answer.AnswerType == domain.AnswerTypeCanonical {
+			return nil, fmt.Errorf("%w: only moderators can edit canonical answers", domain.ErrForbidden)
+		}
+	}
+	contentChanged := patch.Content != nil && *patch.Content != answer.Content
+	levelChanged := patch.LevelTarget != nil && *patch.LevelTarget != answer.LevelTarget
+	if !contentChanged && !levelChanged {
+		return answer, nil
+	}
+
+	if contentChanged {
+		answer.Content = *patch.Content
+	}
+	if levelChanged {
+		answer.LevelTarget = *patch.LevelTarget
+	}
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, answer, actorID); err != nil {
+			return err
+		}
+		if !contentChanged {
+			return nil
+		}
+		return s.revisionRepo.Create(ctx, domain.NewAnswerRevision(answer, actorID))
+	}); err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+// DeleteAnswer soft-deletes an answer. A promoted answer is demoted first, in the same transaction,
+// so the question gets back the correct answer it replaced.
+func (s *answerService) DeleteAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) error {
+	answer, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !actorRole.IsModerator() && answer.AuthorID != actorID {
+		return fmt.Errorf("%w: only the author or a moderator can delete an answer", domain.ErrForbidden)
+	}
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if answer.PromotedAt != nil {
+			if err := s.repo.Demote(ctx, answer, actorID); err != nil {
+				return err
+			}
+		}
+		return s.repo.Delete(ctx, id, actorID)
+	})
+}
+
+// ListRevisions returns an answer's content history, oldest first
+func (s *answerService) ListRevisions(ctx context.Context, id uuid.UUID) ([]*domain.AnswerRevision, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.revisionRepo.ListByAnswerID(ctx, id)
+}
+
+func (s *answerService) ListAnswersForQuestion(ctx context.Context, filter domain.AnswerFilter) ([]*domain.Answer, error) {
+	return s.repo.ListByQuestionID(ctx, filter)
+}
+
+// Vote sets the user's vote on an answer to VoteUp or VoteDown, replacing any earlier vote
+func (s *answerService) Vote(ctx context.Context, answerID, userID uuid.UUID, value int) (*domain.VoteResult, error) {
+	if value != domain.VoteUp && value != domain.VoteDown {
+		return nil, fmt.Errorf("invalid vote: %d", value)
+	}
+	return s.setVote(ctx, answerID, userID, value)
+}
+
+// Unvote removes the user's vote on an answer, if any
+func (s *answerService) Unvote(ctx context.Context, answerID, userID uuid.UUID) (*domain.VoteResult, error) {
+	return s.setVote(ctx, answerID, userID, 0)
+}
+
+func (s *answerService) setVote(ctx context.Context, answerID, userID uuid.UUID, value int) (*domain.VoteResult, error) {
+	voteCount, err := s.repo.SetVote(ctx, answerID, userID, value)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.VoteResult{AnswerID: answerID, VoteCount: voteCount, UserVote: value}, nil
+}
+
+// AcceptAnswer marks an answer as accepted, replacing the question's earlier accepted answer
+func (s *answerService) AcceptAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error) {
+	return s.setAccepted(ctx, id, actorID, actorRole, true)
+}
+
+// UnacceptAnswer removes an answer's acceptance
+func (s *answerService) UnacceptAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error) {
+	return s.setAccepted(ctx, id, actorID, actorRole, false)
+}
+
+// setAccepted is allowed to the question's author and to moderators
+func (s *answerService) setAccepted(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role, accepted bool) (*domain.Answer, error) {
+	answer, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !actorRole.IsModerator() {
+		authorID, err := s.repo.GetQuestionAuthor(ctx, answer.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+		if authorID != actorID {
+			return nil, fmt.Errorf("%w: only the question's author or a moderator can accept answers", domain.ErrForbidden)
+		}
+	}
+	if err := s.repo.SetAccepted(ctx, answer, accepted); err != nil {
+		return nil, err
+	}
+	answer.IsAccepted = accepted
+	return answer, nil
+}
+
+// PromoteAnswer makes a community or suggested answer the question's canonical answer and its correct answer
+func (s *answerService) PromoteAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error) {
+	if !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can promote answers", domain.ErrForbidden)
+	}
+	answer, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !answer.IsPromotable() {
+		return nil, fmt.Errorf("invalid promotion: only community and suggested answers can be promoted")
+	}
+	if err := s.repo.Promote(ctx, answer, actorID); err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+// DemoteAnswer turns a canonical answer back into a community answer, or a suggestion if it was generated,
+// restoring the correct answer it replaced
+func (s *answerService) DemoteAnswer(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) (*domain.Answer, error) {
+	if !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can demote answers", domain.ErrForbidden)
+	}
+	answer, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if answer.AnswerType != domain.AnswerTypeCanonical {
+		return nil, fmt.Errorf("invalid demotion: answer is not canonical")
+	}
+	if err := s.repo.Demote(ctx, answer, actorID); err != nil {
+		return nil, err
+	}
+	return answer, nil
 }
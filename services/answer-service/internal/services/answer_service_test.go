@@ -0,0 +1,472 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/answer-service/internal/domain"
+	"github.com/question-interviewer/answer-service/internal/ports"
+)
+
+// fakeTx marks the context it passes on, so fakes can tell which calls ran in a transaction
+type fakeTx struct{}
+
+type inTxKey struct{}
+
+func (fakeTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, inTxKey{}, true))
+}
+
+func inTx(ctx context.Context) bool {
+	return ctx.Value(inTxKey{}) != nil
+}
+
+type fakeQuestion struct {
+	authorID      uuid.UUID
+	correctAnswer string
+	revisedBy     []uuid.UUID // Authors of the question revisions recorded for correct answer changes
+}
+
+// setCorrectAnswer mirrors the repository: a change is recorded as a question revision by actorID
+func (q *fakeQuestion) setCorrectAnswer(correctAnswer string, actorID uuid.UUID) {
+	if q.correctAnswer != correctAnswer {
+		q.correctAnswer = correctAnswer
+		q.revisedBy = append(q.revisedBy, actorID)
+	}
+}
+
+type fakeAnswerRepo struct {
+	answers     map[uuid.UUID]*domain.Answer
+	votes       map[[2]uuid.UUID]int // (answer, user) -> value
+	questions   map[uuid.UUID]*fakeQuestion
+	replaced    map[uuid.UUID]string // promoted answer -> correct answer it replaced
+	deleted     map[uuid.UUID]uuid.UUID
+	demotedInTx bool // whether the last demotion joined the caller's transaction
+}
+
+func newFakeAnswerRepo() *fakeAnswerRepo {
+	return &fakeAnswerRepo{
+		answers:   make(map[uuid.UUID]*domain.Answer),
+		votes:     make(map[[2]uuid.UUID]int),
+		questions: make(map[uuid.UUID]*fakeQuestion),
+		replaced:  make(map[uuid.UUID]string),
+		deleted:   make(map[uuid.UUID]uuid.UUID),
+	}
+}
+
+func (r *fakeAnswerRepo) Create(ctx context.Context, answer *domain.Answer) error {
+	r.answers[answer.ID] = answer
+	return nil
+}
+func (r *fakeAnswerRepo) CreateSuggested(ctx context.Context, answer *domain.Answer) (bool, error) {
+	// Mirrors idx_answers_one_generated_suggestion, which also counts deleted and promoted answers
+	for _, a := range r.answers {
+		if a.QuestionID == answer.QuestionID && a.LevelTarget == answer.LevelTarget && a.IsGenerated() &&
+			(a.AnswerType == domain.AnswerTypeSuggested || a.AnswerType == domain.AnswerTypeCanonical) {
+			return false, nil
+		}
+	}
+	r.answers[answer.ID] = answer
+	return true, nil
+}
+func (r *fakeAnswerRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Answer, error) {
+	a, ok := r.answers[id]
+	if _, deleted := r.deleted[id]; !ok || deleted {
+		return nil, fmt.Errorf("answer not found")
+	}
+	copied := *a
+	return &copied, nil
+}
+func (r *fakeAnswerRepo) ListByQuestionID(ctx context.Context, filter domain.AnswerFilter) ([]*domain.Answer, error) {
+	var answers []*domain.Answer
+	for _, a := range r.answers {
+		if a.QuestionID == filter.QuestionID && (filter.LevelTarget == "" || a.LevelTarget == filter.LevelTarget) &&
+			(filter.AnswerType == "" || a.AnswerType == filter.AnswerType) {
+			answers = append(answers, a)
+		}
+	}
+	return answers, nil
+}
+func (r *fakeAnswerRepo) Update(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error {
+	previous, ok := r.answers[answer.ID]
+	if !ok {
+		return fmt.Errorf("answer not found")
+	}
+	if q := r.questions[answer.QuestionID]; previous.PromotedAt != nil && q.correctAnswer == previous.Content {
+		q.setCorrectAnswer(answer.Content, actorID)
+	}
+	// Like the repository, only content and level target are written; the rest comes back as stored
+	previous.Content, previous.LevelTarget, previous.UpdatedAt = answer.Content, answer.LevelTarget, time.Now()
+	*answer = *previous
+	return nil
+}
+func (r *fakeAnswerRepo) Delete(ctx context.Context, id, deletedBy uuid.UUID) error {
+	if !inTx(ctx) {
+		return errors.New("delete outside a transaction")
+	}
+	r.deleted[id] = deletedBy
+	r.answers[id].IsAccepted = false
+	return nil
+}
+func (r *fakeAnswerRepo) SetVote(ctx context.Context, answerID, userID uuid.UUID, value int) (int, error) {
+	a, ok := r.answers[answerID]
+	if !ok {
+		return 0, fmt.Errorf("answer not found")
+	}
+	key := [2]uuid.UUID{answerID, userID}
+	a.VoteCount += value - r.votes[key]
+	if value == 0 {
+		delete(r.votes, key)
+	} else {
+		r.votes[key] = value
+	}
+	return a.VoteCount, nil
+}
+
+func (r *fakeAnswerRepo) GetQuestionAuthor(ctx context.Context, questionID uuid.UUID) (uuid.UUID, error) {
+	q, ok := r.questions[questionID]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("question not found")
+	}
+	return q.authorID, nil
+}
+func (r *fakeAnswerRepo) SetAccepted(ctx context.Context, answer *domain.Answer, accepted bool) error {
+	for _, a := range r.answers {
+		if a.QuestionID == answer.QuestionID && accepted {
+			a.IsAccepted = false
+		}
+	}
+	r.answers[answer.ID].IsAccepted = accepted
+	return nil
+}
+func (r *fakeAnswerRepo) Promote(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error {
+	q := r.questions[answer.QuestionID]
+	previous := q.correctAnswer
+	for id, p := range r.replaced {
+		if r.answers[id].QuestionID == answer.QuestionID {
+			previous = p
+			r.answers[id].AnswerType, r.answers[id].PromotedAt = r.answers[id].DemotedType(), nil
+			delete(r.replaced, id)
+		}
+	}
+	now := time.Now()
+	r.replaced[answer.ID] = previous
+	q.setCorrectAnswer(answer.Content, actorID)
+	answer.AnswerType, answer.PromotedAt = domain.AnswerTypeCanonical, &now
+	r.answers[answer.ID] = answer
+	return nil
+}
+func (r *fakeAnswerRepo) Demote(ctx context.Context, answer *domain.Answer, actorID uuid.UUID) error {
+	r.demotedInTx = inTx(ctx)
+	if previous, ok := r.replaced[answer.ID]; ok {
+		if q := r.questions[answer.QuestionID]; q.correctAnswer == answer.Content {
+			q.setCorrectAnswer(previous, actorID)
+		}
+		delete(r.replaced, answer.ID)
+	}
+	answer.AnswerType, answer.PromotedAt = answer.DemotedType(), nil
+	r.answers[answer.ID] = answer
+	return nil
+}
+
+var _ ports.AnswerRepository = (*fakeAnswerRepo)(nil)
+
+type fakeRevisionRepo struct {
+	revisions []*domain.AnswerRevision
+}
+
+func (r *fakeRevisionRepo) Create(ctx context.Context, revision *domain.AnswerRevision) error {
+	// Revisions are written in the transaction of the answer change they record
+	if !inTx(ctx) {
+		return errors.New("revision outside a transaction")
+	}
+	revision.RevisionNumber = 1
+	for _, rev := range r.revisions {
+		if rev.AnswerID == revision.AnswerID {
+			revision.RevisionNumber++
+		}
+	}
+	r.revisions = append(r.revisions, revision)
+	return nil
+}
+func (r *fakeRevisionRepo) ListByAnswerID(ctx context.Context, answerID uuid.UUID) ([]*domain.AnswerRevision, error) {
+	revisions := []*domain.AnswerRevision{}
+	for _, rev := range r.revisions {
+		if rev.AnswerID == answerID {
+			revisions = append(revisions, rev)
+		}
+	}
+	return revisions, nil
+}
+
+var _ ports.AnswerRevisionRepository = (*fakeRevisionRepo)(nil)
+
+func TestVote_OneVotePerUser(t *testing.T) {
+	repo := newFakeAnswerRepo()
+	svc := NewAnswerService(repo, &fakeRevisionRepo{}, fakeTx{})
+	ctx := context.Background()
+	answer, _ := svc.CreateAnswer(ctx, uuid.New(), uuid.New(), "Use a buffered channel", domain.AnswerTypeCommunity, "")
+	alice, bob := uuid.New(), uuid.New()
+
+	if _, err := svc.Vote(ctx, answer.ID, alice, 2); err == nil {
+		t.Fatal("expected a vote other than +1 or -1 to be rejected")
+	}
+	if _, err := svc.Vote(ctx, uuid.New(), alice, domain.VoteUp); err == nil || err.Error() != "answer not found" {
+		t.Fatalf("expected answer not found, got %v", err)
+	}
+
+	steps := []struct {
+		user  uuid.UUID
+		value int
+		want  int
+	}{
+		{alice, domain.VoteUp, 1},
+		{alice, domain.VoteUp, 1}, // Voting the same way twice counts once
+		{bob, domain.VoteUp, 2},
+		{alice, domain.VoteDown, 0}, // Changing a vote moves the count by two
+		{bob, 0, -1},
+		{bob, 0, -1},
+	}
+	for i, step := range steps {
+		var result *domain.VoteResult
+		var err error
+		if step.value == 0 {
+			result, err = svc.Unvote(ctx, answer.ID, step.user)
+		} else {
+			result, err = svc.Vote(ctx, answer.ID, step.user, step.value)
+		}
+		if err != nil || result.VoteCount != step.want || result.UserVote != step.value {
+			t.Fatalf("step %d: expected count %d and vote %d, got %+v (%v)", i, step.want, step.value, result, err)
+		}
+	}
+}
+
+func TestAcceptAndPromoteAnswers(t *testing.T) {
+	repo := newFakeAnswerRepo()
+	svc := NewAnswerService(repo, &fakeRevisionRepo{}, fakeTx{})
+	ctx := context.Background()
+	questionID, asker, moderator := uuid.New(), uuid.New(), uuid.New()
+	repo.questions[questionID] = &fakeQuestion{authorID: asker, correctAnswer: "Original"}
+	first, _ := svc.CreateAnswer(ctx, questionID, uuid.New(), "First", domain.AnswerTypeCommunity, "")
+	second, _ := svc.CreateAnswer(ctx, questionID, uuid.New(), "Second", domain.AnswerTypeCommunity, "")
+
+	// Only the question's author or a moderator may accept, and accepting moves the acceptance
+	if _, err := svc.AcceptAnswer(ctx, first.ID, first.AuthorID, domain.RoleUser); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected the answer's own author to be forbidden, got %v", err)
+	}
+	if _, err := svc.AcceptAnswer(ctx, first.ID, asker, domain.RoleUser); err != nil {
+		t.Fatalf("expected the question's author to accept, got %v", err)
+	}
+	if _, err := svc.AcceptAnswer(ctx, second.ID, uuid.New(), domain.RoleModerator); err != nil {
+		t.Fatalf("expected a moderator to accept, got %v", err)
+	}
+	if repo.answers[first.ID].IsAccepted || !repo.answers[second.ID].IsAccepted {
+		t.Fatal("expected only the second answer to be accepted")
+	}
+
+	if _, err := svc.PromoteAnswer(ctx, first.ID, moderator, domain.RoleContributor); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected contributors to be forbidden, got %v", err)
+	}
+	promoted, err := svc.PromoteAnswer(ctx, first.ID, moderator, domain.RoleModerator)
+	if err != nil || promoted.AnswerType != domain.AnswerTypeCanonical || repo.questions[questionID].correctAnswer != "First" {
+		t.Fatalf("expected the first answer to become the correct answer, got %+v (%v)", promoted, err)
+	}
+	if _, err := svc.PromoteAnswer(ctx, first.ID, moderator, domain.RoleModerator); err == nil {
+		t.Fatal("expected a canonical answer not to be promoted again")
+	}
+
+	// Promoting another answer replaces the first; demoting it brings back the original value
+	if _, err := svc.PromoteAnswer(ctx, second.ID, moderator, domain.RoleModerator); err != nil || repo.answers[first.ID].AnswerType != domain.AnswerTypeCommunity {
+		t.Fatalf("expected the first answer to return to community, got %v", err)
+	}
+	if _, err := svc.DemoteAnswer(ctx, second.ID, moderator, domain.RoleModerator); err != nil || repo.questions[questionID].correctAnswer != "Original" {
+		t.Fatalf("expected the original correct answer back, got %q (%v)", repo.questions[questionID].correctAnswer, err)
+	}
+	if _, err := svc.DemoteAnswer(ctx, second.ID, moderator, domain.RoleModerator); err == nil {
+		t.Fatal("expected a community answer not to be demoted")
+	}
+	// Every change to the correct answer was recorded as a question revision by the moderator
+	if revised := repo.questions[questionID].revisedBy; len(revised) != 3 || revised[0] != moderator || revised[2] != moderator {
+		t.Fatalf("expected three question revisions by the moderator, got %v", revised)
+	}
+}
+
+func TestUpdateAndDeleteAnswers(t *testing.T) {
+	repo := newFakeAnswerRepo()
+	svc := NewAnswerService(repo, &fakeRevisionRepo{}, fakeTx{})
+	ctx := context.Background()
+	questionID, author, moderator := uuid.New(), uuid.New(), uuid.New()
+	repo.questions[questionID] = &fakeQuestion{authorID: uuid.New(), correctAnswer: "Original"}
+	answer, _ := svc.CreateAnswer(ctx, questionID, author, "Draft", domain.AnswerTypeCommunity, "")
+
+	if _, err := svc.UpdateAnswer(ctx, answer.ID, domain.AnswerPatch{Content: strPtr("Hijacked")}, uuid.New(), domain.RoleUser); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected other users to be forbidden, got %v", err)
+	}
+	if _, err := svc.UpdateAnswer(ctx, answer.ID, domain.AnswerPatch{Content: strPtr("  ")}, author, domain.RoleUser); err == nil {
+		t.Fatal("expected empty content to be rejected")
+	}
+	if _, err := svc.UpdateAnswer(ctx, answer.ID, domain.AnswerPatch{Content: strPtr(" Final ")}, author, domain.RoleUser); err != nil {
+		t.Fatalf("expected the author to edit, got %v", err)
+	}
+	if _, err := svc.UpdateAnswer(ctx, answer.ID, domain.AnswerPatch{Content: strPtr("Final")}, author, domain.RoleUser); err != nil {
+		t.Fatalf("expected an unchanged edit to succeed, got %v", err)
+	}
+	revisions, _ := svc.ListRevisions(ctx, answer.ID)
+	if len(revisions) != 2 || revisions[0].Content != "Draft" || revisions[1].Content != "Final" || revisions[1].RevisionNumber != 2 {
+		t.Fatalf("expected the posted and edited content as revisions, got %+v", revisions)
+	}
+
+	// Once promoted, only moderators edit it, and deleting it gives the question its correct answer back
+	if _, err := svc.PromoteAnswer(ctx, answer.ID, moderator, domain.RoleModerator); err != nil {
+		t.Fatalf("expected promotion, got %v", err)
+	}
+	if _, err := svc.UpdateAnswer(ctx, answer.ID, domain.AnswerPatch{Content: strPtr("Changed")}, author, domain.RoleUser); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected authors not to edit canonical answers, got %v", err)
+	}
+	// A moderator's edit carries over to the correct answer as a question revision
+	if _, err := svc.UpdateAnswer(ctx, answer.ID, domain.AnswerPatch{Content: strPtr("Polished")}, moderator, domain.RoleModerator); err != nil {
+		t.Fatalf("expected a moderator to edit, got %v", err)
+	}
+	if q := repo.questions[questionID]; q.correctAnswer != "Polished" || len(q.revisedBy) != 2 || q.revisedBy[1] != moderator {
+		t.Fatalf("expected the edit as the correct answer and a question revision, got %q %v", q.correctAnswer, q.revisedBy)
+	}
+	if err := svc.DeleteAnswer(ctx, answer.ID, uuid.New(), domain.RoleUser); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected other users not to delete, got %v", err)
+	}
+	if err := svc.DeleteAnswer(ctx, answer.ID, moderator, domain.RoleModerator); err != nil {
+		t.Fatalf("expected a moderator to delete, got %v", err)
+	}
+	if repo.deleted[answer.ID] != moderator || repo.questions[questionID].correctAnswer != "Original" || !repo.demotedInTx {
+		t.Fatalf("expected a soft delete that restores the correct answer in one transaction, got %q", repo.questions[questionID].correctAnswer)
+	}
+	if _, err := svc.GetAnswer(ctx, answer.ID); err == nil {
+		t.Fatal("expected a deleted answer to be hidden")
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestUpdateAnswer_KeepsAcceptanceAndVotes(t *testing.T) {
+	repo := newFakeAnswerRepo()
+	svc := NewAnswerService(repo, &fakeRevisionRepo{}, fakeTx{})
+	ctx := context.Background()
+	questionID, asker, author := uuid.New(), uuid.New(), uuid.New()
+	repo.questions[questionID] = &fakeQuestion{authorID: asker}
+	answer, _ := svc.CreateAnswer(ctx, questionID, author, "Draft", domain.AnswerTypeCommunity, "")
+
+	// The caller's copy goes stale while the answer is accepted and voted on
+	stale, _ := svc.GetAnswer(ctx, answer.ID)
+	if _, err := svc.AcceptAnswer(ctx, answer.ID, asker, domain.RoleUser); err != nil {
+		t.Fatalf("AcceptAnswer: %v", err)
+	}
+	if _, err := svc.Vote(ctx, answer.ID, asker, domain.VoteUp); err != nil {
+		t.Fatalf("Vote: %v", err)
+	}
+	stale.Content = "Edited"
+	if err := repo.Update(ctx, stale, author); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	saved := repo.answers[answer.ID]
+	if saved.Content != "Edited" || !saved.IsAccepted || saved.VoteCount != 1 || saved.AnswerType != domain.AnswerTypeCommunity {
+		t.Fatalf("expected only the content to change, got %+v", saved)
+	}
+	if !stale.IsAccepted || stale.VoteCount != 1 {
+		t.Fatalf("expected the caller's copy to be refreshed, got %+v", stale)
+	}
+}
+
+func TestLevelTargetedAnswers(t *testing.T) {
+	repo := newFakeAnswerRepo()
+	svc := NewAnswerService(repo, &fakeRevisionRepo{}, fakeTx{})
+	ctx := context.Background()
+	questionID, author := uuid.New(), uuid.New()
+	mid, _ := svc.CreateAnswer(ctx, questionID, author, "Use a mutex", domain.AnswerTypeCommunity, "")
+	senior, _ := svc.CreateAnswer(ctx, questionID, author, "Compare lock-free designs", domain.AnswerTypeCommunity, domain.LevelSenior)
+	if mid.LevelTarget != domain.LevelMid || senior.LevelTarget != domain.LevelSenior {
+		t.Fatalf("expected mid by default and senior as given, got %s and %s", mid.LevelTarget, senior.LevelTarget)
+	}
+
+	junior := domain.LevelJunior
+	updated, err := svc.UpdateAnswer(ctx, mid.ID, domain.AnswerPatch{LevelTarget: &junior}, author, domain.RoleUser)
+	if err != nil || updated.LevelTarget != domain.LevelJunior {
+		t.Fatalf("expected the level target to change, got %+v (%v)", updated, err)
+	}
+	if revisions, _ := svc.ListRevisions(ctx, mid.ID); len(revisions) != 1 {
+		t.Fatalf("expected a level change not to add a content revision, got %d", len(revisions))
+	}
+
+	answers, err := svc.ListAnswersForQuestion(ctx, domain.AnswerFilter{QuestionID: questionID, LevelTarget: domain.LevelJunior})
+	if err != nil || len(answers) != 1 || answers[0].ID != mid.ID {
+		t.Fatalf("expected only the junior answer, got %+v (%v)", answers, err)
+	}
+	if level, err := domain.ParseLevelTarget("Fresher"); err != nil || level != domain.LevelJunior {
+		t.Fatalf("expected freshers to read junior answers, got %s (%v)", level, err)
+	}
+}
+
+func TestPublishSuggestedAnswer_OncePerLevel(t *testing.T) {
+	repo := newFakeAnswerRepo()
+	svc := NewAnswerService(repo, &fakeRevisionRepo{}, fakeTx{})
+	ctx := context.Background()
+	questionID := uuid.New()
+
+	answer, err := svc.PublishSuggestedAnswer(ctx, questionID, " A sample answer ", "", "ai-service")
+	if err != nil {
+		t.Fatalf("expected the suggestion to publish, got %v", err)
+	}
+	if answer.AnswerType != domain.AnswerTypeSuggested || answer.AuthorID != uuid.Nil ||
+		answer.LevelTarget != domain.LevelMid || answer.Attribution != "ai-service" || answer.Content != "A sample answer" {
+		t.Fatalf("expected an authorless mid suggestion from ai-service, got %+v", answer)
+	}
+	if _, err := svc.PublishSuggestedAnswer(ctx, questionID, "Another", domain.LevelMid, "ai-service"); err == nil {
+		t.Fatal("expected a second mid suggestion to be rejected")
+	}
+	if _, err := svc.PublishSuggestedAnswer(ctx, questionID, "For seniors", domain.LevelSenior, "ai-service"); err != nil {
+		t.Fatalf("expected a suggestion for another level, got %v", err)
+	}
+
+	moderator := uuid.New()
+	if err := svc.DeleteAnswer(ctx, answer.ID, moderator, domain.RoleModerator); err != nil {
+		t.Fatalf("expected a moderator to delete the suggestion, got %v", err)
+	}
+	if _, err := svc.PublishSuggestedAnswer(ctx, questionID, "Again", domain.LevelMid, "ai-service"); err == nil {
+		t.Fatal("expected a deleted suggestion not to be published again")
+	}
+}
+
+func TestPromoteAnswer_SuggestedAnswers(t *testing.T) {
+	repo := newFakeAnswerRepo()
+	svc := NewAnswerService(repo, &fakeRevisionRepo{}, fakeTx{})
+	ctx := context.Background()
+	questionID, moderator := uuid.New(), uuid.New()
+	repo.questions[questionID] = &fakeQuestion{correctAnswer: "Original"}
+	suggestion, _ := svc.PublishSuggestedAnswer(ctx, questionID, "Generated", domain.LevelSenior, "ai-service")
+
+	promoted, err := svc.PromoteAnswer(ctx, suggestion.ID, moderator, domain.RoleModerator)
+	if err != nil || promoted.AnswerType != domain.AnswerTypeCanonical || repo.questions[questionID].correctAnswer != "Generated" {
+		t.Fatalf("expected the suggestion to become the correct answer, got %+v (%v)", promoted, err)
+	}
+	// The promoted suggestion keeps its level's slot
+	if _, err := svc.PublishSuggestedAnswer(ctx, questionID, "Another", domain.LevelSenior, "ai-service"); err == nil {
+		t.Fatal("expected no new suggestion while the generated one is canonical")
+	}
+
+	demoted, err := svc.DemoteAnswer(ctx, suggestion.ID, moderator, domain.RoleModerator)
+	if err != nil || demoted.AnswerType != domain.AnswerTypeSuggested || repo.questions[questionID].correctAnswer != "Original" {
+		t.Fatalf("expected the answer back as a suggestion and the original correct answer, got %+v (%v)", demoted, err)
+	}
+
+	// A written suggestion is promotable too and returns to community
+	written, _ := svc.CreateAnswer(ctx, questionID, uuid.New(), "Written", domain.AnswerTypeSuggested, domain.LevelMid)
+	if _, err := svc.PromoteAnswer(ctx, written.ID, moderator, domain.RoleModerator); err != nil {
+		t.Fatalf("expected a written suggestion to be promoted, got %v", err)
+	}
+	if demoted, err := svc.DemoteAnswer(ctx, written.ID, moderator, domain.RoleModerator); err != nil || demoted.AnswerType != domain.AnswerTypeCommunity {
+		t.Fatalf("expected the written suggestion to return to community, got %+v (%v)", demoted, err)
+	}
+}
@@ -1,12 +1,19 @@
 package main
 
 import (
+	"database/sql"
+	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	bff_http "github.com/question-interviewer/bff-service/internal/adapters/http"
+	"github.com/question-interviewer/bff-service/internal/adapters/jwt"
+	"github.com/question-interviewer/bff-service/internal/adapters/postgres"
+	"github.com/question-interviewer/bff-service/internal/services"
 )
 
 func main() {
@@ -22,8 +29,67 @@ func main() {
 		port = "8081"
 	}
 
+	// JWT Config (must match the secret configured in the other services)
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET is required")
+	}
+	tokenTTL := 24 * time.Hour
+	if v := os.Getenv("JWT_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("Invalid JWT_TTL: %v", err)
+		}
+		tokenTTL = d
+	}
+
+	// Database Connection
+	dbHost := os.Getenv("DB_HOST")
+	dbPort := os.Getenv("DB_PORT")
+	dbUser := os.Getenv("DB_USER")
+	dbPassword := os.Getenv("DB_PASSWORD")
+	dbName := os.Getenv("DB_NAME")
+
+	// Default fallback for local dev
+	if dbHost == "" {
+		dbHost = "localhost"
+	}
+	if dbPort == "" {
+		dbPort = "5432"
+	}
+	if dbUser == "" {
+		dbUser = "user"
+	}
+	if dbPassword == "" {
+		dbPassword = "password"
+	}
+	if dbName == "" {
+		dbName = "question_db"
+	}
+
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		dbHost, dbPort, dbUser, dbPassword, dbName)
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		log.Fatalf("Failed to open database connection: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		log.Printf("Warning: Failed to ping database: %v", err)
+	} else {
+		log.Println("Connected to Database")
+	}
+
+	// Dependency Injection
+	userRepo := postgres.NewUserRepository(db)
+	tokenIssuer := jwt.NewTokenIssuer([]byte(jwtSecret), tokenTTL)
+	authSvc := services.NewAuthService(userRepo, tokenIssuer)
+
 	// Handlers
 	handler := bff_http.NewBFFHandler(practiceServiceURL)
+	authHandler := bff_http.NewAuthHandler(authSvc, tokenIssuer)
 
 	// Router Setup
 	r := gin.Default()
@@ -42,6 +108,7 @@ func main() {
 	})
 
 	handler.RegisterRoutes(r)
+	authHandler.RegisterRoutes(r)
 
 	// Start Server
 	log.Printf("BFF Service listening on :%s", port)
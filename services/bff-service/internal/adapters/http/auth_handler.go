@@ -0,0 +1,196 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/bff-service/internal/domain"
+	"github.com/question-interviewer/bff-service/internal/ports"
+)
+
+// Gin context keys holding the authenticated user
+const (
+	userIDKey   = "user_id"
+	userRoleKey = "user_role"
+)
+
+type AuthHandler struct {
+	service ports.AuthService
+	tokens  ports.TokenIssuer
+}
+
+func NewAuthHandler(service ports.AuthService, tokens ports.TokenIssuer) *AuthHandler {
+	return &AuthHandler{
+		service: service,
+		tokens:  tokens,
+	}
+}
+
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Username string `json:"username" binding:"required,min=3,max=50"`
+	Password string `json:"password" binding:"required"`
+}
+
+type SetRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=user contributor moderator admin"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+func (h *AuthHandler) RegisterRoutes(r *gin.Engine) {
+	auth := r.Group("/api/v1/auth")
+	{
+		auth.POST("/register", h.Register)
+		auth.POST("/login", h.Login)
+		auth.GET("/me", RequireAuth(h.tokens), h.Me)
+	}
+
+	users := r.Group("/api/v1/users", RequireAuth(h.tokens), RequireRole(h.service, domain.RoleAdmin))
+	{
+		users.PUT("/:id/role", h.SetRole)
+	}
+}
+
+// RequireAuth verifies the Bearer token and injects the user ID into the gin context
+func RequireAuth(tokens ports.TokenIssuer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing bearer token"})
+			return
+		}
+
+		userID, role, err := tokens.Verify(token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set(userIDKey, userID)
+		c.Set(userRoleKey, role)
+		c.Next()
+	}
+}
+
+// RequireRole rejects authenticated users whose role is not in roles; use after RequireAuth.
+// The role is read from users rather than the token, so a demotion applies before the token expires.
+func RequireRole(users ports.AuthService, roles ...domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := users.GetUser(c.Request.Context(), c.MustGet(userIDKey).(uuid.UUID))
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "User no longer exists"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Set(userRoleKey, user.Role)
+		for _, allowed := range roles {
+			if user.Role == allowed {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient role"})
+	}
+}
+
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.service.Register(c.Request.Context(), req.Email, req.Username, req.Password)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.Contains(err.Error(), "password must be") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, user)
+}
+
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, token, expiresAt, err := h.service.Login(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_at":   expiresAt,
+		"user":         user,
+	})
+}
+
+func (h *AuthHandler) Me(c *gin.Context) {
+	userID := c.MustGet(userIDKey).(uuid.UUID)
+
+	user, err := h.service.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+func (h *AuthHandler) SetRole(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req SetRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.service.SetRole(c.Request.Context(), userID, domain.Role(req.Role))
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
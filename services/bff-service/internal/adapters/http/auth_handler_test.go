@@ -0,0 +1,133 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/bff-service/internal/adapters/jwt"
+	"github.com/question-interviewer/bff-service/internal/domain"
+)
+
+// stubAuthService returns whichever users it holds
+type stubAuthService struct {
+	users map[uuid.UUID]*domain.User
+}
+
+func (s *stubAuthService) Register(ctx context.Context, email, username, password string) (*domain.User, error) {
+	return nil, nil
+}
+func (s *stubAuthService) Login(ctx context.Context, email, password string) (*domain.User, string, time.Time, error) {
+	return nil, "", time.Time{}, domain.ErrInvalidCredentials
+}
+func (s *stubAuthService) GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	if u, ok := s.users[id]; ok {
+		return u, nil
+	}
+	return nil, domain.ErrUserNotFound
+}
+func (s *stubAuthService) SetRole(ctx context.Context, id uuid.UUID, role domain.Role) (*domain.User, error) {
+	u, ok := s.users[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	u.Role = role
+	return u, nil
+}
+
+func newAuthRouter(t *testing.T) (*gin.Engine, *stubAuthService, func(*domain.User) string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	tokens := jwt.NewTokenIssuer([]byte("test-secret"), time.Hour)
+	service := &stubAuthService{users: map[uuid.UUID]*domain.User{}}
+	r := gin.New()
+	NewAuthHandler(service, tokens).RegisterRoutes(r)
+
+	issue := func(u *domain.User) string {
+		service.users[u.ID] = u
+		token, _, err := tokens.Issue(u)
+		if err != nil {
+			t.Fatalf("Issue: %v", err)
+		}
+		return token
+	}
+	return r, service, issue
+}
+
+func doRequest(r http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestMe_RequiresValidToken(t *testing.T) {
+	r, _, issue := newAuthRouter(t)
+	user := domain.NewUser("alice@example.com", "alice", "hash")
+	token := issue(user)
+
+	if w := doRequest(r, http.MethodGet, "/api/v1/auth/me", "", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("no token: expected 401, got %d", w.Code)
+	}
+	if w := doRequest(r, http.MethodGet, "/api/v1/auth/me", "not-a-jwt", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("bad token: expected 401, got %d", w.Code)
+	}
+
+	w := doRequest(r, http.MethodGet, "/api/v1/auth/me", token, "")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), user.ID.String()) {
+		t.Fatalf("expected 200 with the user, got %d: %s", w.Code, w.Body)
+	}
+}
+
+func TestSetRole_AdminOnly(t *testing.T) {
+	r, service, issue := newAuthRouter(t)
+	target := domain.NewUser("bob@example.com", "bob", "hash")
+	issue(target)
+	moderator := domain.NewUser("mod@example.com", "mod", "hash")
+	moderator.Role = domain.RoleModerator
+	admin := domain.NewUser("admin@example.com", "admin", "hash")
+	admin.Role = domain.RoleAdmin
+
+	path := "/api/v1/users/" + target.ID.String() + "/role"
+	body := `{"role":"contributor"}`
+
+	if w := doRequest(r, http.MethodPut, path, "", body); w.Code != http.StatusUnauthorized {
+		t.Fatalf("anonymous: expected 401, got %d", w.Code)
+	}
+	if w := doRequest(r, http.MethodPut, path, issue(moderator), body); w.Code != http.StatusForbidden {
+		t.Fatalf("moderator: expected 403, got %d", w.Code)
+	}
+
+	adminToken := issue(admin)
+
+	// A demoted admin is refused even though their token still says admin
+	demoted := domain.NewUser("former@example.com", "former", "hash")
+	demoted.Role = domain.RoleAdmin
+	demotedToken := issue(demoted)
+	demoted.Role = domain.RoleUser
+	if w := doRequest(r, http.MethodPut, path, demotedToken, body); w.Code != http.StatusForbidden {
+		t.Fatalf("demoted admin: expected 403, got %d", w.Code)
+	}
+
+	if w := doRequest(r, http.MethodPut, path, adminToken, `{"role":"owner"}`); w.Code != http.StatusBadRequest {
+		t.Fatalf("unknown role: expected 400, got %d", w.Code)
+	}
+	if w := doRequest(r, http.MethodPut, "/api/v1/users/"+uuid.NewString()+"/role", adminToken, body); w.Code != http.StatusNotFound {
+		t.Fatalf("unknown user: expected 404, got %d", w.Code)
+	}
+	if w := doRequest(r, http.MethodPut, path, adminToken, body); w.Code != http.StatusOK {
+		t.Fatalf("admin: expected 200, got %d: %s", w.Code, w.Body)
+	}
+	if service.users[target.ID].Role != domain.RoleContributor {
+		t.Fatalf("expected role contributor, got %q", service.users[target.ID].Role)
+	}
+}
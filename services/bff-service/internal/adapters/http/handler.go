@@ -30,6 +30,7 @@ func (h *BFFHandler) RegisterRoutes(r *gin.Engine) {
 		api.POST("/sessions", h.StartSession)
 		api.GET("/sessions/:id", h.GetSession)
 		api.POST("/sessions/:id/answers", h.SubmitAnswer)
+		api.POST("/sessions/:id/follow-ups/:follow_up_id/answers", h.SubmitFollowUpAnswer)
 		api.GET("/questions/:id", h.GetQuestion)
 	}
 }
@@ -101,3 +102,16 @@ func (h *BFFHandler) SubmitAnswer(c *gin.Context) {
 	url := fmt.Sprintf("%s/api/v1/practice/sessions/%s/answers", h.practiceServiceURL, sessionID)
 	h.proxyRequest(c, "POST", url, body)
 }
+
+func (h *BFFHandler) SubmitFollowUpAnswer(c *gin.Context) {
+	sessionID := c.Param("id")
+	followUpID := c.Param("follow_up_id")
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	url := fmt.Sprintf("%s/api/v1/practice/sessions/%s/follow-ups/%s/answers", h.practiceServiceURL, sessionID, followUpID)
+	h.proxyRequest(c, "POST", url, body)
+}
@@ -0,0 +1,69 @@
+package jwt
+
+import (
+	"fmt"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/bff-service/internal/domain"
+	"github.com/question-interviewer/bff-service/internal/ports"
+)
+
+// Issuer is the value of the "iss" claim; services verifying tokens expect it
+const Issuer = "question-interviewer-bff"
+
+// Claims are the access token claims; services read "sub" and "role"
+type Claims struct {
+	Role string `json:"role"`
+	gojwt.RegisteredClaims
+}
+
+type TokenIssuer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewTokenIssuer(secret []byte, ttl time.Duration) ports.TokenIssuer {
+	return &TokenIssuer{
+		secret: secret,
+		ttl:    ttl,
+	}
+}
+
+func (t *TokenIssuer) Issue(user *domain.User) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(t.ttl)
+
+	claims := Claims{
+		Role: string(user.Role),
+		RegisteredClaims: gojwt.RegisteredClaims{
+			Subject:   user.ID.String(),
+			Issuer:    Issuer,
+			IssuedAt:  gojwt.NewNumericDate(now),
+			ExpiresAt: gojwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token, err := gojwt.NewWithClaims(gojwt.SigningMethodHS256, claims).SignedString(t.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+func (t *TokenIssuer) Verify(token string) (uuid.UUID, domain.Role, error) {
+	var claims Claims
+	_, err := gojwt.ParseWithClaims(token, &claims, func(*gojwt.Token) (interface{}, error) {
+		return t.secret, nil
+	}, gojwt.WithValidMethods([]string{gojwt.SigningMethodHS256.Alg()}), gojwt.WithIssuer(Issuer))
+	if err != nil {
+		return uuid.Nil, "", domain.ErrInvalidToken
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, "", domain.ErrInvalidToken
+	}
+	return userID, domain.ParseRole(claims.Role), nil
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/question-interviewer/bff-service/internal/domain"
+	"github.com/question-interviewer/bff-service/internal/ports"
+)
+
+// uniqueViolation is the Postgres SQLSTATE for unique constraint violations
+const uniqueViolation = "23505"
+
+type UserRepository struct {
+	db *sql.DB
+}
+
+func NewUserRepository(db *sql.DB) ports.UserRepository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	query := `
+		INSERT INTO users (id, email, username, role, password_hash, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		user.ID,
+		user.Email,
+		user.Username,
+		user.Role,
+		user.PasswordHash,
+		user.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return domain.ErrUserAlreadyExists
+		}
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	query := `
+		SELECT id, email, username, COALESCE(role, 'user'), password_hash, created_at
+		FROM users
+		WHERE id = $1
+	`
+	return r.scanUser(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	query := `
+		SELECT id, email, username, COALESCE(role, 'user'), password_hash, created_at
+		FROM users
+		WHERE LOWER(email) = LOWER($1)
+	`
+	return r.scanUser(r.db.QueryRowContext(ctx, query, email))
+}
+
+func (r *UserRepository) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE users SET role = $1 WHERE id = $2`, role, id)
+	if err != nil {
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) scanUser(row *sql.Row) (*domain.User, error) {
+	var u domain.User
+	var role string
+	err := row.Scan(
+		&u.ID,
+		&u.Email,
+		&u.Username,
+		&role,
+		&u.PasswordHash,
+		&u.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	u.Role = domain.ParseRole(role)
+	return &u, nil
+}
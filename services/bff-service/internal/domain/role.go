@@ -0,0 +1,26 @@
+package domain
+
+// Role is a user's permission level, stored in users.role and carried in access tokens
+type Role string
+
+const (
+	RoleUser        Role = "user"
+	RoleContributor Role = "contributor"
+	RoleModerator   Role = "moderator"
+	RoleAdmin       Role = "admin"
+)
+
+// ParseRole maps a stored role to a known Role, defaulting to RoleUser
+func ParseRole(s string) Role {
+	switch r := Role(s); r {
+	case RoleContributor, RoleModerator, RoleAdmin:
+		return r
+	default:
+		return RoleUser
+	}
+}
+
+// IsModerator reports whether the role may moderate content (moderators and admins)
+func (r Role) IsModerator() bool {
+	return r == RoleModerator || r == RoleAdmin
+}
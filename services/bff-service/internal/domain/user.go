@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserAlreadyExists  = errors.New("email or username already registered")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrInvalidToken       = errors.New("invalid or expired token")
+)
+
+// User represents an account in the users table
+type User struct {
+	ID           uuid.UUID `json:"id"`
+	Email        string    `json:"email"`
+	Username     string    `json:"username"`
+	Role         Role      `json:"role"`
+	PasswordHash string    `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// NewUser creates a new user with the default role
+func NewUser(email, username, passwordHash string) *User {
+	return &User{
+		ID:           uuid.New(),
+		Email:        email,
+		Username:     username,
+		Role:         RoleUser,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now(),
+	}
+}
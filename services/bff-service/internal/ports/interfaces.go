@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/bff-service/internal/domain"
+)
+
+// UserRepository defines the interface for user data access
+type UserRepository interface {
+	Create(ctx context.Context, user *domain.User) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error
+}
+
+// TokenIssuer signs and verifies access tokens
+type TokenIssuer interface {
+	Issue(user *domain.User) (string, time.Time, error)  // token, expiresAt
+	Verify(token string) (uuid.UUID, domain.Role, error) // userID, role
+}
+
+// AuthService defines the interface for registration and login
+type AuthService interface {
+	Register(ctx context.Context, email, username, password string) (*domain.User, error)
+	Login(ctx context.Context, email, password string) (*domain.User, string, time.Time, error) // user, token, expiresAt
+	GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	SetRole(ctx context.Context, id uuid.UUID, role domain.Role) (*domain.User, error)
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/bff-service/internal/domain"
+	"github.com/question-interviewer/bff-service/internal/ports"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// minPasswordLength is the shortest password accepted at registration
+const minPasswordLength = 8
+
+// maxPasswordBytes is the longest password bcrypt can hash
+const maxPasswordBytes = 72
+
+type authService struct {
+	repo   ports.UserRepository
+	tokens ports.TokenIssuer
+}
+
+// NewAuthService creates a new instance of AuthService
+func NewAuthService(repo ports.UserRepository, tokens ports.TokenIssuer) ports.AuthService {
+	return &authService{
+		repo:   repo,
+		tokens: tokens,
+	}
+}
+
+func (s *authService) Register(ctx context.Context, email, username, password string) (*domain.User, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	username = strings.TrimSpace(username)
+	if len(password) < minPasswordLength {
+		return nil, fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	if len(password) > maxPasswordBytes {
+		return nil, fmt.Errorf("password must be at most %d bytes", maxPasswordBytes)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user := domain.NewUser(email, username, string(hash))
+	if err := s.repo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *authService) Login(ctx context.Context, email, password string) (*domain.User, string, time.Time, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	user, err := s.repo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, "", time.Time{}, domain.ErrInvalidCredentials
+		}
+		return nil, "", time.Time{}, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, "", time.Time{}, domain.ErrInvalidCredentials
+	}
+
+	token, expiresAt, err := s.tokens.Issue(user)
+	if err != nil {
+		return nil, "", time.Time{}, fmt.Errorf("failed to issue token: %w", err)
+	}
+	return user, token, expiresAt, nil
+}
+
+func (s *authService) GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *authService) SetRole(ctx context.Context, id uuid.UUID, role domain.Role) (*domain.User, error) {
+	if domain.ParseRole(string(role)) != role {
+		return nil, fmt.Errorf("unknown role: %s", role)
+	}
+	if err := s.repo.UpdateRole(ctx, id, role); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/bff-service/internal/adapters/jwt"
+	"github.com/question-interviewer/bff-service/internal/domain"
+)
+
+type fakeUserRepo struct {
+	users map[uuid.UUID]*domain.User
+}
+
+func newFakeUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{users: map[uuid.UUID]*domain.User{}}
+}
+
+func (r *fakeUserRepo) Create(ctx context.Context, user *domain.User) error {
+	for _, u := range r.users {
+		if u.Email == user.Email || u.Username == user.Username {
+			return domain.ErrUserAlreadyExists
+		}
+	}
+	stored := *user
+	r.users[user.ID] = &stored
+	return nil
+}
+
+func (r *fakeUserRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	found := *u
+	return &found, nil
+}
+
+func (r *fakeUserRepo) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			found := *u
+			return &found, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *fakeUserRepo) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error {
+	u, ok := r.users[id]
+	if !ok {
+		return domain.ErrUserNotFound
+	}
+	u.Role = role
+	return nil
+}
+
+func TestRegisterAndLogin_IssuesVerifiableToken(t *testing.T) {
+	ctx := context.Background()
+	tokens := jwt.NewTokenIssuer([]byte("test-secret"), time.Hour)
+	svc := NewAuthService(newFakeUserRepo(), tokens)
+
+	user, err := svc.Register(ctx, "  Alice@Example.com ", "alice", "correct-horse")
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if user.Email != "alice@example.com" || user.Role != domain.RoleUser {
+		t.Fatalf("expected normalized email and default role, got %q %q", user.Email, user.Role)
+	}
+	if user.PasswordHash == "correct-horse" {
+		t.Fatal("password stored in plain text")
+	}
+
+	if _, err := svc.Register(ctx, "alice@example.com", "alice2", "correct-horse"); !errors.Is(err, domain.ErrUserAlreadyExists) {
+		t.Fatalf("expected ErrUserAlreadyExists, got %v", err)
+	}
+
+	loggedIn, token, expiresAt, err := svc.Login(ctx, "ALICE@example.com", "correct-horse")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if loggedIn.ID != user.ID || time.Until(expiresAt) <= 0 {
+		t.Fatalf("unexpected login result %v %v", loggedIn.ID, expiresAt)
+	}
+
+	userID, role, err := tokens.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if userID != user.ID || role != domain.RoleUser {
+		t.Fatalf("token carries %v %q, expected %v user", userID, role, user.ID)
+	}
+
+	if _, _, err := jwt.NewTokenIssuer([]byte("other-secret"), time.Hour).Verify(token); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for another secret, got %v", err)
+	}
+}
+
+func TestRegister_RejectsShortPassword(t *testing.T) {
+	svc := NewAuthService(newFakeUserRepo(), jwt.NewTokenIssuer([]byte("test-secret"), time.Hour))
+	if _, err := svc.Register(context.Background(), "bob@example.com", "bob", "short"); err == nil {
+		t.Fatal("expected an error for a short password")
+	}
+}
+
+func TestRegister_RejectsPasswordTooLongForBcrypt(t *testing.T) {
+	svc := NewAuthService(newFakeUserRepo(), jwt.NewTokenIssuer([]byte("test-secret"), time.Hour))
+	_, err := svc.Register(context.Background(), "bob@example.com", "bob", strings.Repeat("é", 37))
+	if err == nil || !strings.HasPrefix(err.Error(), "password must be") {
+		t.Fatalf("expected a validation error for a 74-byte password, got %v", err)
+	}
+}
+
+func TestLogin_InvalidCredentials(t *testing.T) {
+	ctx := context.Background()
+	svc := NewAuthService(newFakeUserRepo(), jwt.NewTokenIssuer([]byte("test-secret"), time.Hour))
+	if _, err := svc.Register(ctx, "carol@example.com", "carol", "correct-horse"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if _, _, _, err := svc.Login(ctx, "carol@example.com", "wrong-password"); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("wrong password: expected ErrInvalidCredentials, got %v", err)
+	}
+	if _, _, _, err := svc.Login(ctx, "nobody@example.com", "correct-horse"); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("unknown email: expected ErrInvalidCredentials, got %v", err)
+	}
+}
+
+func TestVerify_RejectsExpiredToken(t *testing.T) {
+	tokens := jwt.NewTokenIssuer([]byte("test-secret"), -time.Minute)
+	token, _, err := tokens.Issue(domain.NewUser("dave@example.com", "dave", "hash"))
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if _, _, err := tokens.Verify(token); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+}
+
+func TestSetRole(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeUserRepo()
+	tokens := jwt.NewTokenIssuer([]byte("test-secret"), time.Hour)
+	svc := NewAuthService(repo, tokens)
+	user, err := svc.Register(ctx, "erin@example.com", "erin", "correct-horse")
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if _, err := svc.SetRole(ctx, user.ID, domain.Role("owner")); err == nil {
+		t.Fatal("expected an error for an unknown role")
+	}
+	if _, err := svc.SetRole(ctx, uuid.New(), domain.RoleModerator); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+
+	updated, err := svc.SetRole(ctx, user.ID, domain.RoleModerator)
+	if err != nil {
+		t.Fatalf("SetRole: %v", err)
+	}
+	if updated.Role != domain.RoleModerator {
+		t.Fatalf("expected moderator, got %q", updated.Role)
+	}
+
+	// Tokens issued from now on carry the new role
+	_, token, _, err := svc.Login(ctx, "erin@example.com", "correct-horse")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if _, role, _ := tokens.Verify(token); role != domain.RoleModerator {
+		t.Fatalf("expected a moderator token, got %q", role)
+	}
+}
@@ -508,6 +508,7 @@ class VietnamMarketCrawler(BaseCrawler):
             item["meta_role"] = item["role"]
             item["meta_level"] = item["level"]
             item["meta_tags"] = item["tags"]
+            item["meta_language"] = "vi"
             # Hint and Correct Answer are already in item
             
             results.append(item)
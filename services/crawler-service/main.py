@@ -4,7 +4,7 @@ from typing import Optional, List, Union, Any
 from sqlalchemy.orm import Session
 
 from database import get_db, engine, Base
-from models import CrawledQuestion, Question, Topic
+from models import CrawledQuestion
 from normalizer import Normalizer
 
 from crawlers.github import GitHubCrawler
@@ -82,6 +82,10 @@ async def crawl_url(request: CrawlRequest, db: Session = Depends(get_db)):
             detected_role = raw_data.get("meta_role")
             if not detected_role:
                 detected_role = Normalizer.detect_role(raw_data.get("title", ""), normalized_content)
+
+            language = raw_data.get("meta_language")
+            if not language:
+                language = Normalizer.detect_language(raw_data.get("title", ""), normalized_content)
             
             # 4. Store to Staging DB
             db_item = CrawledQuestion(
@@ -92,6 +96,7 @@ async def crawl_url(request: CrawlRequest, db: Session = Depends(get_db)):
                 detected_topic=detected_topic,
                 detected_role=detected_role,
                 detected_level=detected_level,
+                language=language,
                 status="pending"
             )
             db.add(db_item)
@@ -104,44 +109,4 @@ async def crawl_url(request: CrawlRequest, db: Session = Depends(get_db)):
     except Exception as e:
         raise HTTPException(status_code=500, detail=str(e))
 
-@app.post("/crawled-questions/{question_id}/approve")
-def approve_question(question_id: str, db: Session = Depends(get_db)):
-    # Find crawled question
-    crawled = db.query(CrawledQuestion).filter(CrawledQuestion.id == question_id).first()
-    if not crawled:
-        raise HTTPException(status_code=404, detail="Crawled question not found")
-    
-    if crawled.status == "approved":
-        return {"message": "Question already approved"}
-        
-    # Find or Create Topic
-    topic_name = crawled.detected_topic or "General"
-    topic = db.query(Topic).filter(Topic.name == topic_name).first()
-    if not topic:
-        topic = Topic(name=topic_name, description=f"Topic {topic_name}")
-        db.add(topic)
-        db.flush() # get ID
-        
-    # Determine Language
-    language = "en"
-    if crawled.source == "Vietnam IT Job Market":
-        language = "vi"
-        
-    # Create Question
-    new_question = Question(
-        title=crawled.raw_title,
-        content=crawled.raw_content or "",
-        level=crawled.detected_level,
-        language=language,
-        role=crawled.detected_role,
-        topic_id=topic.id,
-        status="published"
-    )
-    db.add(new_question)
-    
-    # Update Status
-    crawled.status = "approved"
-    
-    db.commit()
-    
-    return {"message": "Question approved and published", "question_id": str(new_question.id)}
+# Review (edit / approve / reject) lives in question-service under /api/v1/crawled-questions
@@ -14,6 +14,7 @@ class CrawledQuestion(Base):
     detected_topic = Column(String, nullable=True)
     detected_role = Column(String, nullable=True)
     detected_level = Column(String, nullable=True)
+    language = Column(String, default="en")
     status = Column(String, default="pending") # pending, approved, rejected
     created_at = Column(DateTime(timezone=True), server_default=func.now())
 
@@ -1,5 +1,8 @@
 import re
 
+# Letters only Vietnamese uses among the languages we crawl
+VIETNAMESE_LETTERS = re.compile(r"[ăâđêôơưạảấầẩẫậắằẳẵặẹẻẽếềểễệỉịọỏốồổỗộớờởỡợụủứừửữựỳỵỷỹ]")
+
 class Normalizer:
     @staticmethod
     def normalize_content(content: str) -> str:
@@ -44,6 +47,13 @@ class Normalizer:
         
         return "General"
 
+    @staticmethod
+    def detect_language(title: str, content: str) -> str:
+        text = (title + " " + content).lower()
+        if VIETNAMESE_LETTERS.search(text):
+            return "vi"
+        return "en"
+
     @staticmethod
     def detect_level(title: str, content: str) -> str:
         # Very naive heuristic
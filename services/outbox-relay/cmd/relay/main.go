@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/question-interviewer/outbox-relay/internal/adapters/postgres"
+	"github.com/question-interviewer/outbox-relay/internal/adapters/sinks"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+	"github.com/question-interviewer/outbox-relay/internal/ports"
+	"github.com/question-interviewer/outbox-relay/internal/services"
+)
+
+func main() {
+	log.Println("Starting Outbox Relay...")
+
+	// Database Connection
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		getenvDefault("DB_HOST", "localhost"),
+		getenvDefault("DB_PORT", "5432"),
+		getenvDefault("DB_USER", "user"),
+		getenvDefault("DB_PASSWORD", "password"),
+		getenvDefault("DB_NAME", "question_db"))
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		log.Fatalf("Failed to open database connection: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		log.Printf("Warning: Failed to ping database: %v", err)
+	} else {
+		log.Println("Connected to Database")
+	}
+
+	// Relay Config
+	config := services.DefaultRelayConfig()
+	config.BatchSize = getenvIntDefault("OUTBOX_BATCH_SIZE", config.BatchSize)
+	config.MaxAttempts = getenvIntDefault("OUTBOX_MAX_ATTEMPTS", config.MaxAttempts)
+	config.PollInterval = getenvDurationDefault("OUTBOX_POLL_INTERVAL", config.PollInterval)
+	config.SinkTimeout = getenvDurationDefault("OUTBOX_SINK_TIMEOUT", config.SinkTimeout)
+	sinkTimeout := config.SinkTimeout
+	// By default the lease covers a batch in which every publish times out
+	config.Lease = getenvDurationDefault("OUTBOX_LEASE", config.MinLease())
+	if config.Lease < config.MinLease() {
+		log.Printf("Warning: OUTBOX_LEASE %s is shorter than a batch can take (%s); other relays may publish its events again", config.Lease, config.MinLease())
+	}
+
+	// Sink: inprocess (default), webhook, nats or memory
+	var sink ports.Sink
+	switch kind := getenvDefault("OUTBOX_SINK", "inprocess"); kind {
+	case "inprocess":
+		inProcess := sinks.NewInProcess()
+		// Consumers that live in the relay subscribe here
+		inProcess.Subscribe(sinks.AllEvents, func(ctx context.Context, event *domain.Event) error {
+			log.Printf("Event %s %s for %s %s", event.Type, event.ID, event.AggregateType, event.AggregateID)
+			return nil
+		})
+		sink = inProcess
+	case "webhook":
+		webhookURL := os.Getenv("OUTBOX_WEBHOOK_URL")
+		if webhookURL == "" {
+			log.Fatal("OUTBOX_WEBHOOK_URL is required for the webhook sink")
+		}
+		sink = sinks.NewWebhook(webhookURL, os.Getenv("OUTBOX_WEBHOOK_SECRET"), sinkTimeout)
+	case "nats":
+		natsSink, err := sinks.NewNATS(getenvDefault("NATS_URL", "nats://localhost:4222"), getenvDefault("NATS_SUBJECT_PREFIX", "outbox"), sinkTimeout)
+		if err != nil {
+			log.Fatalf("Failed to configure NATS sink: %v", err)
+		}
+		sink = natsSink
+	case "memory":
+		log.Println("Warning: memory sink keeps events in this process only")
+		sink = sinks.NewMemory()
+	default:
+		log.Fatalf("Unknown OUTBOX_SINK: %s", kind)
+	}
+	defer sink.Close()
+
+	relay := services.NewRelay(postgres.NewOutboxRepository(db), sink, config)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Relaying outbox events every %s", config.PollInterval)
+	relay.Run(ctx)
+	log.Println("Outbox Relay stopped")
+}
+
+func getenvDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func getenvIntDefault(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		log.Fatalf("Invalid %s: %q", key, v)
+	}
+	return n
+}
+
+func getenvDurationDefault(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Fatalf("Invalid %s: %q", key, v)
+	}
+	return d
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+	"github.com/question-interviewer/outbox-relay/internal/ports"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) ports.OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// ClaimPending pushes the claimed events' available_at past the lease in the statement that selects them.
+// SKIP LOCKED lets several relays claim disjoint batches, and a relay that dies mid-batch only delays
+// its events until the lease runs out. An earlier event of the same aggregate that is waiting for a retry
+// or claimed by another relay holds the later ones back; parked events do not.
+//
+// Relays claim an aggregate's events only while holding its advisory lock, taken in a statement of its own.
+// The claim is therefore read after any other relay's claim on the aggregate has committed and sees its
+// lease, so two relays never split one aggregate's events between them.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]*domain.Event, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	types, ids, err := lockAggregates(ctx, tx, limit, maxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		return []*domain.Event{}, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE outbox_events
+		SET available_at = NOW() + make_interval(secs => $3)
+		WHERE id IN (
+			SELECT e.id FROM outbox_events e
+			WHERE e.published_at IS NULL AND e.available_at <= NOW() AND e.attempts < $2
+				AND (e.aggregate_type, e.aggregate_id) IN (SELECT * FROM unnest($4::text[], $5::uuid[]))
+				AND NOT EXISTS (
+					SELECT 1 FROM outbox_events p
+					WHERE p.aggregate_type = e.aggregate_type AND p.aggregate_id = e.aggregate_id
+						AND p.published_at IS NULL AND p.attempts < $2 AND p.available_at > NOW()
+						AND (p.occurred_at, p.id) < (e.occurred_at, e.id)
+				)
+			ORDER BY e.occurred_at, e.id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, aggregate_type, aggregate_id, event_type, payload, occurred_at, attempts
+	`, limit, maxAttempts, lease.Seconds(), types, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*domain.Event{}
+	for rows.Next() {
+		var e domain.Event
+		if err := rows.Scan(&e.ID, &e.AggregateType, &e.AggregateID, &e.Type, &e.Payload, &e.OccurredAt, &e.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	rows.Close()
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit outbox claim: %w", err)
+	}
+
+	// RETURNING does not keep the subquery's order
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].OccurredAt.Equal(events[j].OccurredAt) {
+			return events[i].OccurredAt.Before(events[j].OccurredAt)
+		}
+		return bytes.Compare(events[i].ID[:], events[j].ID[:]) < 0
+	})
+	return events, nil
+}
+
+// lockAggregates takes the transaction-scoped advisory locks of up to limit aggregates with claimable
+// events, oldest first, skipping aggregates another relay is claiming. It returns the aggregates it locked.
+func lockAggregates(ctx context.Context, tx *sql.Tx, limit, maxAttempts int) ([]string, []string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT a.aggregate_type, a.aggregate_id FROM (
+			SELECT aggregate_type, aggregate_id, MIN(occurred_at) AS first_occurred_at
+			FROM outbox_events
+			WHERE published_at IS NULL AND available_at <= NOW() AND attempts < $2
+			GROUP BY aggregate_type, aggregate_id
+			ORDER BY first_occurred_at
+			LIMIT $1
+		) a
+		WHERE pg_try_advisory_xact_lock(hashtext(a.aggregate_type || ':' || a.aggregate_id::text))
+	`, limit, maxAttempts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to lock outbox aggregates: %w", err)
+	}
+	defer rows.Close()
+
+	var types, ids []string
+	for rows.Next() {
+		var aggregateType, aggregateID string
+		if err := rows.Scan(&aggregateType, &aggregateID); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan outbox aggregate: %w", err)
+		}
+		types, ids = append(types, aggregateType), append(ids, aggregateID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return types, ids, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE outbox_events SET published_at = NOW(), last_error = NULL WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event published: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE outbox_events SET attempts = attempts + 1, last_error = $2, available_at = $3 WHERE id = $1
+	`, id, reason, retryAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event failed: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) Postpone(ctx context.Context, id uuid.UUID, availableAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE outbox_events SET available_at = $2 WHERE id = $1`, id, availableAt)
+	if err != nil {
+		return fmt.Errorf("failed to postpone outbox event: %w", err)
+	}
+	return nil
+}
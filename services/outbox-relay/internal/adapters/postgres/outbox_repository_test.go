@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+)
+
+// testDB opens OUTBOX_TEST_DATABASE_URL, a migrated database with no other pending outbox events
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("OUTBOX_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("OUTBOX_TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestClaimPending_TwoRelaysNeverSplitAnAggregate(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	relays := []*OutboxRepository{{db: db}, {db: db}}
+
+	for round := 0; round < 50; round++ {
+		aggregateID := uuid.New()
+		t.Cleanup(func() { db.Exec(`DELETE FROM outbox_events WHERE aggregate_id = $1`, aggregateID) })
+		for _, eventType := range []string{"question.created", "question.updated"} {
+			_, err := db.ExecContext(ctx, `SELECT outbox_emit('question', $1, $2, '{}')`, aggregateID, eventType)
+			if err != nil {
+				t.Fatalf("failed to emit event: %v", err)
+			}
+		}
+
+		// Both relays claim one event at once; the second event must wait for the first
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		claimed := make([][]*domain.Event, len(relays))
+		errs := make([]error, len(relays))
+		for i, relay := range relays {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				claimed[i], errs[i] = relay.ClaimPending(ctx, 1, 10, time.Minute)
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		var got []*domain.Event
+		for i := range relays {
+			if errs[i] != nil {
+				t.Fatalf("round %d: failed to claim: %v", round, errs[i])
+			}
+			got = append(got, claimed[i]...)
+		}
+		if len(got) != 1 || got[0].AggregateID != aggregateID || got[0].Type != "question.created" {
+			t.Fatalf("round %d: expected one relay to claim only the first event, got %+v", round, got)
+		}
+		if err := relays[0].MarkPublished(ctx, got[0].ID); err != nil {
+			t.Fatalf("round %d: failed to publish: %v", round, err)
+		}
+		// The lease on the second event is not taken yet, so marking the first published releases it
+		if next, err := relays[1].ClaimPending(ctx, 1, 10, time.Minute); err != nil || len(next) != 1 || next[0].Type != "question.updated" {
+			t.Fatalf("round %d: expected the second event once the first was published, got %+v (%v)", round, next, err)
+		}
+	}
+}
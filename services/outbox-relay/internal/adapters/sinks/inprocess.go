@@ -0,0 +1,53 @@
+package sinks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+	"github.com/question-interviewer/outbox-relay/internal/ports"
+)
+
+// Handler consumes an event inside the relay process. It may see an event more than once.
+type Handler func(ctx context.Context, event *domain.Event) error
+
+// AllEvents subscribes a handler to every event type
+const AllEvents = "*"
+
+// InProcess hands events to handlers registered in the relay process, such as index or stats updaters
+type InProcess struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+func NewInProcess() *InProcess {
+	return &InProcess{handlers: make(map[string][]Handler)}
+}
+
+var _ ports.Sink = (*InProcess)(nil)
+
+// Subscribe registers a handler for an event type, or for AllEvents
+func (s *InProcess) Subscribe(eventType string, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[eventType] = append(s.handlers[eventType], handler)
+}
+
+// Publish runs the event's handlers in order; the first error stops them and the whole event is retried
+func (s *InProcess) Publish(ctx context.Context, event *domain.Event) error {
+	s.mu.RLock()
+	handlers := append(append([]Handler{}, s.handlers[event.Type]...), s.handlers[AllEvents]...)
+	s.mu.RUnlock()
+
+	for _, handle := range handlers {
+		if err := handle(ctx, event); err != nil {
+			return fmt.Errorf("handler failed for %s: %w", event.Type, err)
+		}
+	}
+	return nil
+}
+
+func (s *InProcess) Close() error {
+	return nil
+}
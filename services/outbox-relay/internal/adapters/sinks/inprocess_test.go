@@ -0,0 +1,53 @@
+package sinks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+)
+
+func TestInProcess_FansOutToSubscribers(t *testing.T) {
+	sink := NewInProcess()
+	var calls []string
+	record := func(name string) Handler {
+		return func(ctx context.Context, event *domain.Event) error {
+			calls = append(calls, name+":"+event.Type)
+			return nil
+		}
+	}
+	sink.Subscribe("question.created", record("index"))
+	sink.Subscribe("question.created", record("stats"))
+	sink.Subscribe(AllEvents, record("log"))
+	ctx := context.Background()
+
+	if err := sink.Publish(ctx, &domain.Event{ID: uuid.New(), Type: "question.created"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if err := sink.Publish(ctx, &domain.Event{ID: uuid.New(), Type: "attempt.graded"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if got := strings.Join(calls, ","); got != "index:question.created,stats:question.created,log:question.created,log:attempt.graded" {
+		t.Fatalf("expected type handlers in order, then catch-all ones, got %s", got)
+	}
+}
+
+func TestInProcess_StopsAtTheFirstError(t *testing.T) {
+	sink := NewInProcess()
+	ran := false
+	sink.Subscribe("question.created", func(ctx context.Context, event *domain.Event) error {
+		return errors.New("index down")
+	})
+	sink.Subscribe(AllEvents, func(ctx context.Context, event *domain.Event) error {
+		ran = true
+		return nil
+	})
+
+	err := sink.Publish(context.Background(), &domain.Event{ID: uuid.New(), Type: "question.created"})
+	if err == nil || !strings.Contains(err.Error(), "index down") || ran {
+		t.Fatalf("expected the first error to stop the handlers, got %v (later handler ran: %v)", err, ran)
+	}
+}
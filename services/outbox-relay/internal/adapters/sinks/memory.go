@@ -0,0 +1,50 @@
+package sinks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+	"github.com/question-interviewer/outbox-relay/internal/ports"
+)
+
+// Memory is a local stand-in for a real sink: it records what it is given and can be made to fail
+type Memory struct {
+	mu     sync.Mutex
+	events []*domain.Event
+	err    error
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+var _ ports.Sink = (*Memory)(nil)
+
+// FailWith makes Publish return err until it is called again with nil
+func (m *Memory) FailWith(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+}
+
+func (m *Memory) Publish(ctx context.Context, event *domain.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.events = append(m.events, event)
+	return nil
+}
+
+// Events returns the events published so far, in order
+func (m *Memory) Events() []*domain.Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*domain.Event{}, m.events...)
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
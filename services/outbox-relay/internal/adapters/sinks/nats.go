@@ -0,0 +1,164 @@
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+	"github.com/question-interviewer/outbox-relay/internal/ports"
+)
+
+// NATS publishes each event to "<prefix>.<event type>", such as outbox.question.created. It speaks just
+// enough of the core NATS client protocol to publish: every message is followed by a PING, and the event
+// counts as published once the server's PONG shows it read the message. Core NATS does not store
+// messages, so an event no subscriber was listening for is lost and this sink gives no delivery
+// guarantee past the server. The event ID goes in the Nats-Msg-Id header, so a JetStream stream
+// capturing the subjects drops redelivered duplicates.
+type NATS struct {
+	address       string
+	subjectPrefix string
+	timeout       time.Duration
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewNATS takes a server URL such as nats://nats:4222; connecting waits for the first publish
+func NewNATS(serverURL, subjectPrefix string, timeout time.Duration) (*NATS, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid NATS URL: %s", serverURL)
+	}
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), "4222")
+	}
+	return &NATS{
+		address:       address,
+		subjectPrefix: strings.TrimSuffix(subjectPrefix, "."),
+		timeout:       timeout,
+	}, nil
+}
+
+var _ ports.Sink = (*NATS)(nil)
+
+func (n *NATS) Publish(ctx context.Context, event *domain.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	header := "NATS/1.0\r\nNats-Msg-Id: " + event.ID.String() + "\r\n\r\n"
+	msg := fmt.Sprintf("HPUB %s.%s %d %d\r\n%s%s\r\nPING\r\n",
+		n.subjectPrefix, event.Type, len(header), len(header)+len(body), header, body)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if err := n.connect(ctx); err != nil {
+		return err
+	}
+	if err := n.roundTrip(ctx, msg); err != nil {
+		// The connection is in an unknown state; the next publish reconnects
+		n.closeConn()
+		return fmt.Errorf("failed to publish to NATS: %w", err)
+	}
+	return nil
+}
+
+// connect dials the server if there is no connection, and completes the handshake
+func (n *NATS) connect(ctx context.Context) error {
+	if n.conn != nil {
+		return nil
+	}
+	dialer := net.Dialer{Timeout: n.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", n.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	n.conn = conn
+	n.reader = bufio.NewReader(conn)
+
+	// The server greets with INFO; headers are needed for HPUB
+	if err := n.setDeadline(ctx); err != nil {
+		n.closeConn()
+		return err
+	}
+	line, err := n.reader.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		n.closeConn()
+		return fmt.Errorf("failed to read NATS server info: %v", err)
+	}
+	var info struct {
+		Headers bool `json:"headers"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "INFO "))), &info); err != nil || !info.Headers {
+		n.closeConn()
+		return fmt.Errorf("NATS server does not support message headers")
+	}
+
+	connect := `CONNECT {"verbose":false,"pedantic":false,"headers":true,"name":"outbox-relay"}` + "\r\nPING\r\n"
+	if err := n.roundTrip(ctx, connect); err != nil {
+		n.closeConn()
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	return nil
+}
+
+// roundTrip writes commands ending in PING and reads until the matching PONG
+func (n *NATS) roundTrip(ctx context.Context, commands string) error {
+	if err := n.setDeadline(ctx); err != nil {
+		return err
+	}
+	if _, err := n.conn.Write([]byte(commands)); err != nil {
+		return err
+	}
+	for {
+		line, err := n.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		switch line = strings.TrimSpace(line); {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := n.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+		// +OK and INFO updates need no answer
+	}
+}
+
+// setDeadline bounds the next exchange by the timeout and the context's deadline, whichever comes first
+func (n *NATS) setDeadline(ctx context.Context) error {
+	deadline := time.Now().Add(n.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	return n.conn.SetDeadline(deadline)
+}
+
+func (n *NATS) closeConn() {
+	if n.conn != nil {
+		n.conn.Close()
+		n.conn = nil
+		n.reader = nil
+	}
+}
+
+func (n *NATS) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.closeConn()
+	return nil
+}
@@ -0,0 +1,153 @@
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+)
+
+// natsMessage is an HPUB the fake server received
+type natsMessage struct {
+	subject string
+	header  string
+	body    []byte
+}
+
+// fakeNATS accepts connections on a local listener and speaks the server side of the protocol the sink
+// uses. Every publish is answered with reply, such as "PONG" or "-ERR 'Permissions Violation'".
+type fakeNATS struct {
+	listener net.Listener
+	info     string
+	reply    chan string
+	messages chan natsMessage
+}
+
+func newFakeNATS(t *testing.T, info string) *fakeNATS {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	f := &fakeNATS{listener: listener, info: info, reply: make(chan string, 10), messages: make(chan natsMessage, 10)}
+	t.Cleanup(func() { listener.Close() })
+	go f.serve()
+	return f
+}
+
+func (f *fakeNATS) url() string {
+	return "nats://" + f.listener.Addr().String()
+}
+
+func (f *fakeNATS) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeNATS) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	fmt.Fprintf(conn, "INFO %s\r\n", f.info)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		switch fields := strings.Fields(line); {
+		case len(fields) == 0:
+		case fields[0] == "CONNECT":
+		case fields[0] == "PING":
+			// The handshake's PING; publishes are answered below
+			fmt.Fprint(conn, "PONG\r\n")
+		case fields[0] == "HPUB" && len(fields) == 4:
+			var headerLen, totalLen int
+			fmt.Sscan(fields[2], &headerLen)
+			fmt.Sscan(fields[3], &totalLen)
+			payload := make([]byte, totalLen+2) // With the trailing CRLF
+			if _, err := io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			if ping, err := reader.ReadString('\n'); err != nil || strings.TrimSpace(ping) != "PING" {
+				return
+			}
+			f.messages <- natsMessage{subject: fields[1], header: string(payload[:headerLen]), body: payload[headerLen:totalLen]}
+			fmt.Fprintf(conn, "%s\r\n", <-f.reply)
+		}
+	}
+}
+
+func TestNATS_PublishesWithMessageID(t *testing.T) {
+	server := newFakeNATS(t, `{"server_id":"fake","headers":true}`)
+	sink, err := NewNATS(server.url(), "outbox.", time.Second)
+	if err != nil {
+		t.Fatalf("NewNATS: %v", err)
+	}
+	defer sink.Close()
+	ctx := context.Background()
+
+	event := &domain.Event{ID: uuid.New(), AggregateType: "question", AggregateID: uuid.New(), Type: "question.created", Payload: []byte(`{"level":"Mid"}`)}
+	server.reply <- "PONG"
+	if err := sink.Publish(ctx, event); err != nil {
+		t.Fatalf("expected the event published, got %v", err)
+	}
+	msg := <-server.messages
+	if msg.subject != "outbox.question.created" || !strings.Contains(msg.header, "Nats-Msg-Id: "+event.ID.String()) {
+		t.Fatalf("unexpected message %q %q", msg.subject, msg.header)
+	}
+	var got domain.Event
+	if err := json.Unmarshal(msg.body, &got); err != nil || got.ID != event.ID || string(got.Payload) != `{"level":"Mid"}` {
+		t.Fatalf("expected the event as JSON, got %s (%v)", msg.body, err)
+	}
+
+	// A server error fails the publish, and the next one reconnects
+	server.reply <- "-ERR 'Permissions Violation'"
+	if err := sink.Publish(ctx, event); err == nil || !strings.Contains(err.Error(), "Permissions Violation") {
+		t.Fatalf("expected the server error, got %v", err)
+	}
+	<-server.messages
+	server.reply <- "PONG"
+	if err := sink.Publish(ctx, event); err != nil {
+		t.Fatalf("expected a publish after reconnecting, got %v", err)
+	}
+	<-server.messages
+}
+
+func TestNATS_TimesOutWithoutAnAcknowledgement(t *testing.T) {
+	server := newFakeNATS(t, `{"headers":true}`)
+	sink, _ := NewNATS(server.url(), "outbox", 50*time.Millisecond)
+	defer sink.Close()
+
+	// No reply is queued, so the PONG never comes
+	if err := sink.Publish(context.Background(), &domain.Event{ID: uuid.New(), Type: "attempt.graded", Payload: []byte(`{}`)}); err == nil {
+		t.Fatal("expected an unacknowledged publish to fail")
+	}
+	<-server.messages
+	server.reply <- "PONG" // Lets the stuck handler finish
+}
+
+func TestNATS_RequiresHeaderSupport(t *testing.T) {
+	server := newFakeNATS(t, `{"headers":false}`)
+	sink, _ := NewNATS(server.url(), "outbox", time.Second)
+	defer sink.Close()
+
+	err := sink.Publish(context.Background(), &domain.Event{ID: uuid.New(), Type: "question.created", Payload: []byte(`{}`)})
+	if err == nil || !strings.Contains(err.Error(), "headers") {
+		t.Fatalf("expected a server without headers to be rejected, got %v", err)
+	}
+	if _, err := NewNATS("nats://", "outbox", time.Second); err == nil {
+		t.Fatal("expected a URL without a host to be rejected")
+	}
+}
@@ -0,0 +1,59 @@
+package sinks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+)
+
+func TestWebhook_SignsTheBody(t *testing.T) {
+	event := &domain.Event{ID: uuid.New(), Type: "answer.accepted", Payload: []byte(`{"answer_id":"a"}`)}
+	var body []byte
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		header = r.Header
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	if err := NewWebhook(srv.URL, "s3cret", time.Second).Publish(context.Background(), event); err != nil {
+		t.Fatalf("expected a 2xx to count as delivered, got %v", err)
+	}
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); header.Get("X-Outbox-Signature") != want {
+		t.Fatalf("expected signature %s, got %s", want, header.Get("X-Outbox-Signature"))
+	}
+	if header.Get("X-Event-ID") != event.ID.String() || header.Get("X-Event-Type") != event.Type || !strings.Contains(string(body), `"answer_id":"a"`) {
+		t.Fatalf("unexpected request %v %s", header, body)
+	}
+
+	// Without a secret nothing is signed
+	if err := NewWebhook(srv.URL, "", time.Second).Publish(context.Background(), event); err != nil || header.Get("X-Outbox-Signature") != "" {
+		t.Fatalf("expected an unsigned request, got %q (%v)", header.Get("X-Outbox-Signature"), err)
+	}
+}
+
+func TestWebhook_FailsOnNon2xx(t *testing.T) {
+	for _, status := range []int{http.StatusMovedPermanently, http.StatusBadRequest, http.StatusServiceUnavailable} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+		err := NewWebhook(srv.URL, "", time.Second).Publish(context.Background(), &domain.Event{ID: uuid.New(), Payload: []byte(`{}`)})
+		srv.Close()
+		if err == nil || !strings.Contains(err.Error(), "status") {
+			t.Errorf("status %d: expected a failed delivery, got %v", status, err)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+)
+
+type OutboxRepository interface {
+	// ClaimPending returns up to limit unpublished events that are due and have failed fewer than maxAttempts
+	// times, oldest first, and hides them from other relays for the lease. An event waits while an earlier
+	// event of its aggregate is still pending and not due, so each aggregate's events go out in order.
+	ClaimPending(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]*domain.Event, error)
+	MarkPublished(ctx context.Context, id uuid.UUID) error
+	// MarkFailed counts a failed delivery and makes the event due again at retryAt
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error
+	// Postpone makes an event that was claimed but not attempted due again at availableAt
+	Postpone(ctx context.Context, id uuid.UUID, availableAt time.Time) error
+}
+
+// Sink is where the relay publishes events; an error leaves the event to be retried
+type Sink interface {
+	Publish(ctx context.Context, event *domain.Event) error
+	Close() error
+}
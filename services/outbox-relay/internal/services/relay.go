@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+	"github.com/question-interviewer/outbox-relay/internal/ports"
+)
+
+// leaseMargin is the part of the lease left for the outbox writes of a batch
+const leaseMargin = 30 * time.Second
+
+// RelayConfig tunes how the relay polls the outbox and retries failed deliveries
+type RelayConfig struct {
+	BatchSize    int
+	PollInterval time.Duration // Wait between polls once the outbox is drained
+	// SinkTimeout bounds each publish
+	SinkTimeout time.Duration
+	// Lease hides claimed events from other relays; it must outlast publishing a batch (see MinLease)
+	Lease time.Duration
+	// MaxAttempts failed deliveries park an event; it stays in the outbox with its last error
+	MaxAttempts int
+	// Retries wait RetryBackoff, doubling with each failed attempt up to MaxRetryBackoff
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+}
+
+func DefaultRelayConfig() RelayConfig {
+	config := RelayConfig{
+		BatchSize:       100,
+		PollInterval:    time.Second,
+		SinkTimeout:     5 * time.Second,
+		MaxAttempts:     10,
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: 5 * time.Minute,
+	}
+	config.Lease = config.MinLease()
+	return config
+}
+
+// MinLease is how long a batch can take when every publish times out, plus a margin for the outbox writes.
+// A shorter lease lets another relay claim events this one is still publishing.
+func (c RelayConfig) MinLease() time.Duration {
+	return time.Duration(c.BatchSize)*c.SinkTimeout + leaseMargin
+}
+
+// Relay publishes outbox events to a sink
+type Relay struct {
+	repo   ports.OutboxRepository
+	sink   ports.Sink
+	config RelayConfig
+	now    func() time.Time
+}
+
+func NewRelay(repo ports.OutboxRepository, sink ports.Sink, config RelayConfig) *Relay {
+	return &Relay{
+		repo:   repo,
+		sink:   sink,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Run relays batches until ctx is cancelled, polling again at once while batches come back full
+func (r *Relay) Run(ctx context.Context) {
+	for {
+		claimed, err := r.RelayOnce(ctx)
+		if err != nil {
+			log.Printf("outbox relay: %v", err)
+		}
+		if err == nil && claimed == r.config.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.config.PollInterval):
+		}
+	}
+}
+
+// RelayOnce claims one batch and publishes it, returning how many events were claimed.
+// An event the sink rejects is scheduled for retry, and the later events of its aggregate in the batch
+// are postponed until then so they never overtake it; other aggregates' events still go out.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	events, err := r.repo.ClaimPending(ctx, r.config.BatchSize, r.config.MaxAttempts, r.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	blocked := make(map[string]time.Time) // aggregate → retry time of its failed event
+	for _, event := range events {
+		aggregate := event.AggregateType + "/" + event.AggregateID.String()
+		if retryAt, ok := blocked[aggregate]; ok {
+			if err := r.repo.Postpone(ctx, event.ID, retryAt); err != nil {
+				return len(events), err
+			}
+			continue
+		}
+
+		if err := r.publish(ctx, event); err != nil {
+			retryAt := r.now().Add(r.backoff(event.Attempts))
+			blocked[aggregate] = retryAt
+			if event.Attempts+1 >= r.config.MaxAttempts {
+				log.Printf("outbox relay: giving up on %s event %s after %d attempts: %v", event.Type, event.ID, event.Attempts+1, err)
+			}
+			if err := r.repo.MarkFailed(ctx, event.ID, err.Error(), retryAt); err != nil {
+				return len(events), err
+			}
+			continue
+		}
+		if err := r.repo.MarkPublished(ctx, event.ID); err != nil {
+			return len(events), err
+		}
+	}
+	return len(events), nil
+}
+
+// publish hands one event to the sink within the sink timeout, which MinLease counts on
+func (r *Relay) publish(ctx context.Context, event *domain.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, r.config.SinkTimeout)
+	defer cancel()
+	return r.sink.Publish(ctx, event)
+}
+
+// backoff is the wait before retrying an event that has already failed attempts times
+func (r *Relay) backoff(attempts int) time.Duration {
+	wait := r.config.RetryBackoff
+	for i := 0; i < attempts && wait < r.config.MaxRetryBackoff; i++ {
+		wait *= 2
+	}
+	if wait > r.config.MaxRetryBackoff {
+		wait = r.config.MaxRetryBackoff
+	}
+	return wait
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/outbox-relay/internal/adapters/sinks"
+	"github.com/question-interviewer/outbox-relay/internal/domain"
+	"github.com/question-interviewer/outbox-relay/internal/ports"
+)
+
+type fakeOutbox struct {
+	events    []*domain.Event
+	published map[uuid.UUID]bool
+	failures  map[uuid.UUID]string
+	retryAt   map[uuid.UUID]time.Time
+	postponed map[uuid.UUID]time.Time
+}
+
+func newFakeOutbox(events ...*domain.Event) *fakeOutbox {
+	return &fakeOutbox{
+		events:    events,
+		published: make(map[uuid.UUID]bool),
+		failures:  make(map[uuid.UUID]string),
+		retryAt:   make(map[uuid.UUID]time.Time),
+		postponed: make(map[uuid.UUID]time.Time),
+	}
+}
+
+func (o *fakeOutbox) ClaimPending(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]*domain.Event, error) {
+	var claimed []*domain.Event
+	for _, e := range o.events {
+		if _, waiting := o.postponed[e.ID]; !waiting && !o.published[e.ID] && e.Attempts < maxAttempts && len(claimed) < limit {
+			claimed = append(claimed, e)
+		}
+	}
+	return claimed, nil
+}
+func (o *fakeOutbox) MarkPublished(ctx context.Context, id uuid.UUID) error {
+	o.published[id] = true
+	return nil
+}
+func (o *fakeOutbox) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	for _, e := range o.events {
+		if e.ID == id {
+			e.Attempts++
+		}
+	}
+	o.failures[id] = reason
+	o.retryAt[id] = retryAt
+	return nil
+}
+
+func (o *fakeOutbox) Postpone(ctx context.Context, id uuid.UUID, availableAt time.Time) error {
+	o.postponed[id] = availableAt
+	return nil
+}
+
+var _ ports.OutboxRepository = (*fakeOutbox)(nil)
+
+func newEvent(eventType string) *domain.Event {
+	return &domain.Event{ID: uuid.New(), AggregateType: "question", AggregateID: uuid.New(), Type: eventType, Payload: []byte(`{}`)}
+}
+
+func TestRelayOnce_PublishesAndRetriesWithBackoff(t *testing.T) {
+	created, graded := newEvent("question.created"), newEvent("attempt.graded")
+	outbox := newFakeOutbox(created, graded)
+	sink := sinks.NewMemory()
+	config := DefaultRelayConfig()
+	config.MaxAttempts = 3
+	relay := NewRelay(outbox, sink, config)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	relay.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	sink.FailWith(errors.New("sink down"))
+	for attempt := 1; attempt <= 3; attempt++ {
+		if claimed, err := relay.RelayOnce(ctx); err != nil || claimed != 2 {
+			t.Fatalf("attempt %d: expected both events claimed, got %d (%v)", attempt, claimed, err)
+		}
+		// 1s, then 2s, then 4s
+		if want := now.Add(time.Second << (attempt - 1)); !outbox.retryAt[created.ID].Equal(want) {
+			t.Fatalf("attempt %d: expected a retry at %v, got %v", attempt, want, outbox.retryAt[created.ID])
+		}
+	}
+	if outbox.failures[created.ID] != "sink down" || created.Attempts != 3 {
+		t.Fatalf("expected the failure to be recorded, got %q after %d attempts", outbox.failures[created.ID], created.Attempts)
+	}
+	if claimed, _ := relay.RelayOnce(ctx); claimed != 0 {
+		t.Fatalf("expected events past max attempts to be parked, got %d claimed", claimed)
+	}
+
+	// A fresh event goes out once the sink recovers
+	fresh := newEvent("answer.accepted")
+	outbox.events = append(outbox.events, fresh)
+	sink.FailWith(nil)
+	if claimed, err := relay.RelayOnce(ctx); err != nil || claimed != 1 {
+		t.Fatalf("expected the new event claimed, got %d (%v)", claimed, err)
+	}
+	if events := sink.Events(); len(events) != 1 || events[0].ID != fresh.ID || !outbox.published[fresh.ID] {
+		t.Fatalf("expected the event published and marked, got %+v", events)
+	}
+}
+
+func TestRelay_BackoffIsCapped(t *testing.T) {
+	config := DefaultRelayConfig()
+	relay := NewRelay(newFakeOutbox(), sinks.NewMemory(), config)
+	if got := relay.backoff(0); got != config.RetryBackoff {
+		t.Fatalf("expected the first retry after %v, got %v", config.RetryBackoff, got)
+	}
+	if got := relay.backoff(40); got != config.MaxRetryBackoff {
+		t.Fatalf("expected the backoff capped at %v, got %v", config.MaxRetryBackoff, got)
+	}
+}
+
+func TestRelayOnce_KeepsEachAggregateInOrder(t *testing.T) {
+	created, updated, other := newEvent("question.created"), newEvent("question.updated"), newEvent("question.created")
+	updated.AggregateID = created.AggregateID
+	outbox := newFakeOutbox(created, updated, other)
+	sink := sinks.NewInProcess()
+	sink.Subscribe(sinks.AllEvents, func(ctx context.Context, event *domain.Event) error {
+		if event.ID == created.ID {
+			return errors.New("consumer down")
+		}
+		return nil
+	})
+	relay := NewRelay(outbox, sink, DefaultRelayConfig())
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	relay.now = func() time.Time { return now }
+
+	if claimed, err := relay.RelayOnce(context.Background()); err != nil || claimed != 3 {
+		t.Fatalf("expected the batch claimed, got %d (%v)", claimed, err)
+	}
+	// The update waits for the failed create's retry instead of overtaking it
+	if outbox.published[updated.ID] || !outbox.postponed[updated.ID].Equal(outbox.retryAt[created.ID]) || updated.Attempts != 0 {
+		t.Fatalf("expected the later event postponed to %v without an attempt, got %v", outbox.retryAt[created.ID], outbox.postponed[updated.ID])
+	}
+	if !outbox.published[other.ID] {
+		t.Fatal("expected another aggregate's event to go out")
+	}
+}
+
+func TestRelayConfig_LeaseCoversATimedOutBatch(t *testing.T) {
+	config := DefaultRelayConfig()
+	if config.Lease != config.MinLease() || config.MinLease() <= time.Duration(config.BatchSize)*config.SinkTimeout {
+		t.Fatalf("expected the default lease to outlast a batch of timeouts, got %v", config.Lease)
+	}
+	config.BatchSize, config.SinkTimeout = 10, time.Second
+	if got := config.MinLease(); got != 10*time.Second+leaseMargin {
+		t.Fatalf("expected the lease to scale with the batch, got %v", got)
+	}
+}
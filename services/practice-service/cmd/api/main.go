@@ -5,13 +5,19 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/question-interviewer/practice-service/internal/adapters/ai"
+	"github.com/question-interviewer/practice-service/internal/adapters/answers"
 	http_adapter "github.com/question-interviewer/practice-service/internal/adapters/http"
 	"github.com/question-interviewer/practice-service/internal/adapters/postgres"
+	"github.com/question-interviewer/practice-service/internal/adapters/questions"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
 	"github.com/question-interviewer/practice-service/internal/services"
 )
 
@@ -73,11 +79,74 @@ func main() {
 		}
 	}
 
+	// Answer Service Config: attempts are compared with its answers, and AI samples are published there
+	// as suggested answers when the service key is set too
+	answerServiceURL := os.Getenv("ANSWER_SERVICE_URL")
+	serviceKey := os.Getenv("SERVICE_API_KEY")
+
+	// Question Service Config: questions are read and created through it, with the database as the
+	// fallback for reads; unset, practice reads and writes the question tables directly
+	questionServiceURL := os.Getenv("QUESTION_SERVICE_URL")
+	questionServiceTimeout := 3 * time.Second
+	if v := os.Getenv("QUESTION_SERVICE_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("Invalid QUESTION_SERVICE_TIMEOUT: %v", err)
+		}
+		questionServiceTimeout = d
+	}
+	questionCacheTTL := 5 * time.Minute
+	if v := os.Getenv("QUESTION_CACHE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("Invalid QUESTION_CACHE_TTL: %v", err)
+		}
+		questionCacheTTL = d
+	}
+
+	// Near-duplicate thresholds for questions created here without question-service
+	// (trigram similarity of content, 0..1); the same variables configure question-service
+	duplicates := domain.DefaultDuplicatePolicy()
+	for env, threshold := range map[string]*float64{
+		"DUPLICATE_WARN_THRESHOLD":   &duplicates.WarnThreshold,
+		"DUPLICATE_REJECT_THRESHOLD": &duplicates.RejectThreshold,
+	} {
+		if v := os.Getenv(env); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f <= 0 || f > 1 {
+				log.Fatalf("Invalid %s: must be a number in (0, 1]", env)
+			}
+			*threshold = f
+		}
+	}
+
+	// JWT Config (tokens are issued by the BFF with the same secret)
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET is required")
+	}
+
 	// Dependency Injection
 	repo := postgres.NewPracticeRepository(db)
+	var questionCatalog ports.QuestionCatalog = postgres.NewQuestionCatalog(db, duplicates)
+	if questionServiceURL != "" {
+		questionCatalog = questions.NewQuestionClient(questionServiceURL, questionServiceTimeout, questionCacheTTL, questionCatalog)
+	} else {
+		log.Println("QUESTION_SERVICE_URL not set, reading and creating questions in the database directly")
+	}
 	aiClient := ai.NewAIClient(aiServiceURL)
-	svc := services.NewPracticeService(repo, aiClient, aiEnabled)
+	var answerService ports.AnswerService
+	if answerServiceURL != "" {
+		answerService = answers.NewAnswerClient(answerServiceURL, serviceKey)
+		if serviceKey == "" {
+			log.Println("SERVICE_API_KEY not set, AI sample answers stay in the local cache")
+		}
+	} else {
+		log.Println("ANSWER_SERVICE_URL not set, attempts are compared with the correct answer only")
+	}
+	svc := services.NewPracticeService(repo, questionCatalog, aiClient, aiEnabled, answerService)
 	handler := http_adapter.NewPracticeHandler(svc)
+	bookmarkHandler := http_adapter.NewBookmarkHandler(services.NewBookmarkService(postgres.NewBookmarkRepository(db), questionCatalog))
 
 	// Router Setup
 	r := gin.Default()
@@ -94,11 +163,16 @@ func main() {
 		c.Next()
 	})
 
+	// Auth Middleware (injects the authenticated user ID)
+	r.Use(http_adapter.Authenticate([]byte(jwtSecret)))
+	r.Use(http_adapter.Authorize(http_adapter.RoutePolicy, postgres.NewUserRoleRepository(db)))
+
 	r.GET("/health", func(c *gin.Context) {
 		c.String(200, "OK")
 	})
 
 	handler.RegisterRoutes(r)
+	bookmarkHandler.RegisterRoutes(r)
 
 	// Start Server
 	log.Println("Server listening on :8080")
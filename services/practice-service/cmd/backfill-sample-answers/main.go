@@ -13,7 +13,10 @@ import (
 	"github.com/google/uuid"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/question-interviewer/practice-service/internal/adapters/ai"
+	"github.com/question-interviewer/practice-service/internal/adapters/answers"
 	"github.com/question-interviewer/practice-service/internal/adapters/postgres"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
 	"github.com/question-interviewer/practice-service/internal/services"
 )
 
@@ -38,15 +41,20 @@ func main() {
 
 	repo := postgres.NewPracticeRepository(db)
 	aiClient := ai.NewAIClient(aiServiceURL)
-	svc := services.NewPracticeService(repo, aiClient, true)
+	var answerService ports.AnswerService
+	if answerServiceURL, serviceKey := os.Getenv("ANSWER_SERVICE_URL"), os.Getenv("SERVICE_API_KEY"); answerServiceURL != "" && serviceKey != "" {
+		answerService = answers.NewAnswerClient(answerServiceURL, serviceKey)
+	}
+	svc := services.NewPracticeService(repo, postgres.NewQuestionCatalog(db, domain.DefaultDuplicatePolicy()), aiClient, true, answerService)
 
 	ctx := context.Background()
 
 	rows, err := db.QueryContext(ctx, `
-		SELECT id
-		FROM questions
-		WHERE COALESCE(sample_source, '') <> 'ai'
-		ORDER BY created_at DESC
+		SELECT q.id
+		FROM questions q
+		LEFT JOIN practice_question_samples s ON s.question_id = q.id
+		WHERE COALESCE(s.sample_source, '') <> 'ai'
+		ORDER BY q.created_at DESC
 		LIMIT $1
 	`, limit)
 	if err != nil {
@@ -67,7 +75,7 @@ func main() {
 	}
 
 	for i, id := range ids {
-		_, _, _, improved, err := svc.SuggestAnswer(ctx, id, "", language)
+		_, _, _, improved, err := svc.SuggestAnswer(ctx, id, "", language, "")
 		if err != nil {
 			log.Printf("Backfill failed for %s: %v", id, err)
 		} else if strings.TrimSpace(improved) == "" {
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/question-interviewer/practice-service/internal/domain"
+)
+
+type BilingualText struct {
+	En string `json:"en" yaml:"en"`
+	Vi string `json:"vi" yaml:"vi"`
+}
+
+// Concept fills the {Concept} and {KeyPoints} placeholders of every format in its pack
+type Concept struct {
+	ID        string        `json:"id" yaml:"id"` // Defaults to the slug of the English name; set it to keep the key when renaming
+	Name      BilingualText `json:"name" yaml:"name"`
+	KeyPoints BilingualText `json:"key_points" yaml:"key_points"`
+	Hint      BilingualText `json:"hint" yaml:"hint"` // Appended to the format hint
+}
+
+// Format is a question shape asked once per concept
+type Format struct {
+	ID            string        `json:"id" yaml:"id"`
+	Level         string        `json:"level" yaml:"level"`
+	Title         BilingualText `json:"title" yaml:"title"`
+	Content       BilingualText `json:"content" yaml:"content"`
+	CorrectAnswer BilingualText `json:"correct_answer" yaml:"correct_answer"`
+	Hint          BilingualText `json:"hint" yaml:"hint"`
+}
+
+// TemplatePack is one seed data file. A pack may declare topics, and/or generate questions for one
+// topic from every concept × format pair plus any literal questions.
+//
+// Seeded questions are keyed by the pack, concept and format IDs (or the pack and question IDs), so
+// editing a question's text updates it in place. Changing an ID seeds a new question instead.
+type TemplatePack struct {
+	File      string             `json:"-" yaml:"-"`
+	ID        string             `json:"id" yaml:"id"` // Required when the pack has questions
+	Topics    []TopicSeed        `json:"topics" yaml:"topics"`
+	Topic     string             `json:"topic" yaml:"topic"`
+	Role      string             `json:"role" yaml:"role"`
+	Concepts  []Concept          `json:"concepts" yaml:"concepts"`
+	Formats   []Format           `json:"formats" yaml:"formats"`
+	Questions []QuestionTemplate `json:"questions" yaml:"questions"`
+}
+
+// LoadPacks reads every .yaml, .yml and .json pack in dir, in file name order
+func LoadPacks(dir string) ([]TemplatePack, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pack directory: %w", err)
+	}
+	names := []string{}
+	for _, e := range entries {
+		switch strings.ToLower(filepath.Ext(e.Name())) {
+		case ".yaml", ".yml", ".json":
+			if !e.IsDir() {
+				names = append(names, e.Name())
+			}
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no packs found in %s", dir)
+	}
+
+	packs := make([]TemplatePack, 0, len(names))
+	files := map[string]string{} // pack ID → file declaring it
+	for _, name := range names {
+		pack, err := loadPack(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if pack.ID != "" {
+			if other, ok := files[pack.ID]; ok {
+				return nil, fmt.Errorf("%s: pack id %q is already used by %s", name, pack.ID, other)
+			}
+			files[pack.ID] = name
+		}
+		pack.File = name
+		packs = append(packs, *pack)
+	}
+	return packs, nil
+}
+
+func loadPack(path string) (*TemplatePack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var pack TemplatePack
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&pack)
+	} else {
+		err = yaml.UnmarshalWithOptions(data, &pack, yaml.DisallowUnknownField())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := pack.Validate(); err != nil {
+		return nil, err
+	}
+	return &pack, nil
+}
+
+// Validate checks that a pack can be expanded; duplicate questions are caught by ValidateQuestions
+func (p *TemplatePack) Validate() error {
+	if len(p.Concepts) > 0 != (len(p.Formats) > 0) {
+		return fmt.Errorf("concepts and formats must be given together")
+	}
+	for _, t := range p.Topics {
+		if strings.TrimSpace(t.Name) == "" {
+			return fmt.Errorf("topic without a name")
+		}
+	}
+	if len(p.Topics) == 0 && len(p.Formats) == 0 && len(p.Questions) == 0 {
+		return fmt.Errorf("pack declares no topics or questions")
+	}
+	if len(p.Formats) > 0 && p.Topic == "" {
+		return fmt.Errorf("topic is required for concepts and formats")
+	}
+	if (len(p.Formats) > 0 || len(p.Questions) > 0) && !isSeedID(p.ID) {
+		return fmt.Errorf("id is required for packs with questions (lower-case letters, digits and hyphens)")
+	}
+	concepts := map[string]bool{}
+	for i, c := range p.Concepts {
+		id := c.key()
+		if !isSeedID(id) {
+			return fmt.Errorf("concept %d: an English name or id is required", i+1)
+		}
+		if concepts[id] {
+			return fmt.Errorf("concept %d: id %q is used twice", i+1, id)
+		}
+		concepts[id] = true
+	}
+	formats := map[string]bool{}
+	for i, f := range p.Formats {
+		if !isSeedID(f.ID) || formats[f.ID] {
+			return fmt.Errorf("format %d: a unique id is required (lower-case letters, digits and hyphens)", i+1)
+		}
+		formats[f.ID] = true
+		if f.Level == "" || f.Title.En == "" || f.Title.Vi == "" || f.Content.En == "" || f.Content.Vi == "" {
+			return fmt.Errorf("format %d: level, title and content (en and vi) are required", i+1)
+		}
+	}
+	questions := map[string]bool{}
+	for i, q := range p.Questions {
+		if !isSeedID(q.ID) || questions[q.ID] {
+			return fmt.Errorf("question %d: a unique id is required (lower-case letters, digits and hyphens)", i+1)
+		}
+		questions[q.ID] = true
+		if q.Topic == "" && p.Topic == "" {
+			return fmt.Errorf("question %d: topic is required", i+1)
+		}
+		if q.Level == "" || q.Title.En == "" || q.Title.Vi == "" || q.Content.En == "" || q.Content.Vi == "" {
+			return fmt.Errorf("question %d: level, title and content (en and vi) are required", i+1)
+		}
+	}
+	return nil
+}
+
+// key is the concept's part of its questions' seed IDs
+func (c Concept) key() string {
+	if c.ID != "" {
+		return c.ID
+	}
+	return domain.Slugify(c.Name.En)
+}
+
+// isSeedID reports whether id is a non-empty slug, so seed IDs stay readable and free of the "/" separator
+func isSeedID(id string) bool {
+	return id != "" && domain.Slugify(id) == id
+}
+
+// BuildTopics collects the topics declared across all packs
+func BuildTopics(packs []TemplatePack) []TopicSeed {
+	topics := []TopicSeed{}
+	for _, p := range packs {
+		topics = append(topics, p.Topics...)
+	}
+	return topics
+}
+
+// BuildQuestionTemplates expands each pack's concepts × formats and appends its literal questions,
+// prefixing every template ID with the pack ID
+func BuildQuestionTemplates(packs []TemplatePack) []QuestionTemplate {
+	templates := []QuestionTemplate{}
+	for _, p := range packs {
+		for _, t := range BuildFromConcepts(p.Topic, p.Role, p.Concepts, p.Formats) {
+			t.ID = p.ID + "/" + t.ID
+			templates = append(templates, t)
+		}
+		for _, q := range p.Questions {
+			q.ID = p.ID + "/" + q.ID
+			if q.Topic == "" {
+				q.Topic = p.Topic
+			}
+			if q.Role == "" {
+				q.Role = p.Role
+			}
+			templates = append(templates, q)
+		}
+	}
+	return templates
+}
+
+func Render(text string, data map[string]string) string {
+	out := text
+	for k, v := range data {
+		out = strings.ReplaceAll(out, "{"+k+"}", v)
+	}
+	return out
+}
+
+func RenderBilingual(text BilingualText, dataEn map[string]string, dataVi map[string]string) BilingualText {
+	return BilingualText{
+		En: Render(text.En, dataEn),
+		Vi: Render(text.Vi, dataVi),
+	}
+}
+
+func BuildFromConcepts(topic string, role string, concepts []Concept, formats []Format) []QuestionTemplate {
+	templates := make([]QuestionTemplate, 0, len(concepts)*len(formats))
+	for _, c := range concepts {
+		for _, f := range formats {
+			dataEn := map[string]string{
+				"Concept":   c.Name.En,
+				"KeyPoints": c.KeyPoints.En,
+			}
+			dataVi := map[string]string{
+				"Concept":   c.Name.Vi,
+				"KeyPoints": c.KeyPoints.Vi,
+			}
+
+			templates = append(templates, QuestionTemplate{
+				ID:            c.key() + "/" + f.ID,
+				Topic:         topic,
+				Title:         RenderBilingual(f.Title, dataEn, dataVi),
+				Content:       RenderBilingual(f.Content, dataEn, dataVi),
+				Level:         f.Level,
+				Role:          role,
+				CorrectAnswer: RenderBilingual(f.CorrectAnswer, dataEn, dataVi),
+				Hint: RenderBilingual(BilingualText{
+					En: strings.TrimSpace(f.Hint.En + " " + c.Hint.En),
+					Vi: strings.TrimSpace(f.Hint.Vi + " " + c.Hint.Vi),
+				}, dataEn, dataVi),
+			})
+		}
+	}
+	return templates
+}
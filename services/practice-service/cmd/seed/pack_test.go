@@ -0,0 +1,175 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePack(t *testing.T, dir, name, body string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
+
+const goPack = `id: golang
+topic: Golang
+role: BackEnd
+concepts:
+- name: {en: channels, vi: channel}
+  key_points: {en: blocking semantics, vi: blocking}
+- id: ctx
+  name: {en: context cancellation, vi: context cancellation}
+  key_points: {en: deadlines, vi: deadline}
+formats:
+- id: explain
+  level: Junior
+  title: {en: "Go: {Concept}", vi: "Go: {Concept}"}
+  content: {en: "Explain {Concept}.", vi: "Giải thích {Concept}."}
+  correct_answer: {en: "Cover: {KeyPoints}.", vi: "Nêu: {KeyPoints}."}
+questions:
+- id: gc-tuning
+  level: Senior
+  title: {en: GC tuning, vi: Tinh chỉnh GC}
+  content: {en: How do you tune the Go GC?, vi: Bạn tinh chỉnh GC của Go thế nào?}
+`
+
+func TestLoadPacks_ReadsYAMLAndJSONInOrder(t *testing.T) {
+	dir := t.TempDir()
+	writePack(t, dir, "00-topics.json", `{"topics": [{"name": "Golang", "aliases": ["go"]}]}`)
+	writePack(t, dir, "01-golang.yaml", goPack)
+	writePack(t, dir, "README.md", "not a pack")
+
+	packs, err := LoadPacks(dir)
+	if err != nil {
+		t.Fatalf("LoadPacks: %v", err)
+	}
+	if len(packs) != 2 || packs[0].File != "00-topics.json" || packs[1].File != "01-golang.yaml" {
+		t.Fatalf("unexpected packs %+v", packs)
+	}
+	if topics := BuildTopics(packs); len(topics) != 1 || topics[0].Name != "Golang" {
+		t.Fatalf("unexpected topics %+v", topics)
+	}
+
+	templates := BuildQuestionTemplates(packs)
+	var ids []string
+	for _, tmpl := range templates {
+		ids = append(ids, tmpl.ID)
+	}
+	if got := strings.Join(ids, ","); got != "golang/channels/explain,golang/ctx/explain,golang/gc-tuning" {
+		t.Fatalf("unexpected template IDs %s", got)
+	}
+	if templates[2].Topic != "Golang" || templates[2].Role != "BackEnd" {
+		t.Fatalf("literal question did not inherit the pack topic and role: %+v", templates[2])
+	}
+}
+
+func TestLoadPacks_RejectsBadPacks(t *testing.T) {
+	cases := map[string]struct {
+		files map[string]string
+		want  string
+	}{
+		"unknown field": {
+			files: map[string]string{"a.yaml": "topic: Go\nid: go\nsurprise: true\n"},
+			want:  "surprise",
+		},
+		"missing pack id": {
+			files: map[string]string{"a.yaml": strings.Replace(goPack, "id: golang\n", "", 1)},
+			want:  "id is required",
+		},
+		"missing format id": {
+			files: map[string]string{"a.yaml": strings.Replace(goPack, "- id: explain\n  level", "- level", 1)},
+			want:  "format 1",
+		},
+		"duplicate concept": {
+			files: map[string]string{"a.yaml": strings.Replace(goPack, "- id: ctx\n", "- id: channels\n", 1)},
+			want:  `id "channels" is used twice`,
+		},
+		"duplicate pack id": {
+			files: map[string]string{"a.yaml": goPack, "b.yaml": goPack},
+			want:  "already used by a.yaml",
+		},
+		"missing translation": {
+			files: map[string]string{"a.yaml": strings.Replace(goPack, ", vi: Tinh chỉnh GC", "", 1)},
+			want:  "question 1",
+		},
+	}
+	for name, tc := range cases {
+		dir := t.TempDir()
+		for file, body := range tc.files {
+			writePack(t, dir, file, body)
+		}
+		if _, err := LoadPacks(dir); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tc.want, err)
+		}
+	}
+}
+
+func TestBuildFromConcepts_RendersEveryPair(t *testing.T) {
+	concepts := []Concept{
+		{Name: BilingualText{En: "Error wrapping", Vi: "Wrap error"}, KeyPoints: BilingualText{En: "%w", Vi: "%w"}, Hint: BilingualText{En: "Mention errors.Is.", Vi: "Nêu errors.Is."}},
+	}
+	formats := []Format{
+		{ID: "explain", Level: "Junior", Title: BilingualText{En: "{Concept}", Vi: "{Concept}"}, Content: BilingualText{En: "Explain {Concept}.", Vi: "Giải thích {Concept}."}, CorrectAnswer: BilingualText{En: "Cover {KeyPoints}.", Vi: "Nêu {KeyPoints}."}, Hint: BilingualText{En: "Be brief.", Vi: "Ngắn gọn."}},
+		{ID: "pitfalls", Level: "Mid", Title: BilingualText{En: "{Concept} pitfalls", Vi: "Lỗi {Concept}"}, Content: BilingualText{En: "Pitfalls of {Concept}?", Vi: "Lỗi khi dùng {Concept}?"}},
+	}
+
+	templates := BuildFromConcepts("Golang", "BackEnd", concepts, formats)
+	if len(templates) != 2 {
+		t.Fatalf("expected 2 templates, got %d", len(templates))
+	}
+	first := templates[0]
+	if first.ID != "error-wrapping/explain" || templates[1].ID != "error-wrapping/pitfalls" {
+		t.Fatalf("unexpected IDs %q %q", first.ID, templates[1].ID)
+	}
+	if first.Content.En != "Explain Error wrapping." || first.Content.Vi != "Giải thích Wrap error." || first.CorrectAnswer.En != "Cover %w." {
+		t.Fatalf("unexpected rendering %+v", first)
+	}
+	if first.Hint.En != "Be brief. Mention errors.Is." || templates[1].Hint.En != "Mention errors.Is." {
+		t.Fatalf("unexpected hints %q %q", first.Hint.En, templates[1].Hint.En)
+	}
+}
+
+func TestExpandTemplatesToQuestions_KeysOnTemplateIDs(t *testing.T) {
+	templates := []QuestionTemplate{{
+		ID:      "golang/gc-tuning",
+		Topic:   "Golang",
+		Level:   "Senior",
+		Title:   BilingualText{En: "GC tuning", Vi: "Tinh chỉnh GC"},
+		Content: BilingualText{En: "How do you tune the GC?", Vi: "Tinh chỉnh GC thế nào?"},
+	}}
+
+	questions := ExpandTemplatesToQuestions(templates)
+	if len(questions) != 2 {
+		t.Fatalf("expected 2 questions, got %d", len(questions))
+	}
+	en, vi := questions[0], questions[1]
+	if en.ExternalID != "seed:golang/gc-tuning/en" || vi.ExternalID != "seed:golang/gc-tuning/vi" {
+		t.Fatalf("unexpected external IDs %q %q", en.ExternalID, vi.ExternalID)
+	}
+	if en.TranslationOf != "" || vi.TranslationOf != en.ExternalID {
+		t.Fatalf("expected vi to translate en, got %q %q", en.TranslationOf, vi.TranslationOf)
+	}
+
+	// Editing the text keeps the key
+	templates[0].Content.En = "How do you tune Go's garbage collector?"
+	if edited := ExpandTemplatesToQuestions(templates); edited[0].ExternalID != en.ExternalID {
+		t.Fatalf("external ID changed with the content: %q", edited[0].ExternalID)
+	}
+
+	if err := ValidateQuestions(append(questions, en)); err == nil || !strings.Contains(err.Error(), "duplicate external ID") {
+		t.Fatalf("expected a duplicate external ID error, got %v", err)
+	}
+}
+
+func TestShippedPacks_AreValid(t *testing.T) {
+	packs, err := LoadPacks("packs")
+	if err != nil {
+		t.Fatalf("LoadPacks: %v", err)
+	}
+	if err := ValidateQuestions(ExpandTemplatesToQuestions(BuildQuestionTemplates(packs))); err != nil {
+		t.Fatalf("ValidateQuestions: %v", err)
+	}
+}
@@ -35,11 +35,23 @@ type AnswerResponse struct {
 	NextQuestionID string `json:"next_question_id"`
 }
 
+// postJSON sends an authenticated POST; set AUTH_TOKEN to a token from the BFF's /api/v1/auth/login
+func postJSON(url string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token := os.Getenv("AUTH_TOKEN"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return http.DefaultClient.Do(req)
+}
+
 func main() {
 	// 1. Start Session
 	fmt.Println("Starting Session...")
 	startReq := map[string]interface{}{
-		"user_id":  "123e4567-e89b-12d3-a456-426614174000",
 		"language": "en",
 		"config": map[string]interface{}{
 			"mode":        "interview",
@@ -49,7 +61,7 @@ func main() {
 	}
 	jsonData, _ := json.Marshal(startReq)
 
-	resp, err := http.Post(baseURL+"/sessions", "application/json", bytes.NewBuffer(jsonData))
+	resp, err := postJSON(baseURL+"/sessions", jsonData)
 	if err != nil {
 		fmt.Printf("Error starting session: %v\n", err)
 		os.Exit(1)
@@ -104,7 +116,7 @@ func main() {
 			"ai_enabled":  true,
 		}
 		jsonData, _ = json.Marshal(answerReq)
-		resp, err = http.Post(baseURL+"/sessions/"+sessionID+"/answers", "application/json", bytes.NewBuffer(jsonData))
+		resp, err = postJSON(baseURL+"/sessions/"+sessionID+"/answers", jsonData)
 		if err != nil {
 			fmt.Printf("Error submitting answer: %v\n", err)
 			break
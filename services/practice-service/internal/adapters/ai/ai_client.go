@@ -79,3 +79,55 @@ func (c *AIClient) EvaluateAnswer(ctx context.Context, question, userAnswer, cor
 
 	return evalResp.Score, evalResp.Feedback, evalResp.Suggestions, evalResp.ImprovedAnswer, nil
 }
+
+type FollowUpRequest struct {
+	QuestionContent string `json:"question_content"`
+	UserAnswer      string `json:"user_answer"`
+	Feedback        string `json:"feedback"`
+	Topic           string `json:"topic"`
+	Level           string `json:"level"`
+	Language        string `json:"language"`
+}
+
+type FollowUpResponse struct {
+	FollowUps []string `json:"follow_ups"`
+}
+
+func (c *AIClient) GenerateFollowUps(ctx context.Context, question, userAnswer, feedback, topic, level, language string) ([]string, error) {
+	reqBody := FollowUpRequest{
+		QuestionContent: question,
+		UserAnswer:      userAnswer,
+		Feedback:        feedback,
+		Topic:           topic,
+		Level:           level,
+		Language:        language,
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/api/v1/follow-ups", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call AI service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("AI service returned status: %d", resp.StatusCode)
+	}
+
+	var followUpResp FollowUpResponse
+	if err := json.NewDecoder(resp.Body).Decode(&followUpResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return followUpResp.FollowUps, nil
+}
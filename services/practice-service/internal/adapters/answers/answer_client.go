@@ -0,0 +1,99 @@
+package answers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+// AnswerClient talks to answer-service. Publishing suggested answers needs the shared service key;
+// without one it is skipped. Listing answers is public.
+type AnswerClient struct {
+	baseURL    string
+	serviceKey string
+	client     *http.Client
+}
+
+func NewAnswerClient(baseURL, serviceKey string) ports.AnswerService {
+	return &AnswerClient{
+		baseURL:    baseURL,
+		serviceKey: serviceKey,
+		client: &http.Client{
+			// Calls happen while a user waits for their sample answer or comparison
+			Timeout: 5 * time.Second,
+		},
+	}
+}
+
+func (c *AnswerClient) PublishSuggestedAnswer(ctx context.Context, answer domain.SuggestedAnswer) error {
+	if c.serviceKey == "" {
+		return nil
+	}
+	jsonBody, err := json.Marshal(answer)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/api/v1/answers/suggested", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Service-Key", c.serviceKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call answer service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 409: the question already has a suggested answer for this level, which is what we wanted
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusConflict {
+		return fmt.Errorf("answer service returned status: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (c *AnswerClient) ListAnswers(ctx context.Context, query domain.AnswerQuery) ([]domain.Answer, error) {
+	params := url.Values{}
+	params.Set("question_id", query.QuestionID.String())
+	params.Set("sort", "score")
+	if query.AnswerType != "" {
+		params.Set("answer_type", query.AnswerType)
+	}
+	if query.LevelTarget != "" {
+		params.Set("level_target", query.LevelTarget)
+	}
+	if query.Limit > 0 {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/api/v1/answers?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call answer service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("answer service returned status: %d", resp.StatusCode)
+	}
+
+	var answers []domain.Answer
+	if err := json.NewDecoder(resp.Body).Decode(&answers); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return answers, nil
+}
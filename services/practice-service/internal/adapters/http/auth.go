@@ -0,0 +1,94 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+)
+
+// tokenIssuer must match the "iss" claim set by the BFF when it signs access tokens
+const tokenIssuer = "question-interviewer-bff"
+
+// Gin context keys holding the authenticated user
+const (
+	userIDKey   = "user_id"
+	userRoleKey = "user_role"
+)
+
+// accessClaims mirrors the claims the BFF puts in access tokens
+type accessClaims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Authenticate verifies the Bearer JWT issued by the BFF, if present, and injects the user ID into the context.
+// The token itself rides on the request context for calls made on the user's behalf.
+// Requests without a token pass through anonymously; use RequireAuth on routes that need a user.
+func Authenticate(secret []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization header"})
+			return
+		}
+
+		var claims accessClaims
+		_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(tokenIssuer))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			return
+		}
+
+		userID, err := uuid.Parse(claims.Subject)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token subject"})
+			return
+		}
+
+		c.Set(userIDKey, userID)
+		c.Set(userRoleKey, domain.ParseRole(claims.Role))
+		c.Request = c.Request.WithContext(domain.WithAccessToken(c.Request.Context(), token))
+		c.Next()
+	}
+}
+
+// RequireAuth rejects requests that Authenticate did not attach a user to
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := userIDFromContext(c); !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+			return
+		}
+		c.Next()
+	}
+}
+
+func userIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	v, ok := c.Get(userIDKey)
+	if !ok {
+		return uuid.Nil, false
+	}
+	userID, ok := v.(uuid.UUID)
+	return userID, ok
+}
+
+func roleFromContext(c *gin.Context) domain.Role {
+	if role, ok := c.Get(userRoleKey); ok {
+		if r, ok := role.(domain.Role); ok {
+			return r
+		}
+	}
+	return domain.RoleUser
+}
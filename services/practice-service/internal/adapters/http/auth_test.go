@@ -0,0 +1,125 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+)
+
+var testSecret = []byte("test-secret")
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, claims accessClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func validClaims(subject, role string) accessClaims {
+	return accessClaims{
+		Role: role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    tokenIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+// authRouter serves GET /whoami behind Authenticate, echoing what it put on the context
+func authRouter(extra ...gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Authenticate(testSecret))
+	handlers := append(extra, func(c *gin.Context) {
+		userID, ok := userIDFromContext(c)
+		token := domain.AccessTokenFromContext(c.Request.Context())
+		c.JSON(http.StatusOK, gin.H{"user_id": userID.String(), "authenticated": ok, "role": roleFromContext(c), "token": token})
+	})
+	r.GET("/whoami", handlers...)
+	return r
+}
+
+func get(r http.Handler, authorization string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthenticate_ValidTokenSetsUserRoleAndToken(t *testing.T) {
+	userID := uuid.New()
+	token := signToken(t, jwt.SigningMethodHS256, testSecret, validClaims(userID.String(), "moderator"))
+
+	w := get(authRouter(), "Bearer "+token)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body)
+	}
+	want := `{"authenticated":true,"role":"moderator","token":"` + token + `","user_id":"` + userID.String() + `"}`
+	if w.Body.String() != want {
+		t.Fatalf("unexpected body %s", w.Body)
+	}
+}
+
+func TestAuthenticate_AnonymousRequestsPassThrough(t *testing.T) {
+	w := get(authRouter(), "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if w.Body.String() != `{"authenticated":false,"role":"user","token":"","user_id":"00000000-0000-0000-0000-000000000000"}` {
+		t.Fatalf("unexpected body %s", w.Body)
+	}
+
+	if w := get(authRouter(RequireAuth()), ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("RequireAuth: expected 401, got %d", w.Code)
+	}
+}
+
+func TestAuthenticate_RejectsBadTokens(t *testing.T) {
+	userID := uuid.New().String()
+	expired := validClaims(userID, "user")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	wrongIssuer := validClaims(userID, "user")
+	wrongIssuer.Issuer = "someone-else"
+
+	cases := map[string]string{
+		"not bearer":       "Basic abc",
+		"empty bearer":     "Bearer ",
+		"garbage":          "Bearer not-a-jwt",
+		"wrong secret":     "Bearer " + signToken(t, jwt.SigningMethodHS256, []byte("other-secret"), validClaims(userID, "user")),
+		"wrong issuer":     "Bearer " + signToken(t, jwt.SigningMethodHS256, testSecret, wrongIssuer),
+		"expired":          "Bearer " + signToken(t, jwt.SigningMethodHS256, testSecret, expired),
+		"wrong alg":        "Bearer " + signToken(t, jwt.SigningMethodHS512, testSecret, validClaims(userID, "user")),
+		"unsigned":         "Bearer " + signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, validClaims(userID, "user")),
+		"non-uuid subject": "Bearer " + signToken(t, jwt.SigningMethodHS256, testSecret, validClaims("alice", "user")),
+		"missing subject":  "Bearer " + signToken(t, jwt.SigningMethodHS256, testSecret, validClaims("", "user")),
+	}
+	for name, header := range cases {
+		if w := get(authRouter(), header); w.Code != http.StatusUnauthorized {
+			t.Errorf("%s: expected 401, got %d", name, w.Code)
+		}
+	}
+}
+
+func TestAuthenticate_UnknownRoleIsUser(t *testing.T) {
+	token := signToken(t, jwt.SigningMethodHS256, testSecret, validClaims(uuid.New().String(), "superuser"))
+	w := get(authRouter(), "Bearer "+token)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if got := w.Body.String(); !strings.Contains(got, `"role":"user"`) {
+		t.Fatalf("expected role user, got %s", got)
+	}
+}
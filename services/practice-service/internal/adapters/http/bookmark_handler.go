@@ -0,0 +1,246 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+// BookmarkHandler serves a user's bookmarks and bookmark collections
+type BookmarkHandler struct {
+	service ports.BookmarkService
+}
+
+func NewBookmarkHandler(service ports.BookmarkService) *BookmarkHandler {
+	return &BookmarkHandler{
+		service: service,
+	}
+}
+
+type CreateCollectionRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	IsShared    bool   `json:"is_shared"`
+}
+
+type UpdateCollectionRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	IsShared    *bool   `json:"is_shared"`
+}
+
+// writeBookmarkError maps bookmark and collection errors to status codes
+func writeBookmarkError(c *gin.Context, err error) {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, domain.ErrCollectionNotFound), strings.HasPrefix(msg, "question not found"),
+		strings.HasPrefix(msg, "bookmark not found"), strings.HasPrefix(msg, "question not in collection"):
+		c.JSON(http.StatusNotFound, gin.H{"error": msg})
+	case errors.Is(err, domain.ErrCollectionForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": msg})
+	case strings.HasPrefix(msg, "collection already exists"):
+		c.JSON(http.StatusConflict, gin.H{"error": msg})
+	case strings.HasPrefix(msg, "invalid"):
+		c.JSON(http.StatusBadRequest, gin.H{"error": msg})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
+
+// uuidParam parses a path parameter, writing a 400 response if it is not a UUID
+func uuidParam(c *gin.Context, name, label string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + label + " ID format"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *BookmarkHandler) ListBookmarks(c *gin.Context) {
+	limit, errLimit := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, errOffset := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if errLimit != nil || errOffset != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit or offset"})
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	bookmarks, err := h.service.ListBookmarks(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, bookmarks)
+}
+
+func (h *BookmarkHandler) AddBookmark(c *gin.Context) {
+	questionID, ok := uuidParam(c, "question_id", "question")
+	if !ok {
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	if err := h.service.AddBookmark(c.Request.Context(), userID, questionID); err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *BookmarkHandler) RemoveBookmark(c *gin.Context) {
+	questionID, ok := uuidParam(c, "question_id", "question")
+	if !ok {
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	if err := h.service.RemoveBookmark(c.Request.Context(), userID, questionID); err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *BookmarkHandler) CreateCollection(c *gin.Context) {
+	var req CreateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	collection, err := h.service.CreateCollection(c.Request.Context(), userID, req.Name, req.Description, req.IsShared)
+	if err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, collection)
+}
+
+func (h *BookmarkHandler) ListCollections(c *gin.Context) {
+	userID, _ := userIDFromContext(c)
+	collections, err := h.service.ListCollections(c.Request.Context(), userID)
+	if err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, collections)
+}
+
+func (h *BookmarkHandler) GetCollection(c *gin.Context) {
+	id, ok := uuidParam(c, "id", "collection")
+	if !ok {
+		return
+	}
+
+	limit, errLimit := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, errOffset := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if errLimit != nil || errOffset != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit or offset"})
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	collection, err := h.service.GetCollection(c.Request.Context(), id, userID, limit, offset)
+	if err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, collection)
+}
+
+func (h *BookmarkHandler) UpdateCollection(c *gin.Context) {
+	id, ok := uuidParam(c, "id", "collection")
+	if !ok {
+		return
+	}
+
+	var req UpdateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	patch := domain.CollectionPatch{Name: req.Name, Description: req.Description, IsShared: req.IsShared}
+	collection, err := h.service.UpdateCollection(c.Request.Context(), id, userID, patch)
+	if err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, collection)
+}
+
+func (h *BookmarkHandler) DeleteCollection(c *gin.Context) {
+	id, ok := uuidParam(c, "id", "collection")
+	if !ok {
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	if err := h.service.DeleteCollection(c.Request.Context(), id, userID); err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *BookmarkHandler) AddToCollection(c *gin.Context) {
+	id, ok := uuidParam(c, "id", "collection")
+	if !ok {
+		return
+	}
+	questionID, ok := uuidParam(c, "question_id", "question")
+	if !ok {
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	if err := h.service.AddToCollection(c.Request.Context(), id, userID, questionID); err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *BookmarkHandler) RemoveFromCollection(c *gin.Context) {
+	id, ok := uuidParam(c, "id", "collection")
+	if !ok {
+		return
+	}
+	questionID, ok := uuidParam(c, "question_id", "question")
+	if !ok {
+		return
+	}
+
+	userID, _ := userIDFromContext(c)
+	if err := h.service.RemoveFromCollection(c.Request.Context(), id, userID, questionID); err != nil {
+		writeBookmarkError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *BookmarkHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1/practice", RequireAuth())
+	{
+		api.GET("/bookmarks", h.ListBookmarks)
+		api.PUT("/bookmarks/:question_id", h.AddBookmark)
+		api.DELETE("/bookmarks/:question_id", h.RemoveBookmark)
+
+		api.POST("/collections", h.CreateCollection)
+		api.GET("/collections", h.ListCollections)
+		api.GET("/collections/:id", h.GetCollection)
+		api.PUT("/collections/:id", h.UpdateCollection)
+		api.DELETE("/collections/:id", h.DeleteCollection)
+		api.PUT("/collections/:id/questions/:question_id", h.AddToCollection)
+		api.DELETE("/collections/:id/questions/:question_id", h.RemoveFromCollection)
+	}
+}
@@ -1,11 +1,14 @@
 package http
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
 	"github.com/question-interviewer/practice-service/internal/ports"
 )
 
@@ -20,7 +23,6 @@ func NewPracticeHandler(service ports.PracticeService) *PracticeHandler {
 }
 
 type StartSessionRequest struct {
-	UserID   string                 `json:"user_id" binding:"required"`
 	TopicID  *string                `json:"topic_id"`
 	Level    *string                `json:"level"`
 	Language string                 `json:"language"`
@@ -34,9 +36,17 @@ type SubmitAnswerRequest struct {
 	AIEnabled  *bool  `json:"ai_enabled"`
 }
 
+type SubmitFollowUpAnswerRequest struct {
+	Content   string `json:"content" binding:"required"`
+	Language  string `json:"language"`
+	AIEnabled *bool  `json:"ai_enabled"`
+}
+
 type SuggestAnswerRequest struct {
 	Content  string `json:"content"`
 	Language string `json:"language"`
+	// Level is the session level; a canonical answer written for it is preferred as the sample
+	Level string `json:"level"`
 }
 
 func (h *PracticeHandler) StartSession(c *gin.Context) {
@@ -46,9 +56,9 @@ func (h *PracticeHandler) StartSession(c *gin.Context) {
 		return
 	}
 
-	userID, err := uuid.Parse(req.UserID)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+	userID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
 		return
 	}
 
@@ -68,13 +78,55 @@ func (h *PracticeHandler) StartSession(c *gin.Context) {
 
 	session, firstQuestionID, err := h.service.StartSession(c.Request.Context(), userID, topicID, req.Level, req.Language, req.Config)
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, domain.ErrNoWeakQuestions) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{
+	response := gin.H{
 		"session":           session,
 		"first_question_id": firstQuestionID,
+	}
+	if domain.IsWeakestMode(session.Config) {
+		// The pool still includes the first question, which has not been answered yet
+		if remaining, err := h.service.CountWeakQuestions(c.Request.Context(), session.ID); err == nil {
+			response["weak_questions_remaining"] = remaining
+		}
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetWeakQuestionPool reports how many weak questions a weakest-mode session has not drawn yet
+func (h *PracticeHandler) GetWeakQuestionPool(c *gin.Context) {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid session ID format"})
+		return
+	}
+
+	if !h.authorizeSession(c, sessionID) {
+		return
+	}
+
+	remaining, err := h.service.CountWeakQuestions(c.Request.Context(), sessionID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"remaining": remaining,
 	})
 }
 
@@ -86,6 +138,10 @@ func (h *PracticeHandler) SubmitAnswer(c *gin.Context) {
 		return
 	}
 
+	if !h.authorizeSession(c, sessionID) {
+		return
+	}
+
 	var req SubmitAnswerRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -115,6 +171,55 @@ func (h *PracticeHandler) SubmitAnswer(c *gin.Context) {
 	})
 }
 
+func (h *PracticeHandler) SubmitFollowUpAnswer(c *gin.Context) {
+	sessionIDStr := c.Param("id")
+	sessionID, err := uuid.Parse(sessionIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid session ID format"})
+		return
+	}
+
+	if !h.authorizeSession(c, sessionID) {
+		return
+	}
+
+	followUpIDStr := c.Param("follow_up_id")
+	followUpID, err := uuid.Parse(followUpIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid follow-up ID format"})
+		return
+	}
+
+	var req SubmitFollowUpAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	aiEnabled := true
+	if req.AIEnabled != nil {
+		aiEnabled = *req.AIEnabled
+	}
+
+	attempt, err := h.service.SubmitFollowUpAnswer(c.Request.Context(), sessionID, followUpID, req.Content, req.Language, aiEnabled)
+	if err != nil {
+		if strings.Contains(err.Error(), "follow-up not found") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, domain.ErrFollowUpAnswered) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"attempt": attempt,
+	})
+}
+
 func (h *PracticeHandler) GetSession(c *gin.Context) {
 	sessionIDStr := c.Param("id")
 	sessionID, err := uuid.Parse(sessionIDStr)
@@ -129,9 +234,33 @@ func (h *PracticeHandler) GetSession(c *gin.Context) {
 		return
 	}
 
+	if userID, _ := userIDFromContext(c); session.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Session belongs to another user"})
+		return
+	}
+
 	c.JSON(http.StatusOK, session)
 }
 
+// authorizeSession checks that the authenticated user owns the session, writing the error response if not.
+func (h *PracticeHandler) authorizeSession(c *gin.Context, sessionID uuid.UUID) bool {
+	session, err := h.service.GetSession(c.Request.Context(), sessionID)
+	if err != nil {
+		if strings.Contains(err.Error(), "session not found") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+
+	if userID, _ := userIDFromContext(c); session.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Session belongs to another user"})
+		return false
+	}
+	return true
+}
+
 func (h *PracticeHandler) GetQuestion(c *gin.Context) {
 	questionIDStr := c.Param("id")
 	questionID, err := uuid.Parse(questionIDStr)
@@ -140,6 +269,15 @@ func (h *PracticeHandler) GetQuestion(c *gin.Context) {
 		return
 	}
 
+	// ?language= serves the question's translation in that language when it has one
+	if language := c.Query("language"); language != "" {
+		questionID, err = h.service.GetTranslationID(c.Request.Context(), questionID, language)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	content, topic, level, correctAnswer, hint, err := h.service.GetQuestion(c.Request.Context(), questionID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -170,7 +308,7 @@ func (h *PracticeHandler) SuggestAnswer(c *gin.Context) {
 		return
 	}
 
-	score, feedback, suggestions, improvedAnswer, err := h.service.SuggestAnswer(c.Request.Context(), questionID, req.Content, req.Language)
+	score, feedback, suggestions, improvedAnswer, err := h.service.SuggestAnswer(c.Request.Context(), questionID, req.Content, req.Language, req.Level)
 	if err != nil {
 		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
 		return
@@ -192,6 +330,10 @@ func (h *PracticeHandler) SkipRound(c *gin.Context) {
 		return
 	}
 
+	if !h.authorizeSession(c, sessionID) {
+		return
+	}
+
 	nextQuestionID, err := h.service.SkipCurrentRound(c.Request.Context(), sessionID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -211,6 +353,10 @@ func (h *PracticeHandler) GetRandomQuestionForSession(c *gin.Context) {
 		return
 	}
 
+	if !h.authorizeSession(c, sessionID) {
+		return
+	}
+
 	topic := c.Query("topic")
 	var topicPtr *string
 	if topic != "" {
@@ -232,17 +378,62 @@ func (h *PracticeHandler) GetRandomQuestionForSession(c *gin.Context) {
 	})
 }
 
+// CompareAttempt shows an answered question's canonical and top-voted community answers, each with its
+// similarity to the user's answer
+func (h *PracticeHandler) CompareAttempt(c *gin.Context) {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid session ID format"})
+		return
+	}
+	attemptID, err := uuid.Parse(c.Param("attempt_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attempt ID format"})
+		return
+	}
+
+	if !h.authorizeSession(c, sessionID) {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "3"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return
+	}
+
+	comparison, err := h.service.CompareAttempt(c.Request.Context(), sessionID, attemptID, limit)
+	if err != nil {
+		switch {
+		case strings.HasPrefix(err.Error(), "attempt not found"):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case strings.HasPrefix(err.Error(), "invalid"):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, comparison)
+}
+
 func (h *PracticeHandler) RegisterRoutes(r *gin.Engine) {
 	api := r.Group("/api/v1/practice")
 	{
-		api.POST("/sessions", h.StartSession)
-		api.GET("/sessions/:id", h.GetSession)
-		api.POST("/sessions/:id/answers", h.SubmitAnswer)
-		api.POST("/sessions/:id/skip", h.SkipRound)
-		api.GET("/sessions/:id/questions/random", h.GetRandomQuestionForSession)
+		sessions := api.Group("/sessions", RequireAuth())
+		sessions.POST("", h.StartSession)
+		sessions.GET("/:id", h.GetSession)
+		sessions.POST("/:id/answers", h.SubmitAnswer)
+		sessions.POST("/:id/follow-ups/:follow_up_id/answers", h.SubmitFollowUpAnswer)
+		sessions.POST("/:id/skip", h.SkipRound)
+		sessions.GET("/:id/questions/random", h.GetRandomQuestionForSession)
+		sessions.GET("/:id/weak-questions", h.GetWeakQuestionPool)
+		sessions.GET("/:id/attempts/:attempt_id/comparison", h.CompareAttempt)
+
 		api.GET("/questions/:id", h.GetQuestion)
 		api.POST("/questions/:id/suggest", h.SuggestAnswer)
-		api.POST("/questions", h.CreateQuestion)
+		api.POST("/questions", RequireAuth(), h.CreateQuestion)
 	}
 }
 
@@ -251,10 +442,17 @@ type CreateQuestionRequest struct {
 	Content       string `json:"content" binding:"required"`
 	Topic         string `json:"topic" binding:"required"`
 	Level         string `json:"level" binding:"required"`
+	Language      string `json:"language"` // en (default) or vi
 	CorrectAnswer string `json:"correct_answer"`
 	Hint          string `json:"hint"`
 }
 
+// CreateQuestionResponse is the created question plus any existing questions it resembles
+type CreateQuestionResponse struct {
+	*domain.Question
+	PossibleDuplicates []domain.SimilarQuestion `json:"possible_duplicates,omitempty"`
+}
+
 func (h *PracticeHandler) CreateQuestion(c *gin.Context) {
 	var req CreateQuestionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -262,11 +460,20 @@ func (h *PracticeHandler) CreateQuestion(c *gin.Context) {
 		return
 	}
 
-	question, err := h.service.CreateQuestion(c.Request.Context(), req.Content, req.Topic, req.Level, req.CorrectAnswer, req.Hint)
+	question, duplicates, err := h.service.CreateQuestion(c.Request.Context(), req.Content, req.Topic, req.Level, req.Language, req.CorrectAnswer, req.Hint)
 	if err != nil {
+		var dupErr *domain.DuplicateError
+		if errors.As(err, &dupErr) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "duplicates": dupErr.Matches})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "topic not found") || strings.HasPrefix(err.Error(), "invalid question") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusCreated, question)
+	c.JSON(http.StatusCreated, CreateQuestionResponse{Question: question, PossibleDuplicates: duplicates})
 }
@@ -0,0 +1,257 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+type BookmarkRepository struct {
+	db *sql.DB
+}
+
+func NewBookmarkRepository(db *sql.DB) ports.BookmarkRepository {
+	return &BookmarkRepository{db: db}
+}
+
+// execer is satisfied by *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func addBookmark(ctx context.Context, db execer, userID, questionID uuid.UUID) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO bookmarks (user_id, question_id) VALUES ($1, $2)
+		ON CONFLICT (user_id, question_id) DO NOTHING
+	`, userID, questionID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return fmt.Errorf("question not found")
+		}
+		return fmt.Errorf("failed to add bookmark: %w", err)
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) AddBookmark(ctx context.Context, userID, questionID uuid.UUID) error {
+	return addBookmark(ctx, r.db, userID, questionID)
+}
+
+// RemoveBookmark deletes the bookmark; the question leaves the user's collections through the cascade
+func (r *BookmarkRepository) RemoveBookmark(ctx context.Context, userID, questionID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM bookmarks WHERE user_id = $1 AND question_id = $2`, userID, questionID)
+	if err != nil {
+		return fmt.Errorf("failed to remove bookmark: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("bookmark not found")
+	}
+	return nil
+}
+
+// scanBookmarkedQuestions reads question IDs and bookmark times; the service fills in the questions from the catalog
+func scanBookmarkedQuestions(rows *sql.Rows) ([]domain.BookmarkedQuestion, error) {
+	defer rows.Close()
+	questions := []domain.BookmarkedQuestion{}
+	for rows.Next() {
+		var q domain.BookmarkedQuestion
+		if err := rows.Scan(&q.QuestionID, &q.BookmarkedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan bookmarked question: %w", err)
+		}
+		questions = append(questions, q)
+	}
+	return questions, rows.Err()
+}
+
+func (r *BookmarkRepository) ListBookmarks(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT b.question_id, b.created_at
+		FROM bookmarks b
+		WHERE b.user_id = $1
+		ORDER BY b.created_at DESC, b.question_id
+		LIMIT $2 OFFSET $3
+	`, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bookmarks: %w", err)
+	}
+	return scanBookmarkedQuestions(rows)
+}
+
+func (r *BookmarkRepository) CreateCollection(ctx context.Context, c *domain.Collection) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO bookmark_collections (id, user_id, name, description, is_shared, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7)
+	`, c.ID, c.UserID, c.Name, c.Description, c.IsShared, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return fmt.Errorf("collection already exists: %s", c.Name)
+		}
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+	return nil
+}
+
+const collectionColumns = `c.id, c.user_id, c.name, COALESCE(c.description, ''), c.is_shared,
+	(SELECT COUNT(*) FROM bookmark_collection_items i WHERE i.collection_id = c.id),
+	c.created_at, c.updated_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCollection(row rowScanner) (*domain.Collection, error) {
+	var c domain.Collection
+	if err := row.Scan(&c.ID, &c.UserID, &c.Name, &c.Description, &c.IsShared, &c.QuestionCount, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *BookmarkRepository) GetCollection(ctx context.Context, id uuid.UUID) (*domain.Collection, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+collectionColumns+` FROM bookmark_collections c WHERE c.id = $1`, id)
+	c, err := scanCollection(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrCollectionNotFound
+		}
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+	return c, nil
+}
+
+func (r *BookmarkRepository) ListCollections(ctx context.Context, userID uuid.UUID) ([]*domain.Collection, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+collectionColumns+`
+		FROM bookmark_collections c
+		WHERE c.user_id = $1
+		ORDER BY c.name
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	defer rows.Close()
+
+	collections := []*domain.Collection{}
+	for rows.Next() {
+		c, err := scanCollection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan collection: %w", err)
+		}
+		collections = append(collections, c)
+	}
+	return collections, rows.Err()
+}
+
+func (r *BookmarkRepository) UpdateCollection(ctx context.Context, c *domain.Collection) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE bookmark_collections
+		SET name = $1, description = NULLIF($2, ''), is_shared = $3, updated_at = $4
+		WHERE id = $5
+	`, c.Name, c.Description, c.IsShared, c.UpdatedAt, c.ID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return fmt.Errorf("collection already exists: %s", c.Name)
+		}
+		return fmt.Errorf("failed to update collection: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrCollectionNotFound
+	}
+	return nil
+}
+
+// DeleteCollection deletes the collection but keeps the bookmarks in it
+func (r *BookmarkRepository) DeleteCollection(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM bookmark_collections WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrCollectionNotFound
+	}
+	return nil
+}
+
+// AddToCollection bookmarks the question for the collection's owner and adds it to the collection
+func (r *BookmarkRepository) AddToCollection(ctx context.Context, collectionID, userID, questionID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := addBookmark(ctx, tx, userID, questionID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO bookmark_collection_items (collection_id, user_id, question_id) VALUES ($1, $2, $3)
+		ON CONFLICT (collection_id, question_id) DO NOTHING
+	`, collectionID, userID, questionID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return domain.ErrCollectionNotFound
+		}
+		return fmt.Errorf("failed to add to collection: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE bookmark_collections SET updated_at = NOW() WHERE id = $1`, collectionID); err != nil {
+		return fmt.Errorf("failed to update collection: %w", err)
+	}
+	return tx.Commit()
+}
+
+// RemoveFromCollection takes the question out of the collection but keeps the bookmark
+func (r *BookmarkRepository) RemoveFromCollection(ctx context.Context, collectionID, questionID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM bookmark_collection_items WHERE collection_id = $1 AND question_id = $2`, collectionID, questionID)
+	if err != nil {
+		return fmt.Errorf("failed to remove from collection: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("question not in collection")
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) ListCollectionQuestions(ctx context.Context, collectionID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT i.question_id, b.created_at
+		FROM bookmark_collection_items i
+		JOIN bookmarks b ON b.user_id = i.user_id AND b.question_id = i.question_id
+		WHERE i.collection_id = $1
+		ORDER BY i.created_at, i.question_id
+		LIMIT $2 OFFSET $3
+	`, collectionID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collection questions: %w", err)
+	}
+	return scanBookmarkedQuestions(rows)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/question-interviewer/practice-service/internal/domain"
 	"github.com/question-interviewer/practice-service/internal/ports"
@@ -115,96 +117,74 @@ func (r *PracticeRepository) UpdateSession(ctx context.Context, session *domain.
 	return nil
 }
 
-func (r *PracticeRepository) GetRandomQuestionID(ctx context.Context, topicID *uuid.UUID, level *string, language string, config map[string]interface{}) (uuid.UUID, error) {
-	// Base query
-	query := `SELECT q.id FROM questions q`
-	whereClauses := []string{"q.status = 'published'"}
-	args := []interface{}{}
-	argIdx := 1
-
-	// 1. Topic ID (Direct filter)
-	if topicID != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("q.topic_id = $%d", argIdx))
-		args = append(args, *topicID)
-		argIdx++
-	}
-
-	// 2. Stacks/Roles from Config (Topic Name filter)
-	// If topicID is nil, check if we have stacks in config
-	if topicID == nil && config != nil {
-		// Check for "tech_stacks" (frontend key) or "stacks" (legacy/fallback)
-		var stacksInterface interface{}
-		if s, ok := config["tech_stacks"]; ok {
-			stacksInterface = s
-		} else if s, ok := config["stacks"]; ok {
-			stacksInterface = s
-		}
-
-		if stacks, ok := stacksInterface.([]interface{}); ok && len(stacks) > 0 {
-			stackNames := make([]string, len(stacks))
-			for i, s := range stacks {
-				raw := fmt.Sprint(s)
-				// Normalize stack names to match DB topics
-				switch raw {
-				case "Go":
-					stackNames[i] = "Golang"
-				case "Node.js":
-					stackNames[i] = "NodeJS"
-				case "PostgreSQL", "MongoDB", "Redis":
-					stackNames[i] = "Data Layer"
-				case "TypeScript":
-					stackNames[i] = "JavaScript"
-				default:
-					stackNames[i] = raw
-				}
-			}
-
-			// Join with topics table if we are filtering by topic name
-			query += ` JOIN topics t ON q.topic_id = t.id`
-
-			whereClauses = append(whereClauses, fmt.Sprintf("t.name = ANY($%d)", argIdx))
-			// ANY needs a pq array compatible format or just passed as slice if driver supports it.
-			// pgx stdlib usually handles []string as text array.
-			args = append(args, stackNames)
-			argIdx++
-		}
+// poolQuery selects from a session's pool as questions q; $1 to $3 are its IDs, translation groups and levels
+const poolQuery = `SELECT q.id FROM unnest($1::uuid[], $2::uuid[], $3::text[]) AS q(id, translation_group_id, level)`
+
+// unseenGroupClause skips questions whose translation group session $N already attempted
+const unseenGroupClause = ` AND q.translation_group_id NOT IN (
+		SELECT pa.translation_group_id FROM practice_attempts pa
+		WHERE pa.session_id = $%d AND pa.translation_group_id IS NOT NULL)`
+
+// latestGroupAttemptsQuery selects the latest scored attempt by session $N's user on each translation
+// group, across all their sessions and in any language
+const latestGroupAttemptsQuery = `SELECT DISTINCT ON (pa.translation_group_id) pa.translation_group_id, pa.score, pa.created_at
+		FROM practice_attempts pa
+		JOIN practice_sessions ps ON ps.id = pa.session_id
+		WHERE ps.user_id = (SELECT user_id FROM practice_sessions WHERE id = $%[1]d)
+			AND pa.follow_up_id IS NULL AND pa.score IS NOT NULL AND pa.translation_group_id IS NOT NULL
+		ORDER BY pa.translation_group_id, pa.created_at DESC`
+
+// weakGroupsQuery selects the translation groups whose latest scored attempt by session $N's user is below
+// the threshold $M, weighted by how poor the score was and halving every two weeks since the attempt
+const weakGroupsQuery = `SELECT translation_group_id,
+		($%[2]d - score) * POWER(0.5, LEAST(EXTRACT(EPOCH FROM NOW() - created_at) / 86400, 365) / 14) AS weight
+	FROM (` + latestGroupAttemptsQuery + `) latest
+	WHERE score < $%[2]d`
+
+// restingGroupClause skips the translation groups session $N's user answered at or above the threshold $M
+// too recently to review: a group rests one day at the threshold, doubling for every 10 points above it
+// (16 days for a perfect score at the default threshold), whichever language it was answered in
+const restingGroupClause = ` AND q.translation_group_id NOT IN (
+		SELECT translation_group_id FROM (` + latestGroupAttemptsQuery + `) latest
+		WHERE score >= $%[2]d AND created_at > NOW() - INTERVAL '1 day' * POWER(2, (score - $%[2]d) / 10.0))`
+
+// weakGroupsJoin restricts questions q to the weak groups w of weakGroupsQuery
+const weakGroupsJoin = ` JOIN (` + weakGroupsQuery + `) w ON w.translation_group_id = q.translation_group_id`
+
+// poolArgs are poolQuery's arguments for a pool
+func poolArgs(pool []domain.PoolQuestion) []interface{} {
+	ids := make([]string, len(pool))
+	groups := make([]string, len(pool))
+	levels := make([]string, len(pool))
+	for i, q := range pool {
+		ids[i], groups[i], levels[i] = q.ID.String(), q.TranslationGroupID.String(), q.Level
 	}
+	return []interface{}{ids, groups, levels}
+}
 
-	// 3. Role from Config
-	if config != nil {
-		if role, ok := config["role"].(string); ok && role != "" {
-			roundID, _ := config["round_id"].(string)
-
-			if roundID == "devops_round" {
-				whereClauses = append(whereClauses, fmt.Sprintf("(q.role = $%d OR q.role = 'Any' OR q.role = 'DevOps')", argIdx))
-				args = append(args, role)
-				argIdx++
-			} else {
-				whereClauses = append(whereClauses, fmt.Sprintf("(q.role = $%d OR q.role = 'Any')", argIdx))
-				args = append(args, role)
-				argIdx++
-			}
-		}
+// GetRandomQuestionID picks a question from the session's pool. Questions whose translation group the
+// session has already attempted (in any language) are skipped until the pool runs out, and so, while
+// others remain, are groups the user answered well in any session or language and is not due to review.
+// In weakest mode only the user's weak questions are drawn, by weighted random choice, and none is repeated.
+func (r *PracticeRepository) GetRandomQuestionID(ctx context.Context, sessionID uuid.UUID, pool []domain.PoolQuestion, level *string, config map[string]interface{}) (uuid.UUID, error) {
+	if len(pool) == 0 {
+		return uuid.Nil, fmt.Errorf("failed to get random question: %w", sql.ErrNoRows)
 	}
-
-	// 4. Language
-	targetLang := "en"
-	if language != "" {
-		targetLang = language
+	query := poolQuery
+	order := "RANDOM()"
+	args := poolArgs(pool)
+	weakest := domain.IsWeakestMode(config)
+	if weakest {
+		query += fmt.Sprintf(weakGroupsJoin, len(args)+1, len(args)+2)
+		args = append(args, sessionID, domain.WeakThreshold(config))
+		// Weighted sampling: the smallest -ln(u)/weight wins with probability proportional to weight
+		order = "-LN(1 - RANDOM()) / w.weight"
 	}
-	whereClauses = append(whereClauses, fmt.Sprintf("q.language = $%d", argIdx))
-	args = append(args, targetLang)
-	argIdx++
 
-	// Build WHERE string
-	whereStr := " WHERE " + whereClauses[0]
-	for i := 1; i < len(whereClauses); i++ {
-		whereStr += " AND " + whereClauses[i]
-	}
-
-	// 4. Level Logic (With progression, then fallback)
+	// Level Logic (With progression, then fallback)
+	var targetLevels []string
 	if level != nil && *level != "" {
-		targetLevels := []string{*level}
+		targetLevels = []string{*level}
 		switch *level {
 		case "Fresher":
 			targetLevels = append(targetLevels, "Junior")
@@ -213,63 +193,252 @@ func (r *PracticeRepository) GetRandomQuestionID(ctx context.Context, topicID *u
 		case "Mid":
 			targetLevels = append(targetLevels, "Senior")
 		}
+	}
 
-		levelClause := fmt.Sprintf(" AND (q.level = ANY($%d) OR q.level = 'Any')", argIdx)
-		levelQuery := query + whereStr + levelClause + " ORDER BY RANDOM() LIMIT 1"
-		levelArgs := append(args, targetLevels)
+	// Unseen translation groups due for review first, then resting ones; a fresh question at any level
+	// beats a repeat at the right level
+	passes := []struct{ unseen, due, leveled bool }{
+		{true, true, true}, {true, true, false}, {true, false, true}, {true, false, false}, {false, false, true}, {false, false, false},
+	}
+	for _, p := range passes {
+		if p.leveled && targetLevels == nil {
+			continue
+		}
+		if weakest && p.due {
+			// Weak groups scored below the threshold, so none of them is resting
+			continue
+		}
+		if weakest && !p.unseen {
+			break
+		}
+		passWhere, passArgs := " WHERE TRUE", append([]interface{}{}, args...)
+		if p.unseen {
+			passWhere += fmt.Sprintf(unseenGroupClause, len(passArgs)+1)
+			passArgs = append(passArgs, sessionID)
+		}
+		if p.due {
+			passWhere += fmt.Sprintf(restingGroupClause, len(passArgs)+1, len(passArgs)+2)
+			passArgs = append(passArgs, sessionID, domain.WeakThreshold(config))
+		}
+		if p.leveled {
+			passWhere += fmt.Sprintf(" AND (q.level = ANY($%d) OR q.level = 'Any')", len(passArgs)+1)
+			passArgs = append(passArgs, targetLevels)
+		}
 
-		var checkID uuid.UUID
-		err := r.db.QueryRowContext(ctx, levelQuery, levelArgs...).Scan(&checkID)
+		var id uuid.UUID
+		err := r.db.QueryRowContext(ctx, query+passWhere+" ORDER BY "+order+" LIMIT 1", passArgs...).Scan(&id)
 		if err == nil {
-			return checkID, nil
+			return id, nil
+		}
+		if err != sql.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("failed to get random question: %w", err)
 		}
-		// If not found, fall through to fallback
 	}
+	return uuid.Nil, fmt.Errorf("failed to get random question: %w", sql.ErrNoRows)
+}
 
-	// Fallback: Ignore level
-	finalQuery := query + whereStr + " ORDER BY RANDOM() LIMIT 1"
-	finalArgs := args
+// CountWeakQuestions counts the weak translation groups in a weakest-mode session's pool that it has not
+// attempted yet
+func (r *PracticeRepository) CountWeakQuestions(ctx context.Context, sessionID uuid.UUID, pool []domain.PoolQuestion, config map[string]interface{}) (int, error) {
+	if len(pool) == 0 {
+		return 0, nil
+	}
+	args := poolArgs(pool)
+	query := `SELECT COUNT(DISTINCT q.translation_group_id) FROM unnest($1::uuid[], $2::uuid[], $3::text[]) AS q(id, translation_group_id, level)` +
+		fmt.Sprintf(weakGroupsJoin, len(args)+1, len(args)+2)
+	args = append(args, sessionID, domain.WeakThreshold(config))
+	query += " WHERE TRUE" + fmt.Sprintf(unseenGroupClause, len(args)+1)
+	args = append(args, sessionID)
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count weak questions: %w", err)
+	}
+	return count, nil
+}
+
+// ListSessionBookmarks lists the questions the session's user bookmarked, or those in a collection the
+// user owns or that is shared
+func (r *PracticeRepository) ListSessionBookmarks(ctx context.Context, sessionID uuid.UUID, collectionID string) ([]uuid.UUID, error) {
+	sessionUser := `(SELECT user_id FROM practice_sessions WHERE id = $1)`
+	query := `SELECT question_id FROM bookmarks WHERE user_id = ` + sessionUser
+	args := []interface{}{sessionID}
+	if collectionID != "" {
+		query = `SELECT i.question_id FROM bookmark_collection_items i
+			JOIN bookmark_collections c ON c.id = i.collection_id
+			WHERE c.id = $2 AND (c.is_shared OR c.user_id = ` + sessionUser + `)`
+		args = append(args, collectionID)
+	}
 
-	var id uuid.UUID
-	err := r.db.QueryRowContext(ctx, finalQuery, finalArgs...).Scan(&id)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("failed to get random question: %w", err)
+		return nil, fmt.Errorf("failed to list session bookmarks: %w", err)
 	}
-	return id, nil
+	defer rows.Close()
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan bookmark: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
 }
 
+// CreateAttempt stores an attempt together with its attempt.graded event when the AI graded it
 func (r *PracticeRepository) CreateAttempt(ctx context.Context, attempt *domain.PracticeAttempt) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO practice_attempts (id, session_id, question_id, user_answer, score, feedback, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO practice_attempts (id, session_id, question_id, translation_group_id, parent_attempt_id, follow_up_id, user_answer, score, feedback, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
-	_, err := r.db.ExecContext(ctx, query,
+	var group *uuid.UUID
+	if attempt.TranslationGroupID != uuid.Nil {
+		group = &attempt.TranslationGroupID
+	}
+
+	_, err = tx.ExecContext(ctx, query,
 		attempt.ID,
 		attempt.SessionID,
 		attempt.QuestionID,
+		group,
+		attempt.ParentAttemptID,
+		attempt.FollowUpID,
 		attempt.UserAnswer,
-		attempt.Score,
+		storedScore(attempt),
 		attempt.Feedback,
 		attempt.CreatedAt,
 	)
 	if err != nil {
+		// The partial unique index on follow_up_id catches concurrent answers to one follow-up
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && attempt.FollowUpID != nil {
+			return domain.ErrFollowUpAnswered
+		}
 		return fmt.Errorf("failed to create attempt: %w", err)
 	}
+
+	// Ungraded attempts carry no score for consumers to act on
+	if !attempt.Graded {
+		return tx.Commit()
+	}
+	var userID uuid.UUID
+	if err := tx.QueryRowContext(ctx, `SELECT user_id FROM practice_sessions WHERE id = $1`, attempt.SessionID).Scan(&userID); err != nil {
+		return fmt.Errorf("failed to get session user: %w", err)
+	}
+	if err := insertEvent(ctx, tx, domain.NewAttemptGradedEvent(attempt, userID)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// storedScore is the score column of an attempt: NULL when it was not graded, so ungraded
+// attempts never make a group weak or resting
+func storedScore(attempt *domain.PracticeAttempt) *int {
+	if !attempt.Graded {
+		return nil
+	}
+	return &attempt.Score
+}
+
+func (r *PracticeRepository) GetAttempt(ctx context.Context, id uuid.UUID) (*domain.PracticeAttempt, error) {
+	query := `
+		SELECT id, session_id, question_id, parent_attempt_id, follow_up_id, COALESCE(user_answer, ''),
+			COALESCE(score, 0), score IS NOT NULL, COALESCE(feedback, ''), created_at
+		FROM practice_attempts
+		WHERE id = $1
+	`
+	var a domain.PracticeAttempt
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&a.ID,
+		&a.SessionID,
+		&a.QuestionID,
+		&a.ParentAttemptID,
+		&a.FollowUpID,
+		&a.UserAnswer,
+		&a.Score,
+		&a.Graded,
+		&a.Feedback,
+		&a.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("attempt not found")
+		}
+		return nil, fmt.Errorf("failed to get attempt: %w", err)
+	}
+	return &a, nil
+}
+
+func (r *PracticeRepository) CreateFollowUps(ctx context.Context, followUps []*domain.FollowUpQuestion) error {
+	query := `
+		INSERT INTO practice_follow_ups (id, session_id, parent_attempt_id, question_id, content, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	for _, f := range followUps {
+		_, err := r.db.ExecContext(ctx, query,
+			f.ID,
+			f.SessionID,
+			f.ParentAttemptID,
+			f.QuestionID,
+			f.Content,
+			f.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create follow-up: %w", err)
+		}
+	}
 	return nil
 }
 
-func (r *PracticeRepository) GetQuestionSampleCache(ctx context.Context, questionID uuid.UUID) (string, string, []string, string, error) {
+func (r *PracticeRepository) GetFollowUp(ctx context.Context, id uuid.UUID) (*domain.FollowUpQuestion, error) {
 	query := `
-		SELECT COALESCE(sample_answer, ''), COALESCE(sample_feedback, ''), sample_suggestions, COALESCE(sample_source, '')
-		FROM questions
+		SELECT id, session_id, parent_attempt_id, question_id, content,
+			EXISTS (SELECT 1 FROM practice_attempts pa WHERE pa.follow_up_id = f.id), created_at
+		FROM practice_follow_ups f
 		WHERE id = $1
 	`
+	var f domain.FollowUpQuestion
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&f.ID,
+		&f.SessionID,
+		&f.ParentAttemptID,
+		&f.QuestionID,
+		&f.Content,
+		&f.Answered,
+		&f.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("follow-up not found")
+		}
+		return nil, fmt.Errorf("failed to get follow-up: %w", err)
+	}
+	return &f, nil
+}
+
+func (r *PracticeRepository) GetQuestionSampleCache(ctx context.Context, questionID uuid.UUID) (string, string, []string, string, error) {
+	query := `
+		SELECT COALESCE(sample_answer, ''), COALESCE(sample_feedback, ''), sample_suggestions, sample_source
+		FROM practice_question_samples
+		WHERE question_id = $1
+	`
 
 	var sampleAnswer, sampleFeedback, sampleSource string
 	var suggestionsRaw []byte
 
 	row := r.db.QueryRowContext(ctx, query, questionID)
 	if err := row.Scan(&sampleAnswer, &sampleFeedback, &suggestionsRaw, &sampleSource); err != nil {
+		// A question without a cached sample has an empty cache
+		if err == sql.ErrNoRows {
+			return "", "", nil, "", nil
+		}
 		return "", "", nil, "", fmt.Errorf("failed to get question sample cache: %w", err)
 	}
 
@@ -292,92 +461,17 @@ func (r *PracticeRepository) UpsertQuestionSampleCache(ctx context.Context, ques
 	}
 
 	_, err = r.db.ExecContext(ctx, `
-		UPDATE questions
-		SET sample_answer = $2,
-			sample_feedback = $3,
-			sample_suggestions = $4,
-			sample_source = $5,
+		INSERT INTO practice_question_samples (question_id, sample_answer, sample_feedback, sample_suggestions, sample_source)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (question_id) DO UPDATE SET
+			sample_answer = EXCLUDED.sample_answer,
+			sample_feedback = EXCLUDED.sample_feedback,
+			sample_suggestions = EXCLUDED.sample_suggestions,
+			sample_source = EXCLUDED.sample_source,
 			updated_at = CURRENT_TIMESTAMP
-		WHERE id = $1
 	`, questionID, sampleAnswer, sampleFeedback, suggestionsJSON, sampleSource)
 	if err != nil {
 		return fmt.Errorf("failed to upsert question sample cache: %w", err)
 	}
 	return nil
 }
-
-func (r *PracticeRepository) GetQuestionContent(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) {
-	// Join with topics table to get topic name if needed, but for now assuming we just need question fields
-	// But wait, topic name is in topics table.
-	// Let's assume questions table has content and level.
-	// And we need topic name.
-
-	query := `
-		SELECT q.content, t.name, q.level, COALESCE(q.correct_answer, ''), COALESCE(q.hint, '')
-		FROM questions q
-		LEFT JOIN topics t ON q.topic_id = t.id
-		WHERE q.id = $1
-	`
-	var content, topic, level, correctAnswer, hint string
-	// Handle potential NULLs if topic is missing
-	var topicName sql.NullString
-
-	row := r.db.QueryRowContext(ctx, query, questionID)
-	if err := row.Scan(&content, &topicName, &level, &correctAnswer, &hint); err != nil {
-		return "", "", "", "", "", fmt.Errorf("failed to get question content: %w", err)
-	}
-
-	if topicName.Valid {
-		topic = topicName.String
-	} else {
-		topic = "General"
-	}
-
-	return content, topic, level, correctAnswer, hint, nil
-}
-
-func (r *PracticeRepository) CreateQuestion(ctx context.Context, q *domain.Question) error {
-	// First ensure topic exists or get it (simplified: just use a default topic if not found or create one)
-	// For MVP, let's assume we look up topic by name or insert it.
-
-	// Check if topic exists
-	var topicID uuid.UUID
-	err := r.db.QueryRowContext(ctx, "SELECT id FROM topics WHERE name = $1", q.TopicName).Scan(&topicID)
-	if err != nil {
-		// Create topic if not exists
-		topicID = uuid.New()
-		_, err = r.db.ExecContext(ctx, "INSERT INTO topics (id, name, description) VALUES ($1, $2, $3)",
-			topicID, q.TopicName, "Auto-generated topic")
-		if err != nil {
-			return fmt.Errorf("failed to create topic: %w", err)
-		}
-	}
-
-	// Use content excerpt as title if not provided (though we don't have title in domain yet)
-	// Since we made title nullable in migration 000006, we can skip it or provide a default.
-	// We will insert title as first 50 chars of content for backward compatibility or just leave it null if allowed.
-	// But let's check schema: migration 000006 makes title nullable.
-
-	_, err = r.db.ExecContext(ctx,
-		`INSERT INTO questions (id, topic_id, content, level, correct_answer, sample_answer, sample_source, hint, title) 
-		 VALUES ($1, $2, $3, $4, $5, $6, 'user', $7, $8)`,
-		q.ID, topicID, q.Content, q.Level, q.CorrectAnswer, q.CorrectAnswer, q.Hint, "Generated Question")
-
-	if err != nil {
-		return fmt.Errorf("failed to insert question: %w", err)
-	}
-
-	return nil
-}
-
-func (r *PracticeRepository) GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error) {
-	var id uuid.UUID
-	err := r.db.QueryRowContext(ctx, "SELECT id FROM topics WHERE name = $1", name).Scan(&id)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return uuid.Nil, fmt.Errorf("topic not found: %s", name)
-		}
-		return uuid.Nil, fmt.Errorf("failed to get topic id: %w", err)
-	}
-	return id, nil
-}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/question-interviewer/practice-service/internal/domain"
+)
+
+func TestGroupClauses_NumberTheirArguments(t *testing.T) {
+	for name, clause := range map[string]string{
+		"unseen":  fmt.Sprintf(unseenGroupClause, 3),
+		"weak":    fmt.Sprintf(weakGroupsJoin, 3, 4),
+		"resting": fmt.Sprintf(restingGroupClause, 3, 4),
+	} {
+		if strings.Contains(clause, "%!") || !strings.Contains(clause, "$3") {
+			t.Errorf("%s: badly formatted clause %s", name, clause)
+		}
+	}
+	// Resting groups are the user's, across sessions and languages, so the clause keys on the group
+	resting := fmt.Sprintf(restingGroupClause, 3, 4)
+	if !strings.Contains(resting, "ps.user_id = (SELECT user_id FROM practice_sessions WHERE id = $3)") ||
+		!strings.Contains(resting, "DISTINCT ON (pa.translation_group_id)") || !strings.Contains(resting, "score >= $4") {
+		t.Fatalf("unexpected resting clause %s", resting)
+	}
+	// Attempts carry their group, so history never reads the question catalog's tables
+	for _, clause := range []string{resting, fmt.Sprintf(unseenGroupClause, 3), fmt.Sprintf(weakGroupsJoin, 3, 4)} {
+		if strings.Contains(clause, "JOIN questions") {
+			t.Fatalf("expected no join to questions, got %s", clause)
+		}
+	}
+}
+
+func TestUngradedAttempts_DoNotMakeGroupsWeak(t *testing.T) {
+	ungraded := &domain.PracticeAttempt{Score: 0}
+	if storedScore(ungraded) != nil {
+		t.Fatal("expected an ungraded attempt to store no score")
+	}
+	graded := &domain.PracticeAttempt{Score: 0, Graded: true}
+	if score := storedScore(graded); score == nil || *score != 0 {
+		t.Fatalf("expected a graded zero to be stored, got %v", score)
+	}
+	// Weak and resting groups come from the latest attempt that has a score
+	for _, clause := range []string{fmt.Sprintf(weakGroupsJoin, 3, 4), fmt.Sprintf(restingGroupClause, 3, 4)} {
+		if !strings.Contains(clause, "pa.score IS NOT NULL") {
+			t.Fatalf("expected ungraded attempts to be skipped, got %s", clause)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+// maxDuplicateMatches caps how many similar questions CreateQuestion reports
+const maxDuplicateMatches = 5
+
+// topicLookupQuery resolves a topic by exact name, slug or alias, preferring the exact name
+const topicLookupQuery = `SELECT id FROM topics
+	WHERE name = $1 OR slug = lower($1) OR lower($1) = ANY(aliases)
+	ORDER BY (name = $1) DESC LIMIT 1`
+
+// QuestionCatalog reads and writes questions straight from the shared schema. It serves when
+// question-service is not configured, and as the read fallback of the question-service client.
+type QuestionCatalog struct {
+	db         *sql.DB
+	duplicates domain.DuplicatePolicy
+}
+
+func NewQuestionCatalog(db *sql.DB, duplicates domain.DuplicatePolicy) *QuestionCatalog {
+	return &QuestionCatalog{db: db, duplicates: duplicates}
+}
+
+var _ ports.QuestionCatalog = (*QuestionCatalog)(nil)
+
+func (r *QuestionCatalog) GetQuestionContent(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) {
+	q, err := r.GetQuestionInfo(ctx, questionID)
+	if err != nil {
+		return "", "", "", "", "", err
+	}
+	return q.Content, q.Topic, q.Level, q.CorrectAnswer, q.Hint, nil
+}
+
+// GetQuestionInfo returns a question with its topic name, or domain.ErrQuestionNotFound
+func (r *QuestionCatalog) GetQuestionInfo(ctx context.Context, questionID uuid.UUID) (*domain.QuestionInfo, error) {
+	query := `
+		SELECT q.id, q.translation_group_id, q.content, COALESCE(t.name, 'General'), q.level, q.language,
+			COALESCE(q.correct_answer, ''), COALESCE(q.hint, '')
+		FROM questions q
+		LEFT JOIN topics t ON q.topic_id = t.id
+		WHERE q.id = $1
+	`
+	var q domain.QuestionInfo
+	err := r.db.QueryRowContext(ctx, query, questionID).Scan(
+		&q.ID, &q.TranslationGroupID, &q.Content, &q.Topic, &q.Level, &q.Language, &q.CorrectAnswer, &q.Hint)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrQuestionNotFound
+		}
+		return nil, fmt.Errorf("failed to get question content: %w", err)
+	}
+	return &q, nil
+}
+
+// GetQuestionInfos returns the questions found among questionIDs with their topic names
+func (r *QuestionCatalog) GetQuestionInfos(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID]*domain.QuestionInfo, error) {
+	ids := make([]string, len(questionIDs))
+	for i, id := range questionIDs {
+		ids[i] = id.String()
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT q.id, q.translation_group_id, q.content, COALESCE(t.name, 'General'), q.level, q.language,
+			COALESCE(q.correct_answer, ''), COALESCE(q.hint, '')
+		FROM questions q
+		LEFT JOIN topics t ON q.topic_id = t.id
+		WHERE q.id = ANY($1::uuid[])
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questions: %w", err)
+	}
+	defer rows.Close()
+
+	infos := make(map[uuid.UUID]*domain.QuestionInfo, len(questionIDs))
+	for rows.Next() {
+		var q domain.QuestionInfo
+		if err := rows.Scan(&q.ID, &q.TranslationGroupID, &q.Content, &q.Topic, &q.Level, &q.Language, &q.CorrectAnswer, &q.Hint); err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		infos[q.ID] = &q
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return infos, nil
+}
+
+// topicSubtreeQuery selects the topics matching a condition (the %s verb) plus all their descendants
+const topicSubtreeQuery = `WITH RECURSIVE subtree AS (
+		SELECT id FROM topics WHERE %s
+		UNION
+		SELECT c.id FROM topics c JOIN subtree s ON c.parent_id = s.id
+	) SELECT id FROM subtree`
+
+// poolWhere builds the WHERE clause for the published questions q matching a pool filter, with its arguments
+func poolWhere(filter domain.PoolFilter) (string, []interface{}) {
+	whereClauses := []string{"q.status = 'published'", "q.language = $1"}
+	args := []interface{}{filter.Language}
+	argIdx := 2
+
+	// Topic, including descendant topics, or else stacks naming topics by name, slug or alias
+	if filter.TopicID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.topic_id IN ("+topicSubtreeQuery+")", fmt.Sprintf("id = $%d", argIdx)))
+		args = append(args, *filter.TopicID)
+		argIdx++
+	} else if len(filter.Stacks) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.topic_id IN ("+topicSubtreeQuery+")",
+			fmt.Sprintf("lower(name) = ANY($%[1]d) OR slug = ANY($%[1]d) OR aliases && $%[1]d::text[]", argIdx)))
+		// pgx stdlib encodes []string as a text array.
+		args = append(args, filter.Stacks)
+		argIdx++
+	}
+
+	if len(filter.Roles) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("(q.role = ANY($%d) OR q.role = 'Any')", argIdx))
+		args = append(args, filter.Roles)
+		argIdx++
+	}
+
+	// Tag slugs name distinct tags, so "all" can count them
+	if len(filter.Tags) > 0 {
+		tagMatch := `SELECT qt.question_id FROM question_tags qt JOIN tags t ON t.id = qt.tag_id
+			WHERE t.slug = ANY($%[1]d)`
+		if filter.AllTags {
+			tagMatch += fmt.Sprintf(" GROUP BY qt.question_id HAVING COUNT(DISTINCT t.id) = %d", len(filter.Tags))
+		}
+		whereClauses = append(whereClauses, "q.id IN ("+fmt.Sprintf(tagMatch, argIdx)+")")
+		args = append(args, filter.Tags)
+		argIdx++
+	}
+
+	// Bookmarked questions match across languages by translation group
+	if filter.GroupsOf != nil {
+		ids := make([]string, len(filter.GroupsOf))
+		for i, id := range filter.GroupsOf {
+			ids[i] = id.String()
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf(
+			"q.translation_group_id IN (SELECT translation_group_id FROM questions WHERE id = ANY($%d::uuid[]))", argIdx))
+		args = append(args, ids)
+	}
+
+	return " WHERE " + strings.Join(whereClauses, " AND "), args
+}
+
+// ListPool lists the published questions matching a pool filter
+func (r *QuestionCatalog) ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error) {
+	where, args := poolWhere(filter)
+	rows, err := r.db.QueryContext(ctx, "SELECT q.id, q.translation_group_id, q.level FROM questions q"+where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question pool: %w", err)
+	}
+	defer rows.Close()
+
+	pool := []domain.PoolQuestion{}
+	for rows.Next() {
+		var q domain.PoolQuestion
+		if err := rows.Scan(&q.ID, &q.TranslationGroupID, &q.Level); err != nil {
+			return nil, fmt.Errorf("failed to scan pool question: %w", err)
+		}
+		pool = append(pool, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return pool, nil
+}
+
+// GetTranslationID returns the published version of a question in the given language from its translation
+// group, or the question itself when the group has none
+func (r *QuestionCatalog) GetTranslationID(ctx context.Context, questionID uuid.UUID, language string) (uuid.UUID, error) {
+	query := `
+		SELECT t.id
+		FROM questions q
+		JOIN questions t ON t.translation_group_id = q.translation_group_id
+		WHERE q.id = $1 AND t.language = $2 AND (t.status = 'published' OR t.id = q.id)
+	`
+	var id uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, questionID, language).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return questionID, nil
+		}
+		return uuid.Nil, fmt.Errorf("failed to get question translation: %w", err)
+	}
+	return id, nil
+}
+
+// CreateQuestion rejects content that nearly repeats an existing question and
+// returns weaker matches alongside the inserted draft as warnings
+func (r *QuestionCatalog) CreateQuestion(ctx context.Context, q *domain.Question) ([]domain.SimilarQuestion, error) {
+	matches, err := r.findSimilarQuestions(ctx, q.Content, q.Language, r.duplicates.WarnThreshold, maxDuplicateMatches)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for duplicates: %w", err)
+	}
+	if err := r.duplicates.Reject(matches); err != nil {
+		return nil, err
+	}
+
+	// First ensure topic exists or get it (simplified: just use a default topic if not found or create one)
+	// For MVP, let's assume we look up topic by name or insert it.
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Check if topic exists
+	var topicID uuid.UUID
+	err = tx.QueryRowContext(ctx, topicLookupQuery, q.TopicName).Scan(&topicID)
+	if err != nil {
+		// Create topic if not exists
+		topicID = uuid.New()
+		_, err = tx.ExecContext(ctx, "INSERT INTO topics (id, name, description) VALUES ($1, $2, $3)",
+			topicID, q.TopicName, "Auto-generated topic")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create topic: %w", err)
+		}
+	}
+
+	// Use content excerpt as title if not provided (though we don't have title in domain yet)
+	// Since we made title nullable in migration 000006, we can skip it or provide a default.
+	// We will insert title as first 50 chars of content for backward compatibility or just leave it null if allowed.
+	// But let's check schema: migration 000006 makes title nullable.
+
+	// New questions enter question-service's review workflow as drafts; practice only serves published ones.
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO questions (id, topic_id, content, level, correct_answer, hint, title, status, translation_group_id, language) 
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, 'draft', $1, $8)`,
+		q.ID, topicID, q.Content, q.Level, q.CorrectAnswer, q.Hint, "Generated Question", q.Language)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert question: %w", err)
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO practice_question_samples (question_id, sample_answer, sample_source) VALUES ($1, $2, 'user')`,
+		q.ID, q.CorrectAnswer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert question sample: %w", err)
+	}
+	if err := insertEvent(ctx, tx, domain.NewQuestionCreatedEvent(q, topicID)); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit question: %w", err)
+	}
+
+	return matches, nil
+}
+
+// findSimilarQuestions returns non-archived questions in the same language whose content reaches threshold
+// trigram similarity, most similar first. The '%' operator (threshold set per transaction) lets the lookup
+// use idx_questions_content_trgm.
+func (r *QuestionCatalog) findSimilarQuestions(ctx context.Context, content, language string, threshold float64, limit int) ([]domain.SimilarQuestion, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('pg_trgm.similarity_threshold', $1, true)", strconv.FormatFloat(threshold, 'f', -1, 64)); err != nil {
+		return nil, fmt.Errorf("failed to set similarity threshold: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, content, similarity(lower(content), lower($1)) AS sim
+		FROM questions
+		WHERE lower(content) % lower($1) AND language = $2 AND status <> 'archived'
+		ORDER BY sim DESC
+		LIMIT $3`, content, language, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar questions: %w", err)
+	}
+	defer rows.Close()
+
+	matches := []domain.SimilarQuestion{}
+	for rows.Next() {
+		var m domain.SimilarQuestion
+		if err := rows.Scan(&m.QuestionID, &m.Content, &m.Similarity); err != nil {
+			return nil, fmt.Errorf("failed to scan similar question: %w", err)
+		}
+		matches = append(matches, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return matches, nil
+}
+
+func (r *QuestionCatalog) GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.db.QueryRowContext(ctx, topicLookupQuery, name).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("topic not found: %s", name)
+		}
+		return uuid.Nil, fmt.Errorf("failed to get topic id: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+)
+
+func TestPoolWhere_NormalizesTags(t *testing.T) {
+	config := map[string]interface{}{
+		"tags":      []interface{}{" System Design ", "system-design", "C++", "", "Concurrency"},
+		"tag_match": "all",
+	}
+	where, args := poolWhere(domain.NewPoolFilter(nil, "vi", config))
+
+	wantSlugs := []string{"system-design", "c-plus-plus", "concurrency"}
+	if len(args) != 2 || args[0] != "vi" || !reflect.DeepEqual(args[1], wantSlugs) {
+		t.Fatalf("expected the language and the distinct slugs as arguments, got %#v", args)
+	}
+	// A name and its slug count once, so "all" asks for exactly the distinct tags
+	if !strings.Contains(where, "WHERE t.slug = ANY($2) GROUP BY qt.question_id HAVING COUNT(DISTINCT t.id) = 3") {
+		t.Fatalf("expected an all-match on three distinct tags, got %s", where)
+	}
+
+	config["tag_match"] = "any"
+	if where, _ := poolWhere(domain.NewPoolFilter(nil, "", config)); strings.Contains(where, "HAVING") {
+		t.Fatalf("expected any-match not to group, got %s", where)
+	}
+
+	config["tags"] = []interface{}{" ", "!!"}
+	if where, args := poolWhere(domain.NewPoolFilter(nil, "", config)); strings.Contains(where, "question_tags") || len(args) != 1 {
+		t.Fatalf("expected tags without a slug to be ignored, got %s %#v", where, args)
+	}
+}
+
+func TestPoolWhere_NumbersItsArguments(t *testing.T) {
+	topicID, bookmarked := uuid.New(), uuid.New()
+	filter := domain.NewPoolFilter(&topicID, "en", map[string]interface{}{
+		"tech_stacks": []interface{}{"Go"}, // ignored with a topic
+		"role":        "BackEnd",
+		"round_id":    "devops_round",
+		"tags":        []interface{}{"concurrency"},
+	})
+	filter.GroupsOf = []uuid.UUID{bookmarked}
+
+	where, args := poolWhere(filter)
+	for _, want := range []string{"q.language = $1", "id = $2", "q.role = ANY($3)", "t.slug = ANY($4)", "id = ANY($5::uuid[])"} {
+		if !strings.Contains(where, want) {
+			t.Errorf("expected %q in %s", want, where)
+		}
+	}
+	if len(args) != 5 || !reflect.DeepEqual(args[2], []string{"BackEnd", "DevOps"}) || !reflect.DeepEqual(args[4], []string{bookmarked.String()}) {
+		t.Fatalf("unexpected arguments %#v", args)
+	}
+	if strings.Contains(where, "aliases") {
+		t.Fatalf("expected stacks to be ignored with a topic, got %s", where)
+	}
+}
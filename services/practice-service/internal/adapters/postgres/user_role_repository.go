@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+// UserRoleRepository reads roles from the users table the BFF owns
+type UserRoleRepository struct {
+	db *sql.DB
+}
+
+func NewUserRoleRepository(db *sql.DB) ports.RoleReader {
+	return &UserRoleRepository{db: db}
+}
+
+func (r *UserRoleRepository) GetUserRole(ctx context.Context, userID uuid.UUID) (domain.Role, error) {
+	var role string
+	err := r.db.QueryRowContext(ctx, `SELECT role FROM users WHERE id = $1`, userID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrUserNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get user role: %w", err)
+	}
+	return domain.ParseRole(role), nil
+}
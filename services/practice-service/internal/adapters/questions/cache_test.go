@@ -0,0 +1,39 @@
+package questions
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCache_ExpiresEntries(t *testing.T) {
+	cache := newTTLCache[string, int](30*time.Millisecond, 10)
+	cache.set("a", 1)
+	if v, ok := cache.get("a"); !ok || v != 1 {
+		t.Fatalf("expected a fresh entry, got %d %v", v, ok)
+	}
+	time.Sleep(40 * time.Millisecond)
+	if _, ok := cache.get("a"); ok {
+		t.Fatal("expected the entry to expire")
+	}
+
+	disabled := newTTLCache[string, int](0, 10)
+	disabled.set("a", 1)
+	if _, ok := disabled.get("a"); ok {
+		t.Fatal("expected a zero TTL to disable caching")
+	}
+}
+
+func TestTTLCache_EvictsWhenFull(t *testing.T) {
+	cache := newTTLCache[int, int](time.Minute, 2)
+	cache.set(1, 1)
+	cache.set(2, 2)
+	cache.set(2, 20) // Replacing an entry does not evict
+	if v, ok := cache.get(1); !ok || v != 1 {
+		t.Fatal("expected replacing an entry to keep the others")
+	}
+
+	cache.set(3, 3)
+	if v, ok := cache.get(3); !ok || v != 3 || len(cache.entries) != 2 {
+		t.Fatalf("expected the new entry to replace an old one, got %d entries", len(cache.entries))
+	}
+}
@@ -0,0 +1,441 @@
+package questions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+// maxCacheEntries bounds each of the client's caches
+const maxCacheEntries = 10000
+
+// maxTitleLength is the length of the title cut from a question's content
+const maxTitleLength = 80
+
+// maxBatchQuestions is how many questions question-service looks up in one batch request
+const maxBatchQuestions = 100
+
+// errUnavailable marks failures that say nothing about the question: question-service could not be
+// reached or failed. Only these send reads to the fallback catalog.
+var errUnavailable = errors.New("question service unavailable")
+
+// QuestionClient reads questions from question-service, caching them for a short TTL, and creates questions
+// there on behalf of the signed-in user. Reads fall back to the fallback catalog, if any, while question-service
+// is unavailable; creating a question never does, so it always goes through question-service's checks.
+type QuestionClient struct {
+	baseURL  string
+	client   *http.Client
+	fallback ports.QuestionCatalog
+
+	questions    *ttlCache[uuid.UUID, domain.QuestionInfo]
+	pools        *ttlCache[string, []domain.PoolQuestion]
+	topicNames   *ttlCache[uuid.UUID, string]
+	topicIDs     *ttlCache[string, uuid.UUID]
+	translations *ttlCache[string, uuid.UUID]
+}
+
+func NewQuestionClient(baseURL string, timeout, cacheTTL time.Duration, fallback ports.QuestionCatalog) *QuestionClient {
+	return &QuestionClient{
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		client:       &http.Client{Timeout: timeout},
+		fallback:     fallback,
+		questions:    newTTLCache[uuid.UUID, domain.QuestionInfo](cacheTTL, maxCacheEntries),
+		pools:        newTTLCache[string, []domain.PoolQuestion](cacheTTL, maxCacheEntries),
+		topicNames:   newTTLCache[uuid.UUID, string](cacheTTL, maxCacheEntries),
+		topicIDs:     newTTLCache[string, uuid.UUID](cacheTTL, maxCacheEntries),
+		translations: newTTLCache[string, uuid.UUID](cacheTTL, maxCacheEntries),
+	}
+}
+
+var _ ports.QuestionCatalog = (*QuestionClient)(nil)
+
+// question and topic mirror the fields of question-service's responses that practice reads
+type question struct {
+	ID                 uuid.UUID `json:"id"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+	Content            string    `json:"content"`
+	Level              string    `json:"level"`
+	Language           string    `json:"language"`
+	Hint               string    `json:"hint"`
+	CorrectAnswer      string    `json:"correct_answer"`
+	TopicID            uuid.UUID `json:"topic_id"`
+	Status             string    `json:"status"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type batchRequest struct {
+	IDs []uuid.UUID `json:"ids"`
+}
+
+type topic struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type duplicateMatch struct {
+	QuestionID uuid.UUID `json:"question_id"`
+	Title      string    `json:"title"`
+	Similarity float64   `json:"similarity"`
+}
+
+type createQuestionRequest struct {
+	Title         string `json:"title"`
+	Content       string `json:"content"`
+	Level         string `json:"level"`
+	Language      string `json:"language"`
+	Hint          string `json:"hint"`
+	CorrectAnswer string `json:"correct_answer"`
+	TopicID       string `json:"topic_id"`
+}
+
+type createQuestionResponse struct {
+	question
+	PossibleDuplicates []duplicateMatch `json:"possible_duplicates"`
+}
+
+type errorResponse struct {
+	Error      string           `json:"error"`
+	Duplicates []duplicateMatch `json:"duplicates"`
+}
+
+// useFallback reports whether a failed read should be retried against the fallback catalog
+func (c *QuestionClient) useFallback(op string, err error) bool {
+	if c.fallback == nil || !errors.Is(err, errUnavailable) {
+		return false
+	}
+	log.Printf("%s: %v, reading from the database instead", op, err)
+	return true
+}
+
+func (c *QuestionClient) GetQuestionContent(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) {
+	q, err := c.GetQuestionInfo(ctx, questionID)
+	if err != nil {
+		return "", "", "", "", "", err
+	}
+	return q.Content, q.Topic, q.Level, q.CorrectAnswer, q.Hint, nil
+}
+
+// GetQuestionInfo returns a question with its topic name, or domain.ErrQuestionNotFound
+func (c *QuestionClient) GetQuestionInfo(ctx context.Context, questionID uuid.UUID) (*domain.QuestionInfo, error) {
+	if q, ok := c.questions.get(questionID); ok {
+		return &q, nil
+	}
+
+	var q question
+	found, err := c.getJSON(ctx, "/api/v1/questions/"+questionID.String(), &q)
+	if err != nil {
+		if c.useFallback("get question", err) {
+			return c.fallback.GetQuestionInfo(ctx, questionID)
+		}
+		return nil, fmt.Errorf("failed to get question content: %w", err)
+	}
+	if !found {
+		return nil, domain.ErrQuestionNotFound
+	}
+
+	topicName, err := c.topicName(ctx, q.TopicID)
+	if err != nil {
+		if c.useFallback("get topic", err) {
+			return c.fallback.GetQuestionInfo(ctx, questionID)
+		}
+		return nil, fmt.Errorf("failed to get question topic: %w", err)
+	}
+
+	info := q.info(topicName)
+	c.questions.set(questionID, info)
+	return &info, nil
+}
+
+// GetQuestionInfos looks questions up in batches of maxBatchQuestions, reading only those not cached
+func (c *QuestionClient) GetQuestionInfos(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID]*domain.QuestionInfo, error) {
+	infos := make(map[uuid.UUID]*domain.QuestionInfo, len(questionIDs))
+	var uncached []uuid.UUID
+	for _, id := range questionIDs {
+		if q, ok := c.questions.get(id); ok {
+			infos[id] = &q
+		} else {
+			uncached = append(uncached, id)
+		}
+	}
+
+	for start := 0; start < len(uncached); start += maxBatchQuestions {
+		ids := uncached[start:min(start+maxBatchQuestions, len(uncached))]
+		var batch []question
+		found, err := c.doJSON(ctx, "POST", "/api/v1/questions/batch", batchRequest{IDs: ids}, &batch)
+		if err == nil && !found {
+			// A question-service without the batch endpoint is treated as down rather than read one question at a time
+			err = fmt.Errorf("%w: no batch endpoint", errUnavailable)
+		}
+		if err != nil {
+			if c.useFallback("get questions", err) {
+				return c.fallback.GetQuestionInfos(ctx, questionIDs)
+			}
+			return nil, fmt.Errorf("failed to get questions: %w", err)
+		}
+		for _, q := range batch {
+			topicName, err := c.topicName(ctx, q.TopicID)
+			if err != nil {
+				if c.useFallback("get topic", err) {
+					return c.fallback.GetQuestionInfos(ctx, questionIDs)
+				}
+				return nil, fmt.Errorf("failed to get question topic: %w", err)
+			}
+			info := q.info(topicName)
+			c.questions.set(q.ID, info)
+			infos[q.ID] = &info
+		}
+	}
+	return infos, nil
+}
+
+// info is the question as practice reads it, under its topic's name
+func (q question) info(topicName string) domain.QuestionInfo {
+	return domain.QuestionInfo{
+		ID:                 q.ID,
+		TranslationGroupID: q.TranslationGroupID,
+		Content:            q.Content,
+		Topic:              topicName,
+		Level:              q.Level,
+		Language:           q.Language,
+		CorrectAnswer:      q.CorrectAnswer,
+		Hint:               q.Hint,
+	}
+}
+
+// ListPool lists the published questions matching a session's filters. Pools are cached by filter, so
+// questions published meanwhile join a session's pool within the cache TTL.
+func (c *QuestionClient) ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error) {
+	key := filter.Key()
+	if pool, ok := c.pools.get(key); ok {
+		return pool, nil
+	}
+
+	var pool []domain.PoolQuestion
+	found, err := c.doJSON(ctx, "POST", "/api/v1/questions/pool", filter, &pool)
+	if err == nil && !found {
+		// A question-service without the pool endpoint cannot serve the session either
+		err = fmt.Errorf("%w: no pool endpoint", errUnavailable)
+	}
+	if err != nil {
+		if c.useFallback("list question pool", err) {
+			return c.fallback.ListPool(ctx, filter)
+		}
+		return nil, fmt.Errorf("failed to list question pool: %w", err)
+	}
+	c.pools.set(key, pool)
+	return pool, nil
+}
+
+// topicName returns the name of a topic, or "General" for a question without one
+func (c *QuestionClient) topicName(ctx context.Context, topicID uuid.UUID) (string, error) {
+	if name, ok := c.topicNames.get(topicID); ok {
+		return name, nil
+	}
+
+	var t topic
+	found, err := c.getJSON(ctx, "/api/v1/topics/"+topicID.String(), &t)
+	if err != nil {
+		return "", err
+	}
+	name := "General"
+	if found && t.Name != "" {
+		name = t.Name
+	}
+	c.topicNames.set(topicID, name)
+	return name, nil
+}
+
+// GetTranslationID returns the published version of a question in the given language from its translation
+// group, or the question itself when the group has none
+func (c *QuestionClient) GetTranslationID(ctx context.Context, questionID uuid.UUID, language string) (uuid.UUID, error) {
+	key := questionID.String() + "/" + language
+	if id, ok := c.translations.get(key); ok {
+		return id, nil
+	}
+
+	var translations []question
+	found, err := c.getJSON(ctx, "/api/v1/questions/"+questionID.String()+"/translations", &translations)
+	if err != nil {
+		if c.useFallback("get translations", err) {
+			return c.fallback.GetTranslationID(ctx, questionID, language)
+		}
+		return uuid.Nil, fmt.Errorf("failed to get question translation: %w", err)
+	}
+
+	id := questionID
+	if found {
+		for _, t := range translations {
+			if t.Language == language && (t.Status == "published" || t.ID == questionID) {
+				id = t.ID
+				break
+			}
+		}
+	}
+	c.translations.set(key, id)
+	return id, nil
+}
+
+func (c *QuestionClient) GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error) {
+	id, err := c.lookupTopicID(ctx, name)
+	if err != nil && c.useFallback("get topic", err) {
+		return c.fallback.GetTopicIDByName(ctx, name)
+	}
+	return id, err
+}
+
+// lookupTopicID resolves a topic by name, slug or alias; unknown topics are not cached so new ones show up at once
+func (c *QuestionClient) lookupTopicID(ctx context.Context, name string) (uuid.UUID, error) {
+	if id, ok := c.topicIDs.get(name); ok {
+		return id, nil
+	}
+
+	var topics []topic
+	found, err := c.getJSON(ctx, "/api/v1/topics?"+url.Values{"name": {name}}.Encode(), &topics)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get topic id: %w", err)
+	}
+	if !found || len(topics) == 0 {
+		return uuid.Nil, fmt.Errorf("topic not found: %s", name)
+	}
+	c.topicIDs.set(name, topics[0].ID)
+	return topics[0].ID, nil
+}
+
+// CreateQuestion creates the question in question-service as the signed-in user. Unlike the database
+// catalog it does not create missing topics. The question's ID and creation time are replaced by the stored ones.
+func (c *QuestionClient) CreateQuestion(ctx context.Context, q *domain.Question) ([]domain.SimilarQuestion, error) {
+	token := domain.AccessTokenFromContext(ctx)
+	if token == "" {
+		return nil, fmt.Errorf("failed to create question: no access token to call question service with")
+	}
+
+	topicID, err := c.lookupTopicID(ctx, q.TopicName)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonBody, err := json.Marshal(createQuestionRequest{
+		Title:         titleFromContent(q.Content),
+		Content:       q.Content,
+		Level:         q.Level,
+		Language:      q.Language,
+		Hint:          q.Hint,
+		CorrectAnswer: q.CorrectAnswer,
+		TopicID:       topicID.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/api/v1/questions", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call question service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		var created createQuestionResponse
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		q.ID = created.ID
+		q.TopicID = &created.TopicID
+		q.CreatedAt = created.CreatedAt
+		return similarQuestions(created.PossibleDuplicates), nil
+	case http.StatusConflict:
+		var body errorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || len(body.Duplicates) == 0 {
+			return nil, fmt.Errorf("question service returned status: %d", resp.StatusCode)
+		}
+		return nil, &domain.DuplicateError{Matches: similarQuestions(body.Duplicates)}
+	case http.StatusBadRequest:
+		var body errorResponse
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		return nil, fmt.Errorf("invalid question: %s", body.Error)
+	default:
+		return nil, fmt.Errorf("question service returned status: %d", resp.StatusCode)
+	}
+}
+
+// getJSON decodes a 200 response into out. A 404 is reported as not found rather than as an error;
+// transport failures and 5xx responses wrap errUnavailable.
+func (c *QuestionClient) getJSON(ctx context.Context, path string, out interface{}) (bool, error) {
+	return c.doJSON(ctx, "GET", path, nil, out)
+}
+
+// doJSON sends body, if any, as JSON and decodes a 200 response into out, reporting errors as getJSON does
+func (c *QuestionClient) doJSON(ctx context.Context, method, path string, body, out interface{}) (bool, error) {
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonBody)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", errUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return false, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return true, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return false, fmt.Errorf("%w: status %d", errUnavailable, resp.StatusCode)
+	default:
+		return false, fmt.Errorf("question service returned status: %d", resp.StatusCode)
+	}
+}
+
+// similarQuestions maps question-service's duplicate matches, which carry titles rather than content
+func similarQuestions(matches []duplicateMatch) []domain.SimilarQuestion {
+	similar := make([]domain.SimilarQuestion, 0, len(matches))
+	for _, m := range matches {
+		similar = append(similar, domain.SimilarQuestion{QuestionID: m.QuestionID, Content: m.Title, Similarity: m.Similarity})
+	}
+	return similar
+}
+
+// titleFromContent cuts a title from the first maxTitleLength characters of the content
+func titleFromContent(content string) string {
+	title := strings.Join(strings.Fields(content), " ")
+	if runes := []rune(title); len(runes) > maxTitleLength {
+		title = strings.TrimSpace(string(runes[:maxTitleLength])) + "..."
+	}
+	return title
+}
@@ -0,0 +1,222 @@
+package questions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+// fakeCatalog is the database catalog the client falls back to
+type fakeCatalog struct {
+	reads int
+}
+
+func (f *fakeCatalog) GetQuestionContent(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) {
+	return "", "", "", "", "", errors.New("not implemented")
+}
+func (f *fakeCatalog) GetQuestionInfo(ctx context.Context, questionID uuid.UUID) (*domain.QuestionInfo, error) {
+	f.reads++
+	return &domain.QuestionInfo{ID: questionID, Content: "From the database", Topic: "General"}, nil
+}
+func (f *fakeCatalog) GetQuestionInfos(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID]*domain.QuestionInfo, error) {
+	f.reads++
+	infos := make(map[uuid.UUID]*domain.QuestionInfo, len(questionIDs))
+	for _, id := range questionIDs {
+		infos[id] = &domain.QuestionInfo{ID: id, Content: "From the database", Topic: "General"}
+	}
+	return infos, nil
+}
+func (f *fakeCatalog) ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error) {
+	f.reads++
+	return []domain.PoolQuestion{{ID: uuid.Nil, Level: "Mid"}}, nil
+}
+func (f *fakeCatalog) GetTranslationID(ctx context.Context, questionID uuid.UUID, language string) (uuid.UUID, error) {
+	return questionID, nil
+}
+func (f *fakeCatalog) GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error) {
+	return uuid.Nil, errors.New("not implemented")
+}
+func (f *fakeCatalog) CreateQuestion(ctx context.Context, question *domain.Question) ([]domain.SimilarQuestion, error) {
+	return nil, errors.New("not implemented")
+}
+
+var _ ports.QuestionCatalog = (*fakeCatalog)(nil)
+
+// questionServer serves one question and its topic, counting the requests it gets
+func questionServer(t *testing.T, q question, status *int32, requests *int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/questions/" + q.ID.String():
+			_ = json.NewEncoder(w).Encode(q)
+		case "/api/v1/questions/batch":
+			var req batchRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			batch := []question{}
+			for _, id := range req.IDs {
+				if id == q.ID {
+					batch = append(batch, q)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(batch)
+		case "/api/v1/topics/" + q.TopicID.String():
+			_ = json.NewEncoder(w).Encode(topic{ID: q.TopicID, Name: "Golang"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetQuestionInfo_CachesUntilExpiry(t *testing.T) {
+	q := question{ID: uuid.New(), TranslationGroupID: uuid.New(), Content: "What is a goroutine?", Level: "Junior", Language: "en", TopicID: uuid.New()}
+	status, requests := int32(http.StatusOK), int32(0)
+	srv := questionServer(t, q, &status, &requests)
+	client := NewQuestionClient(srv.URL, time.Second, 50*time.Millisecond, nil)
+	ctx := context.Background()
+
+	info, err := client.GetQuestionInfo(ctx, q.ID)
+	if err != nil || info.Content != q.Content || info.Topic != "Golang" || info.TranslationGroupID != q.TranslationGroupID {
+		t.Fatalf("expected the question with its topic name, got %+v (%v)", info, err)
+	}
+	if _, err := client.GetQuestionInfo(ctx, q.ID); err != nil || requests != 2 {
+		t.Fatalf("expected the second read to be cached, got %d requests (%v)", requests, err)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.GetQuestionInfo(ctx, q.ID); err != nil || requests != 4 {
+		t.Fatalf("expected an expired entry to be read again, got %d requests (%v)", requests, err)
+	}
+}
+
+func TestGetQuestionInfo_NotFoundDoesNotFallBack(t *testing.T) {
+	q := question{ID: uuid.New(), TopicID: uuid.New()}
+	status, requests := int32(http.StatusOK), int32(0)
+	srv := questionServer(t, q, &status, &requests)
+	fallback := &fakeCatalog{}
+	client := NewQuestionClient(srv.URL, time.Second, time.Minute, fallback)
+
+	if _, err := client.GetQuestionInfo(context.Background(), uuid.New()); !errors.Is(err, domain.ErrQuestionNotFound) || fallback.reads != 0 {
+		t.Fatalf("expected not found from question-service alone, got %v (%d fallback reads)", err, fallback.reads)
+	}
+}
+
+func TestGetQuestionInfo_FallsBackWhenUnavailable(t *testing.T) {
+	q := question{ID: uuid.New(), TopicID: uuid.New()}
+	status, requests := int32(http.StatusServiceUnavailable), int32(0)
+	srv := questionServer(t, q, &status, &requests)
+	fallback := &fakeCatalog{}
+	ctx := context.Background()
+
+	client := NewQuestionClient(srv.URL, time.Second, time.Minute, fallback)
+	if info, err := client.GetQuestionInfo(ctx, q.ID); err != nil || info.Content != "From the database" {
+		t.Fatalf("expected a 5xx to read from the database, got %+v (%v)", info, err)
+	}
+
+	// Other failures say something about the request, so they are not retried against the database
+	atomic.StoreInt32(&status, http.StatusForbidden)
+	if _, err := client.GetQuestionInfo(ctx, q.ID); err == nil || fallback.reads != 1 {
+		t.Fatalf("expected a 403 to fail without the fallback, got %v (%d fallback reads)", err, fallback.reads)
+	}
+
+	// Without a fallback the outage is reported
+	atomic.StoreInt32(&status, http.StatusInternalServerError)
+	if _, err := NewQuestionClient(srv.URL, time.Second, time.Minute, nil).GetQuestionInfo(ctx, q.ID); !errors.Is(err, errUnavailable) {
+		t.Fatalf("expected question-service to be unavailable, got %v", err)
+	}
+}
+
+func TestGetQuestionInfo_FallsBackOnTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+	fallback := &fakeCatalog{}
+
+	client := NewQuestionClient(srv.URL, 20*time.Millisecond, time.Minute, fallback)
+	if info, err := client.GetQuestionInfo(context.Background(), uuid.New()); err != nil || info.Content != "From the database" {
+		t.Fatalf("expected a timeout to read from the database, got %+v (%v)", info, err)
+	}
+}
+
+func TestGetQuestionInfos_BatchesUncachedQuestions(t *testing.T) {
+	q := question{ID: uuid.New(), Content: "What is a goroutine?", TopicID: uuid.New()}
+	status, requests := int32(http.StatusOK), int32(0)
+	srv := questionServer(t, q, &status, &requests)
+	fallback := &fakeCatalog{}
+	client := NewQuestionClient(srv.URL, time.Second, time.Minute, fallback)
+	ctx := context.Background()
+
+	deleted := uuid.New()
+	infos, err := client.GetQuestionInfos(ctx, []uuid.UUID{q.ID, deleted})
+	if err != nil || len(infos) != 1 || infos[q.ID].Content != q.Content || infos[q.ID].Topic != "Golang" || requests != 2 {
+		t.Fatalf("expected one batch and one topic request leaving the missing question out, got %+v after %d requests (%v)", infos, requests, err)
+	}
+	if infos, err := client.GetQuestionInfos(ctx, []uuid.UUID{q.ID}); err != nil || len(infos) != 1 || requests != 2 {
+		t.Fatalf("expected a cached question not to be requested again, got %d requests (%v)", requests, err)
+	}
+
+	atomic.StoreInt32(&status, http.StatusServiceUnavailable)
+	if infos, err := client.GetQuestionInfos(ctx, []uuid.UUID{q.ID, deleted}); err != nil || infos[deleted].Content != "From the database" || fallback.reads != 1 {
+		t.Fatalf("expected a 5xx to read the batch from the database, got %+v (%v)", infos, err)
+	}
+}
+
+func TestListPool_PostsTheFilterAndFallsBack(t *testing.T) {
+	poolID := uuid.New()
+	status, requests := int32(http.StatusOK), int32(0)
+	var got domain.PoolFilter
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if code := atomic.LoadInt32(&status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/questions/pool" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_ = json.NewEncoder(w).Encode([]domain.PoolQuestion{{ID: poolID, TranslationGroupID: poolID, Level: "Mid"}})
+	}))
+	t.Cleanup(srv.Close)
+	fallback := &fakeCatalog{}
+	client := NewQuestionClient(srv.URL, time.Second, time.Minute, fallback)
+	ctx := context.Background()
+
+	filter := domain.PoolFilter{Language: "vi", Roles: []string{"BackEnd"}, Tags: []string{"concurrency"}, AllTags: true, Bookmarked: true}
+	pool, err := client.ListPool(ctx, filter)
+	if err != nil || len(pool) != 1 || pool[0].ID != poolID {
+		t.Fatalf("expected the served pool, got %+v (%v)", pool, err)
+	}
+	if got.Language != "vi" || !got.AllTags || strings.Join(got.Roles, ",") != "BackEnd" || got.Bookmarked {
+		t.Fatalf("expected the filter in the body without practice's own fields, got %+v", got)
+	}
+	if _, err := client.ListPool(ctx, filter); err != nil || requests != 1 {
+		t.Fatalf("expected the pool to be cached, got %d requests (%v)", requests, err)
+	}
+
+	// A different filter is a different pool; question-service being down sends it to the database
+	atomic.StoreInt32(&status, http.StatusBadGateway)
+	filter.Language = "en"
+	if pool, err := client.ListPool(ctx, filter); err != nil || len(pool) != 1 || pool[0].ID != uuid.Nil || fallback.reads != 1 {
+		t.Fatalf("expected the database pool, got %+v (%v)", pool, err)
+	}
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "github.com/google/uuid"
+
+// SuggestedAnswerAttribution credits AI-generated sample answers published to answer-service
+const SuggestedAnswerAttribution = "ai-service"
+
+// SuggestedAnswer is an AI-generated sample answer published to answer-service for a question level, which
+// answer-service maps to its level target (junior, mid, senior)
+type SuggestedAnswer struct {
+	QuestionID  uuid.UUID `json:"question_id"`
+	Content     string    `json:"content"`
+	LevelTarget string    `json:"level_target"`
+	Attribution string    `json:"attribution"`
+}
+
+// Answer is an answer to a question as answer-service lists it
+type Answer struct {
+	ID          uuid.UUID `json:"id"`
+	QuestionID  uuid.UUID `json:"question_id"`
+	Content     string    `json:"content"`
+	AuthorID    uuid.UUID `json:"author_id"`   // uuid.Nil for generated answers
+	AnswerType  string    `json:"answer_type"` // canonical, community or suggested
+	LevelTarget string    `json:"level_target"`
+	VoteCount   int       `json:"vote_count"`
+	IsAccepted  bool      `json:"is_accepted"`
+}
+
+// AnswerQuery selects a question's answers from answer-service, best voted first; empty fields match everything.
+// LevelTarget takes a question or session level.
+type AnswerQuery struct {
+	QuestionID  uuid.UUID
+	AnswerType  string
+	LevelTarget string
+	Limit       int
+}
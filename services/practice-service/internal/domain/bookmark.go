@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCollectionNotFound = errors.New("collection not found")
+	// ErrCollectionForbidden rejects changes to another user's collection
+	ErrCollectionForbidden = errors.New("not allowed to modify this collection")
+)
+
+// Session config keys that draw a session's questions from bookmarks instead of the whole bank
+const (
+	ConfigBookmarked   = "bookmarked"    // true: the session user's bookmarks
+	ConfigCollectionID = "collection_id" // a collection the session user owns, or a shared one
+)
+
+// BookmarkedQuestion is a bookmarked question with enough of it to list
+type BookmarkedQuestion struct {
+	QuestionID   uuid.UUID `json:"question_id"`
+	Content      string    `json:"content"`
+	Topic        string    `json:"topic"`
+	Level        string    `json:"level"`
+	Language     string    `json:"language"`
+	BookmarkedAt time.Time `json:"bookmarked_at"`
+}
+
+// Collection is a named list of its owner's bookmarks; shared collections are readable by anyone signed in
+type Collection struct {
+	ID            uuid.UUID            `json:"id"`
+	UserID        uuid.UUID            `json:"user_id"`
+	Name          string               `json:"name"`
+	Description   string               `json:"description"`
+	IsShared      bool                 `json:"is_shared"`
+	QuestionCount int                  `json:"question_count"`
+	Questions     []BookmarkedQuestion `json:"questions,omitempty"` // Filled when a single collection is read
+	CreatedAt     time.Time            `json:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at"`
+}
+
+func NewCollection(userID uuid.UUID, name, description string, isShared bool) *Collection {
+	now := time.Now()
+	return &Collection{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		IsShared:    isShared,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// CanRead reports whether a user may read the collection and practice from it
+func (c *Collection) CanRead(userID uuid.UUID) bool {
+	return c.IsShared || c.UserID == userID
+}
+
+// CollectionPatch holds the collection fields an update changes; nil fields are kept
+type CollectionPatch struct {
+	Name        *string
+	Description *string
+	IsShared    *bool
+}
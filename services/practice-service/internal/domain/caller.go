@@ -0,0 +1,16 @@
+package domain
+
+import "context"
+
+type accessTokenKey struct{}
+
+// WithAccessToken carries the caller's verified access token so calls to other services act on their behalf
+func WithAccessToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, accessTokenKey{}, token)
+}
+
+// AccessTokenFromContext returns the caller's access token, or "" for anonymous requests
+func AccessTokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(accessTokenKey{}).(string)
+	return token
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// PoolFilter selects the published questions a session can draw from, before its level and history
+// rules. It mirrors question-service's pool filter; CollectionID and Bookmarked are practice's own and
+// are resolved to GroupsOf from the session user's bookmarks before the catalog is asked.
+type PoolFilter struct {
+	TopicID  *uuid.UUID  `json:"topic_id,omitempty"`  // Includes descendant topics
+	Stacks   []string    `json:"stacks,omitempty"`    // Topic names, slugs or aliases, with descendants; ignored with TopicID
+	Roles    []string    `json:"roles,omitempty"`     // Questions for one of these roles or for "Any"
+	Tags     []string    `json:"tags,omitempty"`      // Tag slugs
+	AllTags  bool        `json:"all_tags,omitempty"`  // Questions must carry every tag rather than any
+	Language string      `json:"language"`            // en or vi
+	GroupsOf []uuid.UUID `json:"groups_of,omitempty"` // Only the translation groups of these questions
+
+	CollectionID string `json:"-"` // Draw from this bookmark collection
+	Bookmarked   bool   `json:"-"` // Draw from all of the session user's bookmarks
+}
+
+// FromBookmarks reports whether the pool is limited to bookmarked questions
+func (f PoolFilter) FromBookmarks() bool {
+	return f.CollectionID != "" || f.Bookmarked
+}
+
+// Key identifies the filter for caching
+func (f PoolFilter) Key() string {
+	return fmt.Sprintf("%v|%s|%s|%s|%v|%s|%v", f.TopicID, strings.Join(f.Stacks, ","), strings.Join(f.Roles, ","),
+		strings.Join(f.Tags, ","), f.AllTags, f.Language, f.GroupsOf)
+}
+
+// PoolQuestion is a question a session can draw, with the fields its selection reads
+type PoolQuestion struct {
+	ID                 uuid.UUID `json:"id"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+	Level              string    `json:"level"`
+}
+
+// NewPoolFilter reads a session's question filters from its topic, language and config:
+//   - "tech_stacks" (or the legacy "stacks") names topics when there is no topic
+//   - "role" also admits "Any" questions, and DevOps ones in the "devops_round" round
+//   - "tags" holds tag slugs or names; "tag_match" is "any" (default) or "all"
+//   - "collection_id" or "bookmarked" draw from bookmarks
+func NewPoolFilter(topicID *uuid.UUID, language string, config map[string]interface{}) PoolFilter {
+	filter := PoolFilter{TopicID: topicID, Language: language}
+	if filter.Language == "" {
+		filter.Language = "en"
+	}
+	if config == nil {
+		return filter
+	}
+
+	if topicID == nil {
+		stacks, ok := config["tech_stacks"]
+		if !ok {
+			stacks = config["stacks"]
+		}
+		if list, ok := stacks.([]interface{}); ok {
+			for _, s := range list {
+				if name := strings.ToLower(strings.TrimSpace(fmt.Sprint(s))); name != "" {
+					filter.Stacks = append(filter.Stacks, name)
+				}
+			}
+		}
+	}
+
+	if role, ok := config["role"].(string); ok && role != "" {
+		filter.Roles = []string{role}
+		if roundID, _ := config["round_id"].(string); roundID == "devops_round" {
+			filter.Roles = append(filter.Roles, "DevOps")
+		}
+	}
+
+	if rawTags, ok := config["tags"].([]interface{}); ok && len(rawTags) > 0 {
+		names := make([]string, len(rawTags))
+		for i, t := range rawTags {
+			names[i] = fmt.Sprint(t)
+		}
+		if slugs := NormalizeTagSlugs(names); len(slugs) > 0 {
+			filter.Tags = slugs
+			match, _ := config["tag_match"].(string)
+			filter.AllTags = match == "all"
+		}
+	}
+
+	if collectionID, _ := config[ConfigCollectionID].(string); collectionID != "" {
+		filter.CollectionID = collectionID
+	} else if bookmarked, _ := config[ConfigBookmarked].(bool); bookmarked {
+		filter.Bookmarked = true
+	}
+	return filter
+}
+
+// ErrQuestionNotFound is returned by the catalog for a question that does not exist
+var ErrQuestionNotFound = errors.New("question not found")
+
+// QuestionInfo is a question as practice reads it from the catalog
+type QuestionInfo struct {
+	ID                 uuid.UUID
+	TranslationGroupID uuid.UUID
+	Content            string
+	Topic              string // "General" for a question without a topic
+	Level              string
+	Language           string
+	CorrectAnswer      string
+	Hint               string
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// Sources of a compared answer
+const (
+	ComparedCanonical = "canonical" // a canonical answer in answer-service
+	ComparedQuestion  = "question"  // the question's correct answer, used when there is no canonical answer
+	ComparedCommunity = "community"
+)
+
+// AnswerComparison sets a practice attempt beside the canonical and top-voted community answers
+type AnswerComparison struct {
+	AttemptID  uuid.UUID        `json:"attempt_id"`
+	QuestionID uuid.UUID        `json:"question_id"`
+	UserAnswer string           `json:"user_answer"`
+	Canonical  *ComparedAnswer  `json:"canonical,omitempty"`
+	Community  []ComparedAnswer `json:"community"`
+}
+
+// ComparedAnswer is a reference answer with its similarity to the user's answer
+type ComparedAnswer struct {
+	AnswerID    *uuid.UUID `json:"answer_id,omitempty"` // Unset for the question's correct answer
+	Source      string     `json:"source"`
+	Content     string     `json:"content"`
+	LevelTarget string     `json:"level_target,omitempty"`
+	VoteCount   int        `json:"vote_count"`
+	IsAccepted  bool       `json:"is_accepted"`
+	Similarity  float64    `json:"similarity"` // 0 (nothing in common) to 1 (same words)
+}
+
+// NewComparedAnswer compares an answer-service answer with the user's answer
+func NewComparedAnswer(answer Answer, source, userAnswer string) ComparedAnswer {
+	id := answer.ID
+	return ComparedAnswer{
+		AnswerID:    &id,
+		Source:      source,
+		Content:     answer.Content,
+		LevelTarget: answer.LevelTarget,
+		VoteCount:   answer.VoteCount,
+		IsAccepted:  answer.IsAccepted,
+		Similarity:  AnswerSimilarity(userAnswer, answer.Content),
+	}
+}
+
+// AnswerSimilarity is the cosine similarity of two answers' word counts, rounded to three decimals.
+// Words are runs of letters, digits and combining marks compared case-insensitively, so it works for any language.
+func AnswerSimilarity(a, b string) float64 {
+	countsA, countsB := wordCounts(a), wordCounts(b)
+	if len(countsA) == 0 || len(countsB) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for word, n := range countsA {
+		normA += n * n
+		dot += n * countsB[word]
+	}
+	for _, n := range countsB {
+		normB += n * n
+	}
+	return math.Round(dot/math.Sqrt(normA*normB)*1000) / 1000
+}
+
+func wordCounts(s string) map[string]float64 {
+	counts := make(map[string]float64)
+	for _, word := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.IsMark(r)
+	}) {
+		counts[word]++
+	}
+	return counts
+}
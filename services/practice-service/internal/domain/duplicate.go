@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var ErrDuplicateQuestion = errors.New("near-duplicate of an existing question")
+
+// DuplicatePolicy sets the trigram similarity (0..1) at which a new question is reported as a
+// possible duplicate, and at which it is rejected outright. It mirrors question-service's policy
+// and is read from the same DUPLICATE_WARN_THRESHOLD / DUPLICATE_REJECT_THRESHOLD variables.
+type DuplicatePolicy struct {
+	WarnThreshold   float64
+	RejectThreshold float64
+}
+
+// DefaultDuplicatePolicy warns at 0.6 similarity and rejects at 0.9
+func DefaultDuplicatePolicy() DuplicatePolicy {
+	return DuplicatePolicy{WarnThreshold: 0.6, RejectThreshold: 0.9}
+}
+
+// Reject returns a *DuplicateError when the closest of matches (most similar first) reaches the reject threshold
+func (p DuplicatePolicy) Reject(matches []SimilarQuestion) error {
+	if len(matches) > 0 && matches[0].Similarity >= p.RejectThreshold {
+		return &DuplicateError{Matches: matches}
+	}
+	return nil
+}
+
+// SimilarQuestion is an existing question whose content resembles a new one (trigram similarity, 0..1)
+type SimilarQuestion struct {
+	QuestionID uuid.UUID `json:"question_id"`
+	Content    string    `json:"content"`
+	Similarity float64   `json:"similarity"`
+}
+
+// DuplicateError rejects a question and carries the matches that caused it
+type DuplicateError struct {
+	Matches []SimilarQuestion
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("%s (%s, similarity %.2f)", ErrDuplicateQuestion, e.Matches[0].QuestionID, e.Matches[0].Similarity)
+}
+
+func (e *DuplicateError) Unwrap() error {
+	return ErrDuplicateQuestion
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDuplicatePolicy_Reject(t *testing.T) {
+	match := func(similarity float64) []SimilarQuestion {
+		return []SimilarQuestion{{QuestionID: uuid.New(), Similarity: similarity}, {QuestionID: uuid.New(), Similarity: 0.61}}
+	}
+
+	defaults := DefaultDuplicatePolicy()
+	if err := defaults.Reject(nil); err != nil {
+		t.Fatalf("expected no rejection without matches, got %v", err)
+	}
+	if err := defaults.Reject(match(0.89)); err != nil {
+		t.Fatalf("expected a warning-only match below 0.9 to pass, got %v", err)
+	}
+
+	err := defaults.Reject(match(0.9))
+	var dupErr *DuplicateError
+	if !errors.As(err, &dupErr) || !errors.Is(err, ErrDuplicateQuestion) || len(dupErr.Matches) != 2 {
+		t.Fatalf("expected a DuplicateError carrying every match at 0.9, got %v", err)
+	}
+
+	// A configured policy replaces the defaults
+	strict := DuplicatePolicy{WarnThreshold: 0.5, RejectThreshold: 0.75}
+	if err := strict.Reject(match(0.8)); !errors.Is(err, ErrDuplicateQuestion) {
+		t.Fatalf("expected the configured threshold to reject 0.8, got %v", err)
+	}
+	lenient := DuplicatePolicy{WarnThreshold: 0.8, RejectThreshold: 1}
+	if err := lenient.Reject(match(0.95)); err != nil {
+		t.Fatalf("expected the configured threshold to accept 0.95, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Event types practice-service appends to the outbox
+const (
+	EventAttemptGraded   = "attempt.graded"
+	EventQuestionCreated = "question.created" // Only when questions are created in the database directly
+)
+
+// Event is a domain event written to the outbox in the same transaction as the change it describes.
+// The outbox assigns its ID and time.
+type Event struct {
+	AggregateType string
+	AggregateID   uuid.UUID
+	Type          string
+	Payload       interface{}
+}
+
+// AttemptGraded is the payload of attempt.graded
+type AttemptGraded struct {
+	AttemptID       uuid.UUID  `json:"attempt_id"`
+	SessionID       uuid.UUID  `json:"session_id"`
+	UserID          uuid.UUID  `json:"user_id"`
+	QuestionID      uuid.UUID  `json:"question_id"`
+	ParentAttemptID *uuid.UUID `json:"parent_attempt_id,omitempty"` // Set for follow-up answers
+	Score           int        `json:"score"`
+}
+
+func NewAttemptGradedEvent(attempt *PracticeAttempt, userID uuid.UUID) Event {
+	return Event{
+		AggregateType: "attempt",
+		AggregateID:   attempt.ID,
+		Type:          EventAttemptGraded,
+		Payload: AttemptGraded{
+			AttemptID:       attempt.ID,
+			SessionID:       attempt.SessionID,
+			UserID:          userID,
+			QuestionID:      attempt.QuestionID,
+			ParentAttemptID: attempt.ParentAttemptID,
+			Score:           attempt.Score,
+		},
+	}
+}
+
+// QuestionCreated is the payload of question.created, matching question-service's
+type QuestionCreated struct {
+	QuestionID         uuid.UUID `json:"question_id"`
+	Level              string    `json:"level"`
+	TopicID            uuid.UUID `json:"topic_id"`
+	Status             string    `json:"status"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+}
+
+func NewQuestionCreatedEvent(q *Question, topicID uuid.UUID) Event {
+	return Event{
+		AggregateType: "question",
+		AggregateID:   q.ID,
+		Type:          EventQuestionCreated,
+		Payload: QuestionCreated{
+			QuestionID:         q.ID,
+			Level:              q.Level,
+			TopicID:            topicID,
+			Status:             "draft",
+			TranslationGroupID: q.ID,
+		},
+	}
+}
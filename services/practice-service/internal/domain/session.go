@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,17 +21,37 @@ type PracticeSession struct {
 }
 
 type PracticeAttempt struct {
-	ID             uuid.UUID `json:"id"`
-	SessionID      uuid.UUID `json:"session_id"`
-	QuestionID     uuid.UUID `json:"question_id"`
-	UserAnswer     string    `json:"user_answer"`
-	Score          int       `json:"score"`                     // 0-100 from AI
-	Feedback       string    `json:"feedback"`                  // Short feedback text (no suggestions)
-	Suggestions    []string  `json:"suggestions,omitempty"`     // Returned in API; not persisted
-	ImprovedAnswer string    `json:"improved_answer,omitempty"` // Returned in API; not persisted
-	CreatedAt      time.Time `json:"created_at"`
+	ID                 uuid.UUID           `json:"id"`
+	SessionID          uuid.UUID           `json:"session_id"`
+	QuestionID         uuid.UUID           `json:"question_id"`
+	TranslationGroupID uuid.UUID           `json:"-"`                           // The question's translation group when answered, for selection
+	ParentAttemptID    *uuid.UUID          `json:"parent_attempt_id,omitempty"` // Set when answering a follow-up
+	FollowUpID         *uuid.UUID          `json:"follow_up_id,omitempty"`      // Set when answering a follow-up
+	UserAnswer         string              `json:"user_answer"`
+	Score              int                 `json:"score"`                     // 0-100 from AI
+	Graded             bool                `json:"graded"`                    // False when the AI was off or failed; no score is stored then
+	Feedback           string              `json:"feedback"`                  // Short feedback text (no suggestions)
+	Suggestions        []string            `json:"suggestions,omitempty"`     // Returned in API; not persisted
+	ImprovedAnswer     string              `json:"improved_answer,omitempty"` // Returned in API; not persisted
+	FollowUps          []*FollowUpQuestion `json:"follow_ups,omitempty"`      // Returned in API; persisted separately
+	CreatedAt          time.Time           `json:"created_at"`
 }
 
+// FollowUpQuestion is an ephemeral probing question generated from an attempt.
+// It lives only inside its session and is answered like any other question.
+type FollowUpQuestion struct {
+	ID              uuid.UUID `json:"id"`
+	SessionID       uuid.UUID `json:"session_id"`
+	ParentAttemptID uuid.UUID `json:"parent_attempt_id"`
+	QuestionID      uuid.UUID `json:"question_id"` // Bank question the parent attempt answered
+	Content         string    `json:"content"`
+	Answered        bool      `json:"answered"` // A follow-up takes a single answer
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// ErrFollowUpAnswered rejects a second answer to the same follow-up
+var ErrFollowUpAnswered = errors.New("follow-up already answered")
+
 type Question struct {
 	ID            uuid.UUID  `json:"id"`
 	Content       string     `json:"content"`
@@ -38,6 +59,7 @@ type Question struct {
 	Level         string     `json:"level"`
 	CorrectAnswer string     `json:"correct_answer"`
 	Hint          string     `json:"hint"`
+	Language      string     `json:"language"`
 	CreatedAt     time.Time  `json:"created_at"`
 	// We might need to handle Topic string vs ID, but for now let's assume simple string mapping or null
 	TopicName string `json:"topic"` // Helper for now
@@ -49,6 +71,7 @@ func NewQuestion(content, topic, level string) *Question {
 		Content:   content,
 		TopicName: topic,
 		Level:     level,
+		Language:  "en",
 		CreatedAt: time.Now(),
 	}
 }
@@ -73,3 +96,14 @@ func NewPracticeAttempt(sessionID, questionID uuid.UUID, userAnswer string) *Pra
 		CreatedAt:  time.Now(),
 	}
 }
+
+func NewFollowUpQuestion(parent *PracticeAttempt, content string) *FollowUpQuestion {
+	return &FollowUpQuestion{
+		ID:              uuid.New(),
+		SessionID:       parent.SessionID,
+		ParentAttemptID: parent.ID,
+		QuestionID:      parent.QuestionID,
+		Content:         content,
+		CreatedAt:       time.Now(),
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+// slugSymbols keeps names like "C++" and "C#" distinct from "C"
+var slugSymbols = strings.NewReplacer("+", " plus ", "#", " sharp ")
+
+// Slugify lower-cases a name and joins its letters and digits with hyphens: "System Design" → "system-design".
+// It matches question-service, which stores every tag's slug as the Slugify of its name.
+func Slugify(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(slugSymbols.Replace(name)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, "-")
+}
+
+// NormalizeTagSlugs turns tag slugs or names into distinct slugs, dropping empty ones
+func NormalizeTagSlugs(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	slugs := []string{}
+	for _, t := range tags {
+		slug := Slugify(t)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		slugs = append(slugs, slug)
+	}
+	return slugs
+}
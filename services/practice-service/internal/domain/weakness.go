@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ModeWeakest is the session mode that drills the user's weak questions: those whose latest attempt
+// scored below the weak threshold. Each is drawn at most once per session.
+const ModeWeakest = "weakest"
+
+const (
+	ConfigWeakThreshold  = "weak_threshold" // scores below it (0-100) make a question weak
+	DefaultWeakThreshold = 60
+)
+
+// ErrNoWeakQuestions rejects a weakest-mode session when the user has nothing to drill
+var ErrNoWeakQuestions = errors.New("no weak questions to practice")
+
+// IsWeakestMode reports whether a session config selects the weakest-questions mode
+func IsWeakestMode(config map[string]interface{}) bool {
+	mode, _ := config["mode"].(string)
+	return mode == ModeWeakest
+}
+
+// WeakThreshold reads the weak threshold from a session config, defaulting when it is missing or invalid
+func WeakThreshold(config map[string]interface{}) int {
+	if threshold, err := ParseWeakThreshold(config); err == nil {
+		return threshold
+	}
+	return DefaultWeakThreshold
+}
+
+// ParseWeakThreshold reads the weak threshold from a session config; it must be a whole score from 1 to 100
+func ParseWeakThreshold(config map[string]interface{}) (int, error) {
+	raw, ok := config[ConfigWeakThreshold]
+	if !ok || raw == nil {
+		return DefaultWeakThreshold, nil
+	}
+	// JSON numbers decode to float64
+	value, ok := raw.(float64)
+	if !ok || value != float64(int(value)) || value < 1 || value > 100 {
+		return 0, fmt.Errorf("invalid weak_threshold: %v", raw)
+	}
+	return int(value), nil
+}
@@ -14,33 +14,106 @@ type PracticeRepository interface {
 
 	// Attempts
 	CreateAttempt(ctx context.Context, attempt *domain.PracticeAttempt) error
+	GetAttempt(ctx context.Context, id uuid.UUID) (*domain.PracticeAttempt, error)
+
+	// Follow-ups
+	CreateFollowUps(ctx context.Context, followUps []*domain.FollowUpQuestion) error
+	GetFollowUp(ctx context.Context, id uuid.UUID) (*domain.FollowUpQuestion, error)
 
 	// Question sample answer cache
 	GetQuestionSampleCache(ctx context.Context, questionID uuid.UUID) (string, string, []string, string, error) // sampleAnswer, sampleFeedback, sampleSuggestions, sampleSource
 	UpsertQuestionSampleCache(ctx context.Context, questionID uuid.UUID, sampleAnswer, sampleFeedback string, sampleSuggestions []string, sampleSource string) error
 
-	// Helper method to get a random question ID for the session
-	GetRandomQuestionID(ctx context.Context, topicID *uuid.UUID, level *string, language string, config map[string]interface{}) (uuid.UUID, error)
+	// GetRandomQuestionID picks the session's next question from the pool the catalog listed for it
+	GetRandomQuestionID(ctx context.Context, sessionID uuid.UUID, pool []domain.PoolQuestion, level *string, config map[string]interface{}) (uuid.UUID, error)
+	// CountWeakQuestions counts the weak questions in the pool a weakest-mode session has not drawn yet
+	CountWeakQuestions(ctx context.Context, sessionID uuid.UUID, pool []domain.PoolQuestion, config map[string]interface{}) (int, error)
+	// ListSessionBookmarks lists the questions the session user bookmarked, or those in a collection
+	// the user owns or that is shared; other collections list nothing
+	ListSessionBookmarks(ctx context.Context, sessionID uuid.UUID, collectionID string) ([]uuid.UUID, error)
+}
 
-	// Helper to get question content (needed for AI) - in real microservices this might come from Question Service,
-	// but here we have direct DB access for now.
+// QuestionCatalog is the question bank as practice-service uses it: question-service over HTTP,
+// or the shared schema directly when question-service is not configured
+type QuestionCatalog interface {
 	GetQuestionContent(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) // returns content, topic, level, correctAnswer, hint
-	CreateQuestion(ctx context.Context, question *domain.Question) error
+	// GetQuestionInfo returns a question with its language and translation group, or domain.ErrQuestionNotFound
+	GetQuestionInfo(ctx context.Context, questionID uuid.UUID) (*domain.QuestionInfo, error)
+	// GetQuestionInfos looks several questions up at once, keyed by ID; questions that do not exist are left out
+	GetQuestionInfos(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID]*domain.QuestionInfo, error)
+	// ListPool lists the published questions matching a session's filters, with bookmarks already resolved to GroupsOf
+	ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error)
+	// Translations: the question's version in a language, or the question itself when it has none
+	GetTranslationID(ctx context.Context, questionID uuid.UUID, language string) (uuid.UUID, error)
 	GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error)
+	// CreateQuestion stores a draft; near-duplicates are rejected with *domain.DuplicateError
+	// and weaker matches are returned as warnings
+	CreateQuestion(ctx context.Context, question *domain.Question) ([]domain.SimilarQuestion, error)
 }
 
 type AIService interface {
 	EvaluateAnswer(ctx context.Context, question, userAnswer, correctAnswer, topic, level, language string) (int, string, []string, string, error) // score, feedback, suggestions, improvedAnswer
+	GenerateFollowUps(ctx context.Context, question, userAnswer, feedback, topic, level, language string) ([]string, error)
+}
+
+// BookmarkRepository stores bookmarks and the collections grouping them. Adding a question to a
+// collection bookmarks it; removing a bookmark removes it from the owner's collections.
+type BookmarkRepository interface {
+	AddBookmark(ctx context.Context, userID, questionID uuid.UUID) error
+	RemoveBookmark(ctx context.Context, userID, questionID uuid.UUID) error
+	ListBookmarks(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error)
+
+	CreateCollection(ctx context.Context, collection *domain.Collection) error
+	GetCollection(ctx context.Context, id uuid.UUID) (*domain.Collection, error)
+	ListCollections(ctx context.Context, userID uuid.UUID) ([]*domain.Collection, error)
+	UpdateCollection(ctx context.Context, collection *domain.Collection) error
+	DeleteCollection(ctx context.Context, id uuid.UUID) error
+	AddToCollection(ctx context.Context, collectionID, userID, questionID uuid.UUID) error
+	RemoveFromCollection(ctx context.Context, collectionID, questionID uuid.UUID) error
+	ListCollectionQuestions(ctx context.Context, collectionID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error)
+}
+
+type BookmarkService interface {
+	AddBookmark(ctx context.Context, userID, questionID uuid.UUID) error
+	RemoveBookmark(ctx context.Context, userID, questionID uuid.UUID) error
+	ListBookmarks(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error)
+
+	CreateCollection(ctx context.Context, userID uuid.UUID, name, description string, isShared bool) (*domain.Collection, error)
+	// GetCollection returns the collection with a page of its questions, if the user owns it or it is shared
+	GetCollection(ctx context.Context, id, userID uuid.UUID, limit, offset int) (*domain.Collection, error)
+	ListCollections(ctx context.Context, userID uuid.UUID) ([]*domain.Collection, error)
+	UpdateCollection(ctx context.Context, id, userID uuid.UUID, patch domain.CollectionPatch) (*domain.Collection, error)
+	DeleteCollection(ctx context.Context, id, userID uuid.UUID) error
+	AddToCollection(ctx context.Context, id, userID, questionID uuid.UUID) error
+	RemoveFromCollection(ctx context.Context, id, userID, questionID uuid.UUID) error
+}
+
+// AnswerService is answer-service as practice-service uses it: AI-generated sample answers are published
+// there as suggested answers, and attempts are compared with the answers it holds
+type AnswerService interface {
+	PublishSuggestedAnswer(ctx context.Context, answer domain.SuggestedAnswer) error
+	ListAnswers(ctx context.Context, query domain.AnswerQuery) ([]domain.Answer, error)
 }
 
 type PracticeService interface {
 	StartSession(ctx context.Context, userID uuid.UUID, topicID *uuid.UUID, level *string, language string, config map[string]interface{}) (*domain.PracticeSession, uuid.UUID, error)
 	SubmitAnswer(ctx context.Context, sessionID, questionID uuid.UUID, answerContent, language string, aiEnabled bool) (*domain.PracticeAttempt, uuid.UUID, error)
-	SuggestAnswer(ctx context.Context, questionID uuid.UUID, answerContent, language string) (int, string, []string, string, error)
+	SubmitFollowUpAnswer(ctx context.Context, sessionID, followUpID uuid.UUID, answerContent, language string, aiEnabled bool) (*domain.PracticeAttempt, error)
+	SuggestAnswer(ctx context.Context, questionID uuid.UUID, answerContent, language, level string) (int, string, []string, string, error)
 	SkipCurrentRound(ctx context.Context, sessionID uuid.UUID) (uuid.UUID, error)
 	GetSession(ctx context.Context, id uuid.UUID) (*domain.PracticeSession, error)
 	GetQuestion(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) // returns content, topic, level, correctAnswer, hint
+	GetTranslationID(ctx context.Context, questionID uuid.UUID, language string) (uuid.UUID, error)
 	GetRandomQuestion(ctx context.Context, sessionID uuid.UUID, topicName *string) (uuid.UUID, error)
-	CreateQuestion(ctx context.Context, content, topic, level, correctAnswer, hint string) (*domain.Question, error)
+	// CountWeakQuestions reports how many weak questions a weakest-mode session has left
+	CountWeakQuestions(ctx context.Context, sessionID uuid.UUID) (int, error)
+	CreateQuestion(ctx context.Context, content, topic, level, language, correctAnswer, hint string) (*domain.Question, []domain.SimilarQuestion, error)
 	GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error)
+	// CompareAttempt sets an attempt beside the question's canonical answer and top-voted community answers
+	CompareAttempt(ctx context.Context, sessionID, attemptID uuid.UUID, limit int) (*domain.AnswerComparison, error)
+}
+
+// RoleReader reads a user's current role, so role changes apply before the user's token expires
+type RoleReader interface {
+	GetUserRole(ctx context.Context, userID uuid.UUID) (domain.Role, error)
 }
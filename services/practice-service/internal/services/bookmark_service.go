@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+type bookmarkService struct {
+	repo      ports.BookmarkRepository
+	questions ports.QuestionCatalog
+}
+
+func NewBookmarkService(repo ports.BookmarkRepository, questions ports.QuestionCatalog) ports.BookmarkService {
+	return &bookmarkService{repo: repo, questions: questions}
+}
+
+// withQuestions fills bookmarked questions in from the catalog in one lookup, dropping those it no longer has
+func (s *bookmarkService) withQuestions(ctx context.Context, bookmarks []domain.BookmarkedQuestion) ([]domain.BookmarkedQuestion, error) {
+	ids := make([]uuid.UUID, len(bookmarks))
+	for i, b := range bookmarks {
+		ids[i] = b.QuestionID
+	}
+	infos, err := s.questions.GetQuestionInfos(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	questions := make([]domain.BookmarkedQuestion, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		q, ok := infos[b.QuestionID]
+		if !ok {
+			continue
+		}
+		b.Content, b.Topic, b.Level, b.Language = q.Content, q.Topic, q.Level, q.Language
+		questions = append(questions, b)
+	}
+	return questions, nil
+}
+
+// pageBounds applies the default and maximum page size
+func pageBounds(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = 20
+	} else if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// questionPage reads up to limit bookmarks from offset with their questions. A bookmark whose question
+// the catalog no longer has is dropped and the page topped up from the rows after it, so a page is only
+// short at the end of the list.
+func (s *bookmarkService) questionPage(ctx context.Context, limit, offset int, list func(limit, offset int) ([]domain.BookmarkedQuestion, error)) ([]domain.BookmarkedQuestion, error) {
+	page := []domain.BookmarkedQuestion{}
+	for len(page) < limit {
+		want := limit - len(page)
+		bookmarks, err := list(want, offset)
+		if err != nil {
+			return nil, err
+		}
+		questions, err := s.withQuestions(ctx, bookmarks)
+		if err != nil {
+			return nil, err
+		}
+		page = append(page, questions...)
+		if len(bookmarks) < want {
+			break
+		}
+		offset += len(bookmarks)
+	}
+	return page, nil
+}
+
+func (s *bookmarkService) AddBookmark(ctx context.Context, userID, questionID uuid.UUID) error {
+	return s.repo.AddBookmark(ctx, userID, questionID)
+}
+
+func (s *bookmarkService) RemoveBookmark(ctx context.Context, userID, questionID uuid.UUID) error {
+	return s.repo.RemoveBookmark(ctx, userID, questionID)
+}
+
+func (s *bookmarkService) ListBookmarks(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error) {
+	limit, offset = pageBounds(limit, offset)
+	return s.questionPage(ctx, limit, offset, func(limit, offset int) ([]domain.BookmarkedQuestion, error) {
+		return s.repo.ListBookmarks(ctx, userID, limit, offset)
+	})
+}
+
+func (s *bookmarkService) CreateCollection(ctx context.Context, userID uuid.UUID, name, description string, isShared bool) (*domain.Collection, error) {
+	collection := domain.NewCollection(userID, name, description, isShared)
+	if collection.Name == "" {
+		return nil, fmt.Errorf("invalid collection: name is required")
+	}
+	if err := s.repo.CreateCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func (s *bookmarkService) GetCollection(ctx context.Context, id, userID uuid.UUID, limit, offset int) (*domain.Collection, error) {
+	collection, err := s.repo.GetCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Private collections look missing to other users
+	if !collection.CanRead(userID) {
+		return nil, domain.ErrCollectionNotFound
+	}
+	limit, offset = pageBounds(limit, offset)
+	collection.Questions, err = s.questionPage(ctx, limit, offset, func(limit, offset int) ([]domain.BookmarkedQuestion, error) {
+		return s.repo.ListCollectionQuestions(ctx, id, limit, offset)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func (s *bookmarkService) ListCollections(ctx context.Context, userID uuid.UUID) ([]*domain.Collection, error) {
+	return s.repo.ListCollections(ctx, userID)
+}
+
+// ownedCollection loads a collection its owner is about to change
+func (s *bookmarkService) ownedCollection(ctx context.Context, id, userID uuid.UUID) (*domain.Collection, error) {
+	collection, err := s.repo.GetCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if collection.UserID != userID {
+		if collection.IsShared {
+			return nil, domain.ErrCollectionForbidden
+		}
+		return nil, domain.ErrCollectionNotFound
+	}
+	return collection, nil
+}
+
+func (s *bookmarkService) UpdateCollection(ctx context.Context, id, userID uuid.UUID, patch domain.CollectionPatch) (*domain.Collection, error) {
+	collection, err := s.ownedCollection(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if patch.Name != nil {
+		collection.Name = strings.TrimSpace(*patch.Name)
+		if collection.Name == "" {
+			return nil, fmt.Errorf("invalid collection: name is required")
+		}
+	}
+	if patch.Description != nil {
+		collection.Description = strings.TrimSpace(*patch.Description)
+	}
+	if patch.IsShared != nil {
+		collection.IsShared = *patch.IsShared
+	}
+	collection.UpdatedAt = time.Now()
+	if err := s.repo.UpdateCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func (s *bookmarkService) DeleteCollection(ctx context.Context, id, userID uuid.UUID) error {
+	if _, err := s.ownedCollection(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repo.DeleteCollection(ctx, id)
+}
+
+func (s *bookmarkService) AddToCollection(ctx context.Context, id, userID, questionID uuid.UUID) error {
+	if _, err := s.ownedCollection(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repo.AddToCollection(ctx, id, userID, questionID)
+}
+
+func (s *bookmarkService) RemoveFromCollection(ctx context.Context, id, userID, questionID uuid.UUID) error {
+	if _, err := s.ownedCollection(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repo.RemoveFromCollection(ctx, id, questionID)
+}
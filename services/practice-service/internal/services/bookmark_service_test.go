@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/practice-service/internal/domain"
+	"github.com/question-interviewer/practice-service/internal/ports"
+)
+
+type fakeBookmarkRepo struct {
+	bookmarks   map[uuid.UUID]map[uuid.UUID]bool // user → bookmarked questions
+	collections map[uuid.UUID]*domain.Collection
+	items       map[uuid.UUID][]uuid.UUID // collection → questions
+}
+
+func newFakeBookmarkRepo() *fakeBookmarkRepo {
+	return &fakeBookmarkRepo{
+		bookmarks:   make(map[uuid.UUID]map[uuid.UUID]bool),
+		collections: make(map[uuid.UUID]*domain.Collection),
+		items:       make(map[uuid.UUID][]uuid.UUID),
+	}
+}
+
+func (r *fakeBookmarkRepo) AddBookmark(ctx context.Context, userID, questionID uuid.UUID) error {
+	if r.bookmarks[userID] == nil {
+		r.bookmarks[userID] = make(map[uuid.UUID]bool)
+	}
+	r.bookmarks[userID][questionID] = true
+	return nil
+}
+func (r *fakeBookmarkRepo) RemoveBookmark(ctx context.Context, userID, questionID uuid.UUID) error {
+	if !r.bookmarks[userID][questionID] {
+		return fmt.Errorf("bookmark not found")
+	}
+	delete(r.bookmarks[userID], questionID)
+	// Mirrors the cascade from bookmarks to the owner's collection items
+	for id, c := range r.collections {
+		if c.UserID == userID {
+			_ = r.RemoveFromCollection(ctx, id, questionID)
+		}
+	}
+	return nil
+}
+func (r *fakeBookmarkRepo) ListBookmarks(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error) {
+	var out []domain.BookmarkedQuestion
+	for id := range r.bookmarks[userID] {
+		out = append(out, domain.BookmarkedQuestion{QuestionID: id})
+	}
+	return out, nil
+}
+func (r *fakeBookmarkRepo) CreateCollection(ctx context.Context, collection *domain.Collection) error {
+	r.collections[collection.ID] = collection
+	return nil
+}
+func (r *fakeBookmarkRepo) GetCollection(ctx context.Context, id uuid.UUID) (*domain.Collection, error) {
+	c, ok := r.collections[id]
+	if !ok {
+		return nil, domain.ErrCollectionNotFound
+	}
+	copied := *c
+	copied.QuestionCount = len(r.items[id])
+	return &copied, nil
+}
+func (r *fakeBookmarkRepo) ListCollections(ctx context.Context, userID uuid.UUID) ([]*domain.Collection, error) {
+	var out []*domain.Collection
+	for _, c := range r.collections {
+		if c.UserID == userID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+func (r *fakeBookmarkRepo) UpdateCollection(ctx context.Context, collection *domain.Collection) error {
+	r.collections[collection.ID] = collection
+	return nil
+}
+func (r *fakeBookmarkRepo) DeleteCollection(ctx context.Context, id uuid.UUID) error {
+	delete(r.collections, id)
+	delete(r.items, id)
+	return nil
+}
+func (r *fakeBookmarkRepo) AddToCollection(ctx context.Context, collectionID, userID, questionID uuid.UUID) error {
+	_ = r.AddBookmark(ctx, userID, questionID)
+	for _, q := range r.items[collectionID] {
+		if q == questionID {
+			return nil
+		}
+	}
+	r.items[collectionID] = append(r.items[collectionID], questionID)
+	return nil
+}
+func (r *fakeBookmarkRepo) RemoveFromCollection(ctx context.Context, collectionID, questionID uuid.UUID) error {
+	for i, q := range r.items[collectionID] {
+		if q == questionID {
+			r.items[collectionID] = append(r.items[collectionID][:i], r.items[collectionID][i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("question not in collection")
+}
+func (r *fakeBookmarkRepo) ListCollectionQuestions(ctx context.Context, collectionID uuid.UUID, limit, offset int) ([]domain.BookmarkedQuestion, error) {
+	var out []domain.BookmarkedQuestion
+	for _, q := range r.items[collectionID] {
+		out = append(out, domain.BookmarkedQuestion{QuestionID: q})
+	}
+	return out[min(offset, len(out)):min(offset+limit, len(out))], nil
+}
+
+var _ ports.BookmarkRepository = (*fakeBookmarkRepo)(nil)
+
+func TestCollections_OwnedBookmarksSharedForReading(t *testing.T) {
+	repo := newFakeBookmarkRepo()
+	questions := &fakeRepo{questionContent: "What is a mutex?", questionTopic: "Golang"}
+	svc := NewBookmarkService(repo, questions)
+	ctx := context.Background()
+	owner, other, questionID := uuid.New(), uuid.New(), uuid.New()
+
+	collection, err := svc.CreateCollection(ctx, owner, " Concurrency ", "", false)
+	if err != nil || collection.Name != "Concurrency" {
+		t.Fatalf("expected a trimmed collection name, got %+v (%v)", collection, err)
+	}
+	if err := svc.AddToCollection(ctx, collection.ID, owner, questionID); err != nil {
+		t.Fatalf("expected the owner to add a question, got %v", err)
+	}
+	if bookmarks, _ := svc.ListBookmarks(ctx, owner, 0, 0); len(bookmarks) != 1 || bookmarks[0].QuestionID != questionID || bookmarks[0].Content != "What is a mutex?" {
+		t.Fatalf("expected adding to a collection to bookmark the question, got %+v", bookmarks)
+	}
+	// Questions the catalog no longer has drop out of listings
+	deleted := uuid.New()
+	_ = svc.AddBookmark(ctx, owner, deleted)
+	questions.missing = map[uuid.UUID]bool{deleted: true}
+	if bookmarks, err := svc.ListBookmarks(ctx, owner, 0, 0); err != nil || len(bookmarks) != 1 || bookmarks[0].QuestionID != questionID {
+		t.Fatalf("expected the deleted question to be skipped, got %+v (%v)", bookmarks, err)
+	}
+
+	if _, err := svc.GetCollection(ctx, collection.ID, other, 0, 0); !errors.Is(err, domain.ErrCollectionNotFound) {
+		t.Fatalf("expected a private collection to look missing to others, got %v", err)
+	}
+	shared := true
+	if _, err := svc.UpdateCollection(ctx, collection.ID, owner, domain.CollectionPatch{IsShared: &shared}); err != nil {
+		t.Fatalf("expected the owner to share the collection, got %v", err)
+	}
+	read, err := svc.GetCollection(ctx, collection.ID, other, 0, 0)
+	if err != nil || len(read.Questions) != 1 || read.QuestionCount != 1 {
+		t.Fatalf("expected a shared collection to be readable with its questions, got %+v (%v)", read, err)
+	}
+	if err := svc.AddToCollection(ctx, collection.ID, other, uuid.New()); !errors.Is(err, domain.ErrCollectionForbidden) {
+		t.Fatalf("expected others not to change a shared collection, got %v", err)
+	}
+
+	if err := svc.RemoveBookmark(ctx, owner, questionID); err != nil {
+		t.Fatalf("expected the bookmark to be removed, got %v", err)
+	}
+	if read, _ := svc.GetCollection(ctx, collection.ID, owner, 0, 0); len(read.Questions) != 0 {
+		t.Fatalf("expected removing a bookmark to empty the collection, got %+v", read.Questions)
+	}
+}
+
+func TestStartSession_RejectsInvalidCollection(t *testing.T) {
+	repo := &fakeRepo{}
+	svc := NewPracticeService(repo, repo, &fakeAI{}, true, nil)
+	config := map[string]interface{}{domain.ConfigCollectionID: "not-a-uuid"}
+	if _, _, err := svc.StartSession(context.Background(), uuid.New(), nil, nil, "en", config); err == nil {
+		t.Fatal("expected an invalid collection_id to be rejected")
+	}
+}
+
+func TestStartSession_DrawsFromBookmarkedGroups(t *testing.T) {
+	questionID := uuid.New()
+	repo := &fakeRepo{pool: []domain.PoolQuestion{{ID: questionID, TranslationGroupID: questionID, Level: "Mid"}}}
+	svc := NewPracticeService(repo, repo, &fakeAI{}, true, nil)
+	ctx := context.Background()
+	config := map[string]interface{}{domain.ConfigBookmarked: true}
+
+	// Without bookmarks the catalog is not asked and there is nothing to draw
+	if _, _, err := svc.StartSession(ctx, uuid.New(), nil, nil, "vi", config); err == nil || repo.poolFilter != nil {
+		t.Fatalf("expected an empty pool without bookmarks, got %v (filter %+v)", err, repo.poolFilter)
+	}
+
+	repo.bookmarks = []uuid.UUID{uuid.New()}
+	_, first, err := svc.StartSession(ctx, uuid.New(), nil, nil, "vi", config)
+	if err != nil || first != questionID {
+		t.Fatalf("expected the pool's question, got %v (%v)", first, err)
+	}
+	if f := repo.poolFilter; f == nil || f.Language != "vi" || len(f.GroupsOf) != 1 || f.GroupsOf[0] != repo.bookmarks[0] {
+		t.Fatalf("expected the pool limited to the bookmarks' groups, got %+v", f)
+	}
+}
+
+func TestGetCollection_TopsUpPagesPastMissingQuestions(t *testing.T) {
+	repo := newFakeBookmarkRepo()
+	questions := &fakeRepo{questionContent: "What is a channel?"}
+	svc := NewBookmarkService(repo, questions)
+	ctx := context.Background()
+	owner := uuid.New()
+
+	collection, _ := svc.CreateCollection(ctx, owner, "Channels", "", false)
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	for _, id := range ids {
+		_ = svc.AddToCollection(ctx, collection.ID, owner, id)
+	}
+	questions.missing = map[uuid.UUID]bool{ids[1]: true}
+
+	read, err := svc.GetCollection(ctx, collection.ID, owner, 2, 0)
+	if err != nil || len(read.Questions) != 2 || read.Questions[0].QuestionID != ids[0] || read.Questions[1].QuestionID != ids[2] {
+		t.Fatalf("expected the page topped up past the missing question, got %+v (%v)", read, err)
+	}
+	if questions.batchLoads != 2 {
+		t.Fatalf("expected one catalog lookup per read, got %d", questions.batchLoads)
+	}
+	if read, _ := svc.GetCollection(ctx, collection.ID, owner, 2, 3); len(read.Questions) != 1 || read.Questions[0].QuestionID != ids[3] {
+		t.Fatalf("expected a short page only at the end, got %+v", read.Questions)
+	}
+}
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/google/uuid"
@@ -12,15 +14,19 @@ import (
 
 type practiceService struct {
 	repo      ports.PracticeRepository
+	questions ports.QuestionCatalog
 	ai        ports.AIService
 	aiEnabled bool
+	answers   ports.AnswerService // optional; nil keeps AI samples local and compares with the correct answer only
 }
 
-func NewPracticeService(repo ports.PracticeRepository, ai ports.AIService, aiEnabled bool) ports.PracticeService {
+func NewPracticeService(repo ports.PracticeRepository, questions ports.QuestionCatalog, ai ports.AIService, aiEnabled bool, answers ports.AnswerService) ports.PracticeService {
 	return &practiceService{
 		repo:      repo,
+		questions: questions,
 		ai:        ai,
 		aiEnabled: aiEnabled,
+		answers:   answers,
 	}
 }
 
@@ -38,6 +44,20 @@ func (s *practiceService) StartSession(ctx context.Context, userID uuid.UUID, to
 		session.Config = make(map[string]interface{})
 	}
 
+	if domain.IsWeakestMode(session.Config) {
+		if _, err := domain.ParseWeakThreshold(session.Config); err != nil {
+			return nil, uuid.Nil, err
+		}
+	}
+
+	// A collection session only draws from the collection; its access is checked with each question
+	if raw, ok := session.Config[domain.ConfigCollectionID]; ok {
+		id, isString := raw.(string)
+		if _, err := uuid.Parse(id); !isString || err != nil {
+			return nil, uuid.Nil, fmt.Errorf("invalid collection_id: %v", raw)
+		}
+	}
+
 	// 1. Initialize Rounds if in Interview Mode
 	if mode, ok := config["mode"].(string); ok && mode == "interview" {
 		role := "BackEnd" // default
@@ -52,7 +72,7 @@ func (s *practiceService) StartSession(ctx context.Context, userID uuid.UUID, to
 		// Override topicID for the first round
 		if len(rounds) > 0 {
 			firstRound := rounds[0]
-			tID, err := s.repo.GetTopicIDByName(ctx, firstRound)
+			tID, err := s.questions.GetTopicIDByName(ctx, firstRound)
 			if err == nil {
 				topicID = &tID
 			}
@@ -65,8 +85,13 @@ func (s *practiceService) StartSession(ctx context.Context, userID uuid.UUID, to
 	}
 
 	// Get first question
-	questionID, err := s.repo.GetRandomQuestionID(ctx, topicID, level, language, session.Config)
+	questionID, err := s.randomQuestionID(ctx, session.ID, topicID, level, language, session.Config)
 	if err != nil {
+		if domain.IsWeakestMode(session.Config) {
+			if remaining, countErr := s.weakQuestionCount(ctx, session.ID, topicID, language, session.Config); countErr == nil && remaining == 0 {
+				return nil, uuid.Nil, domain.ErrNoWeakQuestions
+			}
+		}
 		// Non-blocking error? No, we need a question to start.
 		// But maybe we return session and empty question ID if none found?
 		// Let's return error for now.
@@ -119,16 +144,18 @@ func (s *practiceService) SubmitAnswer(ctx context.Context, sessionID, questionI
 		return nil, uuid.Nil, fmt.Errorf("session is not in progress")
 	}
 
-	// 2. Get Question Data (Content, Topic, Level, CorrectAnswer)
-	qContent, qTopic, qLevel, qCorrectAnswer, _, err := s.repo.GetQuestionContent(ctx, questionID)
+	// 2. Get Question Data (Content, Topic, Level, CorrectAnswer), in the session language when translated
+	question, err := s.questions.GetQuestionInfo(ctx, s.translationFor(ctx, questionID, session.Language))
 	if err != nil {
 		return nil, uuid.Nil, fmt.Errorf("failed to get question content: %w", err)
 	}
+	qContent, qTopic, qLevel, qCorrectAnswer := question.Content, question.Topic, question.Level, question.CorrectAnswer
 
 	var score int
 	var feedbackText string
 	var suggestions []string
 	var improvedAnswer string
+	evaluated := false
 
 	if aiEnabled && s.aiEnabled {
 		// 3. Call AI Service
@@ -144,6 +171,8 @@ func (s *practiceService) SubmitAnswer(ctx context.Context, sessionID, questionI
 			feedbackText = "AI unavailable."
 			improvedAnswer = qCorrectAnswer
 			suggestions = nil
+		} else {
+			evaluated = true
 		}
 	} else {
 		// No AI: Use database answer
@@ -155,7 +184,9 @@ func (s *practiceService) SubmitAnswer(ctx context.Context, sessionID, questionI
 
 	// 4. Create Attempt
 	attempt := domain.NewPracticeAttempt(sessionID, questionID, answerContent)
+	attempt.TranslationGroupID = question.TranslationGroupID
 	attempt.Score = score
+	attempt.Graded = evaluated
 	attempt.Feedback = feedbackText
 	attempt.Suggestions = suggestions
 	attempt.ImprovedAnswer = improvedAnswer
@@ -164,6 +195,11 @@ func (s *practiceService) SubmitAnswer(ctx context.Context, sessionID, questionI
 		return nil, uuid.Nil, fmt.Errorf("failed to save attempt: %w", err)
 	}
 
+	// 4b. Dig deeper with follow-ups when the session asks for them (requires a real AI evaluation)
+	if evaluated && followUpsEnabled(session.Config) {
+		attempt.FollowUps = s.generateFollowUps(ctx, attempt, qContent, qTopic, qLevel, session.Language)
+	}
+
 	// 5. Update Session Score
 	session.Score += score
 	if err := s.repo.UpdateSession(ctx, session); err != nil {
@@ -198,16 +234,16 @@ func (s *practiceService) SubmitAnswer(ctx context.Context, sessionID, questionI
 			}
 
 			nextTopicName := rounds[nextIdx]
-			tID, err := s.repo.GetTopicIDByName(ctx, nextTopicName)
+			tID, err := s.questions.GetTopicIDByName(ctx, nextTopicName)
 			if err == nil {
 				// Use the specific topic ID for this round
-				nextQuestionID, err = s.repo.GetRandomQuestionID(ctx, &tID, session.Level, session.Language, session.Config)
+				nextQuestionID, err = s.randomQuestionID(ctx, session.ID, &tID, session.Level, session.Language, session.Config)
 				if err != nil {
 					nextQuestionID = uuid.Nil
 				}
 			} else {
 				// Topic not found? Fallback to random without specific topic
-				nextQuestionID, _ = s.repo.GetRandomQuestionID(ctx, nil, session.Level, session.Language, session.Config)
+				nextQuestionID, _ = s.randomQuestionID(ctx, session.ID, nil, session.Level, session.Language, session.Config)
 			}
 		} else {
 			// Finished all rounds
@@ -215,7 +251,7 @@ func (s *practiceService) SubmitAnswer(ctx context.Context, sessionID, questionI
 		}
 	} else {
 		// Normal Practice Mode
-		nextQuestionID, err = s.repo.GetRandomQuestionID(ctx, session.TopicID, session.Level, session.Language, session.Config)
+		nextQuestionID, err = s.randomQuestionID(ctx, session.ID, session.TopicID, session.Level, session.Language, session.Config)
 		if err != nil {
 			nextQuestionID = uuid.Nil
 		}
@@ -224,21 +260,157 @@ func (s *practiceService) SubmitAnswer(ctx context.Context, sessionID, questionI
 	return attempt, nextQuestionID, nil
 }
 
-func (s *practiceService) SuggestAnswer(ctx context.Context, questionID uuid.UUID, answerContent, language string) (int, string, []string, string, error) {
-	qContent, qTopic, qLevel, qCorrectAnswer, _, err := s.repo.GetQuestionContent(ctx, questionID)
+// maxFollowUps caps how many probing questions a single attempt can spawn.
+const maxFollowUps = 2
+
+func followUpsEnabled(config map[string]interface{}) bool {
+	enabled, _ := config["follow_ups"].(bool)
+	return enabled
+}
+
+// generateFollowUps asks the AI for probing questions about an attempt and stores them in the session.
+// Failures are non-blocking: the candidate simply moves on to the next question.
+func (s *practiceService) generateFollowUps(ctx context.Context, attempt *domain.PracticeAttempt, qContent, qTopic, qLevel, language string) []*domain.FollowUpQuestion {
+	contents, err := s.ai.GenerateFollowUps(ctx, qContent, attempt.UserAnswer, attempt.Feedback, qTopic, qLevel, language)
 	if err != nil {
-		return 0, "", nil, "", fmt.Errorf("failed to get question content: %w", err)
+		fmt.Printf("Failed to generate follow-ups: %v\n", err)
+		return nil
+	}
+
+	followUps := []*domain.FollowUpQuestion{}
+	for _, content := range contents {
+		content = strings.TrimSpace(content)
+		if content == "" {
+			continue
+		}
+		followUps = append(followUps, domain.NewFollowUpQuestion(attempt, content))
+		if len(followUps) == maxFollowUps {
+			break
+		}
+	}
+	if len(followUps) == 0 {
+		return nil
+	}
+
+	if err := s.repo.CreateFollowUps(ctx, followUps); err != nil {
+		fmt.Printf("Failed to save follow-ups: %v\n", err)
+		return nil
+	}
+	return followUps
+}
+
+func (s *practiceService) SubmitFollowUpAnswer(ctx context.Context, sessionID, followUpID uuid.UUID, answerContent, language string, aiEnabled bool) (*domain.PracticeAttempt, error) {
+	// 1. Verify session exists
+	session, err := s.repo.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("session not found: %w", err)
+	}
+
+	if session.Status != "in_progress" {
+		return nil, fmt.Errorf("session is not in progress")
+	}
+
+	// 2. Verify the follow-up belongs to this session
+	followUp, err := s.repo.GetFollowUp(ctx, followUpID)
+	if err != nil {
+		return nil, err
+	}
+	if followUp.SessionID != session.ID {
+		return nil, fmt.Errorf("follow-up not found")
+	}
+	if followUp.Answered {
+		return nil, domain.ErrFollowUpAnswered
+	}
+
+	// 3. Topic and level come from the bank question the follow-up was generated from
+	question, err := s.questions.GetQuestionInfo(ctx, followUp.QuestionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question content: %w", err)
+	}
+	qTopic, qLevel := question.Topic, question.Level
+
+	var score int
+	var feedbackText string
+	var suggestions []string
+	var improvedAnswer string
+	evaluated := false
+
+	if aiEnabled && s.aiEnabled {
+		evalLanguage := language
+		if session.Language != "" {
+			evalLanguage = session.Language
+		}
+
+		// Follow-ups have no reference answer; the AI grades from its own knowledge
+		score, feedbackText, suggestions, improvedAnswer, err = s.ai.EvaluateAnswer(ctx, followUp.Content, answerContent, "", qTopic, qLevel, evalLanguage)
+		if err != nil {
+			score = 0
+			feedbackText = "AI unavailable."
+			improvedAnswer = ""
+			suggestions = nil
+		} else {
+			evaluated = true
+		}
+	} else {
+		score = 0 // Not graded
+		feedbackText = "Follow-up answer recorded (AI disabled)."
 	}
 
+	// 4. Create Attempt linked to its parent
+	attempt := domain.NewPracticeAttempt(sessionID, followUp.QuestionID, answerContent)
+	attempt.TranslationGroupID = question.TranslationGroupID
+	attempt.ParentAttemptID = &followUp.ParentAttemptID
+	attempt.FollowUpID = &followUp.ID
+	attempt.Score = score
+	attempt.Graded = evaluated
+	attempt.Feedback = feedbackText
+	attempt.Suggestions = suggestions
+	attempt.ImprovedAnswer = improvedAnswer
+
+	if err := s.repo.CreateAttempt(ctx, attempt); err != nil {
+		if errors.Is(err, domain.ErrFollowUpAnswered) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save attempt: %w", err)
+	}
+
+	// 5. Update Session Score
+	session.Score += score
+	if err := s.repo.UpdateSession(ctx, session); err != nil {
+		// Non-critical error
+		fmt.Printf("Failed to update session score: %v\n", err)
+	}
+
+	return attempt, nil
+}
+
+// SuggestAnswer grades an answer or returns a sample answer. When the question has a translation in the
+// requested language, its content, correct answer and cached sample are used instead. A sample is a
+// human canonical answer written for level (the session level, or the question's when empty) if there
+// is one, before any AI sample.
+func (s *practiceService) SuggestAnswer(ctx context.Context, questionID uuid.UUID, answerContent, language, level string) (int, string, []string, string, error) {
 	evalLanguage := language
 	if evalLanguage == "" {
 		evalLanguage = "vi"
 	}
+	questionID = s.translationFor(ctx, questionID, language)
+
+	qContent, qTopic, qLevel, qCorrectAnswer, _, err := s.questions.GetQuestionContent(ctx, questionID)
+	if err != nil {
+		return 0, "", nil, "", fmt.Errorf("failed to get question content: %w", err)
+	}
 
 	userAnswer := strings.TrimSpace(answerContent)
 	requestingSample := userAnswer == ""
 
 	if requestingSample {
+		if level == "" {
+			level = qLevel
+		}
+		if canonical, ok := s.writtenCanonicalAnswer(ctx, questionID, level); ok {
+			return 0, "", nil, canonical, nil
+		}
+
 		sampleAnswer, sampleFeedback, sampleSuggestions, sampleSource, err := s.repo.GetQuestionSampleCache(ctx, questionID)
 		if err == nil && strings.TrimSpace(sampleAnswer) != "" && strings.TrimSpace(sampleSource) == "ai" {
 			return 0, sampleFeedback, sampleSuggestions, sampleAnswer, nil
@@ -267,17 +439,60 @@ func (s *practiceService) SuggestAnswer(ctx context.Context, questionID uuid.UUI
 		return 0, "", nil, qCorrectAnswer, nil
 	}
 
-	if strings.TrimSpace(improvedAnswer) == "" {
+	generated := strings.TrimSpace(improvedAnswer) != ""
+	if !generated {
 		improvedAnswer = qCorrectAnswer
 	}
 
 	if requestingSample {
 		_ = s.repo.UpsertQuestionSampleCache(ctx, questionID, improvedAnswer, feedback, suggestions, "ai")
+		if generated {
+			s.publishSuggestedAnswer(ctx, questionID, improvedAnswer, qLevel)
+		}
 	}
 
 	return score, feedback, suggestions, improvedAnswer, nil
 }
 
+// canonicalCandidates caps how many canonical answers are read to find a human-written one
+const canonicalCandidates = 5
+
+// writtenCanonicalAnswer returns the best human-written canonical answer answer-service has for a level.
+// Generated answers promoted to canonical have no author and are skipped, since the AI sample serves them.
+func (s *practiceService) writtenCanonicalAnswer(ctx context.Context, questionID uuid.UUID, level string) (string, bool) {
+	if s.answers == nil || strings.TrimSpace(level) == "" {
+		return "", false
+	}
+	answers, err := s.answers.ListAnswers(ctx, domain.AnswerQuery{QuestionID: questionID, AnswerType: "canonical", LevelTarget: level, Limit: canonicalCandidates})
+	if err != nil {
+		log.Printf("failed to list canonical answers for question %s: %v", questionID, err)
+		return "", false
+	}
+	for _, answer := range answers {
+		if answer.AuthorID != uuid.Nil && strings.TrimSpace(answer.Content) != "" {
+			return answer.Content, true
+		}
+	}
+	return "", false
+}
+
+// publishSuggestedAnswer shares a freshly generated sample with answer-service, targeted at the
+// question's level the AI wrote it for. Failures only cost the shared copy, so they are logged.
+func (s *practiceService) publishSuggestedAnswer(ctx context.Context, questionID uuid.UUID, content, qLevel string) {
+	if s.answers == nil {
+		return
+	}
+	err := s.answers.PublishSuggestedAnswer(ctx, domain.SuggestedAnswer{
+		QuestionID:  questionID,
+		Content:     content,
+		LevelTarget: qLevel,
+		Attribution: domain.SuggestedAnswerAttribution,
+	})
+	if err != nil {
+		log.Printf("failed to publish suggested answer for question %s: %v", questionID, err)
+	}
+}
+
 func (s *practiceService) SkipCurrentRound(ctx context.Context, sessionID uuid.UUID) (uuid.UUID, error) {
 	// 1. Verify session exists
 	session, err := s.repo.GetSession(ctx, sessionID)
@@ -315,16 +530,16 @@ func (s *practiceService) SkipCurrentRound(ctx context.Context, sessionID uuid.U
 			}
 
 			nextTopicName := rounds[nextIdx]
-			tID, err := s.repo.GetTopicIDByName(ctx, nextTopicName)
+			tID, err := s.questions.GetTopicIDByName(ctx, nextTopicName)
 			if err == nil {
 				// Use the specific topic ID for this round
-				nextQuestionID, err = s.repo.GetRandomQuestionID(ctx, &tID, session.Level, session.Language, session.Config)
+				nextQuestionID, err = s.randomQuestionID(ctx, session.ID, &tID, session.Level, session.Language, session.Config)
 				if err != nil {
 					nextQuestionID = uuid.Nil
 				}
 			} else {
 				// Topic not found? Fallback to random without specific topic
-				nextQuestionID, _ = s.repo.GetRandomQuestionID(ctx, nil, session.Level, session.Language, session.Config)
+				nextQuestionID, _ = s.randomQuestionID(ctx, session.ID, nil, session.Level, session.Language, session.Config)
 			}
 		} else {
 			// Finished all rounds
@@ -332,7 +547,7 @@ func (s *practiceService) SkipCurrentRound(ctx context.Context, sessionID uuid.U
 		}
 	} else {
 		// Normal Practice Mode: Just get another question
-		nextQuestionID, err = s.repo.GetRandomQuestionID(ctx, session.TopicID, session.Level, session.Language, session.Config)
+		nextQuestionID, err = s.randomQuestionID(ctx, session.ID, session.TopicID, session.Level, session.Language, session.Config)
 		if err != nil {
 			nextQuestionID = uuid.Nil
 		}
@@ -352,7 +567,7 @@ func (s *practiceService) GetRandomQuestion(ctx context.Context, sessionID uuid.
 
 	// 2. Resolve TopicName if provided
 	if topicName != nil && *topicName != "" {
-		tID, err := s.repo.GetTopicIDByName(ctx, *topicName)
+		tID, err := s.questions.GetTopicIDByName(ctx, *topicName)
 		if err != nil {
 			return uuid.Nil, fmt.Errorf("topic not found: %w", err)
 		}
@@ -363,7 +578,7 @@ func (s *practiceService) GetRandomQuestion(ctx context.Context, sessionID uuid.
 	}
 
 	// 3. Get Random Question
-	id, err := s.repo.GetRandomQuestionID(ctx, topicID, session.Level, session.Language, session.Config)
+	id, err := s.randomQuestionID(ctx, session.ID, topicID, session.Level, session.Language, session.Config)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to get random question: %w", err)
 	}
@@ -371,40 +586,181 @@ func (s *practiceService) GetRandomQuestion(ctx context.Context, sessionID uuid.
 	return id, nil
 }
 
+// maxComparedAnswers caps how many community answers an attempt is compared with
+const maxComparedAnswers = 10
+
+// CompareAttempt sets an attempt beside the question's canonical answer, preferring one written for the
+// session level, and its top-voted community answers. The question's correct answer stands in for a
+// missing canonical answer; answer-service failures are logged and leave only that.
+func (s *practiceService) CompareAttempt(ctx context.Context, sessionID, attemptID uuid.UUID, limit int) (*domain.AnswerComparison, error) {
+	attempt, err := s.repo.GetAttempt(ctx, attemptID)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.SessionID != sessionID {
+		return nil, fmt.Errorf("attempt not found")
+	}
+	if attempt.FollowUpID != nil {
+		return nil, fmt.Errorf("invalid attempt: follow-up answers have no reference answers")
+	}
+	if limit <= 0 {
+		limit = 3
+	} else if limit > maxComparedAnswers {
+		limit = maxComparedAnswers
+	}
+
+	_, _, qLevel, qCorrectAnswer, _, err := s.questions.GetQuestionContent(ctx, attempt.QuestionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question content: %w", err)
+	}
+	level := qLevel
+	if session, err := s.repo.GetSession(ctx, sessionID); err == nil && session.Level != nil && *session.Level != "" {
+		level = *session.Level
+	}
+
+	comparison := &domain.AnswerComparison{
+		AttemptID:  attempt.ID,
+		QuestionID: attempt.QuestionID,
+		UserAnswer: attempt.UserAnswer,
+		Community:  []domain.ComparedAnswer{},
+	}
+	if s.answers != nil {
+		community, err := s.answers.ListAnswers(ctx, domain.AnswerQuery{QuestionID: attempt.QuestionID, AnswerType: "community", Limit: limit})
+		if err != nil {
+			log.Printf("failed to list community answers for question %s: %v", attempt.QuestionID, err)
+		}
+		for _, answer := range community {
+			comparison.Community = append(comparison.Community, domain.NewComparedAnswer(answer, domain.ComparedCommunity, attempt.UserAnswer))
+		}
+		if canonical, ok := s.canonicalAnswer(ctx, attempt.QuestionID, level); ok {
+			compared := domain.NewComparedAnswer(canonical, domain.ComparedCanonical, attempt.UserAnswer)
+			comparison.Canonical = &compared
+		}
+	}
+	if comparison.Canonical == nil && strings.TrimSpace(qCorrectAnswer) != "" {
+		comparison.Canonical = &domain.ComparedAnswer{
+			Source:     domain.ComparedQuestion,
+			Content:    qCorrectAnswer,
+			Similarity: domain.AnswerSimilarity(attempt.UserAnswer, qCorrectAnswer),
+		}
+	}
+	return comparison, nil
+}
+
+// canonicalAnswer finds the best canonical answer in answer-service, written for the level if one is.
+// answer-service maps the level to its level target and rejects levels it has none for.
+func (s *practiceService) canonicalAnswer(ctx context.Context, questionID uuid.UUID, level string) (domain.Answer, bool) {
+	levels := []string{""}
+	if strings.TrimSpace(level) != "" {
+		levels = []string{level, ""}
+	}
+	for _, l := range levels {
+		answers, err := s.answers.ListAnswers(ctx, domain.AnswerQuery{QuestionID: questionID, AnswerType: "canonical", LevelTarget: l, Limit: 1})
+		if err != nil {
+			log.Printf("failed to get canonical answer for question %s: %v", questionID, err)
+			if l != "" {
+				continue
+			}
+			return domain.Answer{}, false
+		}
+		if len(answers) > 0 {
+			return answers[0], true
+		}
+	}
+	return domain.Answer{}, false
+}
+
+func (s *practiceService) CountWeakQuestions(ctx context.Context, sessionID uuid.UUID) (int, error) {
+	session, err := s.repo.GetSession(ctx, sessionID)
+	if err != nil {
+		return 0, err
+	}
+	if !domain.IsWeakestMode(session.Config) {
+		return 0, fmt.Errorf("invalid mode: not a weakest-questions session")
+	}
+	return s.weakQuestionCount(ctx, session.ID, session.TopicID, session.Language, session.Config)
+}
+
+// questionPool lists the questions a session can draw, from question-service's catalog. Bookmark sessions
+// draw only the translation groups of the session user's bookmarks, so no bookmarks means an empty pool.
+func (s *practiceService) questionPool(ctx context.Context, sessionID uuid.UUID, topicID *uuid.UUID, language string, config map[string]interface{}) ([]domain.PoolQuestion, error) {
+	filter := domain.NewPoolFilter(topicID, language, config)
+	if filter.FromBookmarks() {
+		bookmarks, err := s.repo.ListSessionBookmarks(ctx, sessionID, filter.CollectionID)
+		if err != nil {
+			return nil, err
+		}
+		if len(bookmarks) == 0 {
+			return nil, nil
+		}
+		filter.GroupsOf = bookmarks
+	}
+	return s.questions.ListPool(ctx, filter)
+}
+
+// randomQuestionID draws a session's next question from its pool
+func (s *practiceService) randomQuestionID(ctx context.Context, sessionID uuid.UUID, topicID *uuid.UUID, level *string, language string, config map[string]interface{}) (uuid.UUID, error) {
+	pool, err := s.questionPool(ctx, sessionID, topicID, language, config)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get random question: %w", err)
+	}
+	return s.repo.GetRandomQuestionID(ctx, sessionID, pool, level, config)
+}
+
+// weakQuestionCount counts the weak questions left in a weakest-mode session's pool
+func (s *practiceService) weakQuestionCount(ctx context.Context, sessionID uuid.UUID, topicID *uuid.UUID, language string, config map[string]interface{}) (int, error) {
+	pool, err := s.questionPool(ctx, sessionID, topicID, language, config)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count weak questions: %w", err)
+	}
+	return s.repo.CountWeakQuestions(ctx, sessionID, pool, config)
+}
+
 func (s *practiceService) GetSession(ctx context.Context, id uuid.UUID) (*domain.PracticeSession, error) {
 	return s.repo.GetSession(ctx, id)
 }
 
 func (s *practiceService) GetQuestion(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) {
-	return s.repo.GetQuestionContent(ctx, questionID)
+	return s.questions.GetQuestionContent(ctx, questionID)
 }
 
-func (s *practiceService) GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error) {
-	return s.repo.GetTopicIDByName(ctx, name)
+// GetTranslationID returns the question's version in language, or the question itself when it has none
+func (s *practiceService) GetTranslationID(ctx context.Context, questionID uuid.UUID, language string) (uuid.UUID, error) {
+	return s.questions.GetTranslationID(ctx, questionID, language)
 }
 
-func (s *practiceService) CreateQuestion(ctx context.Context, content, topic, level, correctAnswer, hint string) (*domain.Question, error) {
-	question := domain.NewQuestion(content, topic, level)
-	question.CorrectAnswer = correctAnswer
-	question.Hint = hint
+// translationFor is GetTranslationID for callers that fall back to the original question on any error
+func (s *practiceService) translationFor(ctx context.Context, questionID uuid.UUID, language string) uuid.UUID {
+	if language == "" {
+		return questionID
+	}
+	id, err := s.questions.GetTranslationID(ctx, questionID, language)
+	if err != nil {
+		return questionID
+	}
+	return id
+}
 
-	// We need to resolve Topic ID if possible, but for now NewQuestion just sets TopicName.
-	// The repo implementation of CreateQuestion should handle the TopicName -> TopicID mapping or insertion if needed.
-	// However, looking at repo interface, it takes *domain.Question.
+func (s *practiceService) GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error) {
+	return s.questions.GetTopicIDByName(ctx, name)
+}
 
-	// Let's check if we can resolve topic ID here
-	if topic != "" {
-		topicID, err := s.repo.GetTopicIDByName(ctx, topic)
-		if err == nil {
-			question.TopicID = &topicID
+// CreateQuestion stores a draft question; the catalog rejects near-duplicates and
+// returns weaker matches alongside the created question as warnings
+func (s *practiceService) CreateQuestion(ctx context.Context, content, topic, level, language, correctAnswer, hint string) (*domain.Question, []domain.SimilarQuestion, error) {
+	question := domain.NewQuestion(content, topic, level)
+	if language != "" {
+		if language != "en" && language != "vi" {
+			return nil, nil, fmt.Errorf("invalid question: language must be en or vi")
 		}
-		// If error (topic not found), we might want to create it or just leave it nil/fail?
-		// For now, let's assume the repo handles it or we proceed with just TopicName if the repo supports it.
-		// But domain.Question struct has TopicID *uuid.UUID.
+		question.Language = language
 	}
+	question.CorrectAnswer = correctAnswer
+	question.Hint = hint
 
-	if err := s.repo.CreateQuestion(ctx, question); err != nil {
-		return nil, fmt.Errorf("failed to create question: %w", err)
+	matches, err := s.questions.CreateQuestion(ctx, question)
+	if err != nil {
+		return nil, nil, err
 	}
-	return question, nil
+	return question, matches, nil
 }
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -16,34 +17,140 @@ type fakeRepo struct {
 	questionLevel   string
 	correctAnswer   string
 	hint            string
+
+	session   *domain.PracticeSession
+	attempts  []*domain.PracticeAttempt
+	followUps map[uuid.UUID]*domain.FollowUpQuestion
+
+	translations  map[uuid.UUID]map[string]uuid.UUID // question ID → language → translated question ID
+	missing       map[uuid.UUID]bool                 // questions GetQuestionInfo reports not found
+	batchLoads    int                                // GetQuestionInfos calls
+	contentLoaded []uuid.UUID
+
+	sampleAnswer string // cached AI sample
+
+	weakCount int // weak questions left for CountWeakQuestions
+
+	pool       []domain.PoolQuestion // what ListPool returns
+	poolFilter *domain.PoolFilter    // the last filter ListPool was asked for
+	bookmarks  []uuid.UUID           // what ListSessionBookmarks returns
+
+	created    []*domain.Question       // questions CreateQuestion stored
+	duplicates []domain.SimilarQuestion // weaker matches CreateQuestion warns about
+	createErr  error
 }
 
 func (r *fakeRepo) CreateSession(ctx context.Context, session *domain.PracticeSession) error {
 	return nil
 }
 func (r *fakeRepo) GetSession(ctx context.Context, id uuid.UUID) (*domain.PracticeSession, error) {
+	if r.session != nil && r.session.ID == id {
+		return r.session, nil
+	}
 	return nil, errors.New("not implemented")
 }
 func (r *fakeRepo) UpdateSession(ctx context.Context, session *domain.PracticeSession) error {
 	return nil
 }
 func (r *fakeRepo) CreateAttempt(ctx context.Context, attempt *domain.PracticeAttempt) error {
+	r.attempts = append(r.attempts, attempt)
+	if attempt.FollowUpID != nil {
+		r.followUps[*attempt.FollowUpID].Answered = true
+	}
+	return nil
+}
+func (r *fakeRepo) GetAttempt(ctx context.Context, id uuid.UUID) (*domain.PracticeAttempt, error) {
+	for _, a := range r.attempts {
+		if a.ID == id {
+			return a, nil
+		}
+	}
+	return nil, errors.New("attempt not found")
+}
+func (r *fakeRepo) CreateFollowUps(ctx context.Context, followUps []*domain.FollowUpQuestion) error {
+	if r.followUps == nil {
+		r.followUps = make(map[uuid.UUID]*domain.FollowUpQuestion)
+	}
+	for _, f := range followUps {
+		r.followUps[f.ID] = f
+	}
 	return nil
 }
+func (r *fakeRepo) GetFollowUp(ctx context.Context, id uuid.UUID) (*domain.FollowUpQuestion, error) {
+	if f, ok := r.followUps[id]; ok {
+		return f, nil
+	}
+	return nil, errors.New("follow-up not found")
+}
 func (r *fakeRepo) GetQuestionSampleCache(ctx context.Context, questionID uuid.UUID) (string, string, []string, string, error) {
+	if r.sampleAnswer != "" {
+		return r.sampleAnswer, "", nil, "ai", nil
+	}
 	return "", "", nil, "", nil
 }
 func (r *fakeRepo) UpsertQuestionSampleCache(ctx context.Context, questionID uuid.UUID, sampleAnswer, sampleFeedback string, sampleSuggestions []string, sampleSource string) error {
 	return nil
 }
-func (r *fakeRepo) GetRandomQuestionID(ctx context.Context, topicID *uuid.UUID, level *string, language string, config map[string]interface{}) (uuid.UUID, error) {
-	return uuid.Nil, errors.New("not implemented")
+func (r *fakeRepo) GetRandomQuestionID(ctx context.Context, sessionID uuid.UUID, pool []domain.PoolQuestion, level *string, config map[string]interface{}) (uuid.UUID, error) {
+	if len(pool) == 0 {
+		return uuid.Nil, errors.New("no question found")
+	}
+	return pool[0].ID, nil
+}
+func (r *fakeRepo) CountWeakQuestions(ctx context.Context, sessionID uuid.UUID, pool []domain.PoolQuestion, config map[string]interface{}) (int, error) {
+	return r.weakCount, nil
+}
+func (r *fakeRepo) ListSessionBookmarks(ctx context.Context, sessionID uuid.UUID, collectionID string) ([]uuid.UUID, error) {
+	return r.bookmarks, nil
 }
 func (r *fakeRepo) GetQuestionContent(ctx context.Context, questionID uuid.UUID) (string, string, string, string, string, error) {
-	return r.questionContent, r.questionTopic, r.questionLevel, r.correctAnswer, r.hint, nil
+	q, err := r.GetQuestionInfo(ctx, questionID)
+	if err != nil {
+		return "", "", "", "", "", err
+	}
+	return q.Content, q.Topic, q.Level, q.CorrectAnswer, q.Hint, nil
+}
+func (r *fakeRepo) GetQuestionInfo(ctx context.Context, questionID uuid.UUID) (*domain.QuestionInfo, error) {
+	r.contentLoaded = append(r.contentLoaded, questionID)
+	if r.missing[questionID] {
+		return nil, domain.ErrQuestionNotFound
+	}
+	return &domain.QuestionInfo{
+		ID:                 questionID,
+		TranslationGroupID: questionID,
+		Content:            r.questionContent,
+		Topic:              r.questionTopic,
+		Level:              r.questionLevel,
+		CorrectAnswer:      r.correctAnswer,
+		Hint:               r.hint,
+	}, nil
+}
+func (r *fakeRepo) GetQuestionInfos(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID]*domain.QuestionInfo, error) {
+	r.batchLoads++
+	infos := make(map[uuid.UUID]*domain.QuestionInfo, len(questionIDs))
+	for _, id := range questionIDs {
+		if q, err := r.GetQuestionInfo(ctx, id); err == nil {
+			infos[id] = q
+		}
+	}
+	return infos, nil
 }
-func (r *fakeRepo) CreateQuestion(ctx context.Context, question *domain.Question) error {
-	return errors.New("not implemented")
+func (r *fakeRepo) ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error) {
+	r.poolFilter = &filter
+	return r.pool, nil
+}
+func (r *fakeRepo) GetTranslationID(ctx context.Context, questionID uuid.UUID, language string) (uuid.UUID, error) {
+	if id, ok := r.translations[questionID][language]; ok {
+		return id, nil
+	}
+	return questionID, nil
+}
+func (r *fakeRepo) CreateQuestion(ctx context.Context, question *domain.Question) ([]domain.SimilarQuestion, error) {
+	if r.createErr != nil {
+		return nil, r.createErr
+	}
+	r.created = append(r.created, question)
+	return r.duplicates, nil
 }
 func (r *fakeRepo) GetTopicIDByName(ctx context.Context, name string) (uuid.UUID, error) {
 	return uuid.Nil, errors.New("not implemented")
@@ -54,6 +161,7 @@ type fakeAI struct {
 	feedback       string
 	suggestions    []string
 	improvedAnswer string
+	followUps      []string
 	err            error
 }
 
@@ -64,8 +172,47 @@ func (a *fakeAI) EvaluateAnswer(ctx context.Context, question, userAnswer, corre
 	return a.score, a.feedback, a.suggestions, a.improvedAnswer, nil
 }
 
+func (a *fakeAI) GenerateFollowUps(ctx context.Context, question, userAnswer, feedback, topic, level, language string) ([]string, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	return a.followUps, nil
+}
+
+type fakeAnswers struct {
+	published []domain.SuggestedAnswer
+	answers   []domain.Answer // best voted first
+	err       error
+}
+
+func (p *fakeAnswers) PublishSuggestedAnswer(ctx context.Context, answer domain.SuggestedAnswer) error {
+	p.published = append(p.published, answer)
+	return p.err
+}
+
+func (p *fakeAnswers) ListAnswers(ctx context.Context, query domain.AnswerQuery) ([]domain.Answer, error) {
+	var out []domain.Answer
+	for _, a := range p.answers {
+		if a.QuestionID == query.QuestionID && (query.AnswerType == "" || a.AnswerType == query.AnswerType) &&
+			(query.LevelTarget == "" || a.LevelTarget == fakeLevelTarget(query.LevelTarget)) && (query.Limit == 0 || len(out) < query.Limit) {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// fakeLevelTarget maps levels the way answer-service does, so the fake filters like the real one
+func fakeLevelTarget(level string) string {
+	if level = strings.ToLower(level); level == "fresher" {
+		return "junior"
+	}
+	return level
+}
+
 var _ ports.PracticeRepository = (*fakeRepo)(nil)
+var _ ports.QuestionCatalog = (*fakeRepo)(nil)
 var _ ports.AIService = (*fakeAI)(nil)
+var _ ports.AnswerService = (*fakeAnswers)(nil)
 
 func TestSuggestAnswer_FallbackWhenAIUnavailable(t *testing.T) {
 	repo := &fakeRepo{
@@ -76,9 +223,9 @@ func TestSuggestAnswer_FallbackWhenAIUnavailable(t *testing.T) {
 		hint:            "Focus on purpose.",
 	}
 	ai := &fakeAI{err: errors.New("ai down")}
-	svc := NewPracticeService(repo, ai, true)
+	svc := NewPracticeService(repo, repo, ai, true, nil)
 
-	score, feedback, suggestions, improved, err := svc.SuggestAnswer(context.Background(), uuid.New(), "", "vi")
+	score, feedback, suggestions, improved, err := svc.SuggestAnswer(context.Background(), uuid.New(), "", "vi", "")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -95,3 +242,269 @@ func TestSuggestAnswer_FallbackWhenAIUnavailable(t *testing.T) {
 		t.Fatalf("expected empty feedback on fallback")
 	}
 }
+
+func TestSubmitAnswer_GeneratesFollowUpsLinkedToParent(t *testing.T) {
+	session := domain.NewPracticeSession(uuid.New())
+	session.Language = "en"
+	session.Config["follow_ups"] = true
+	repo := &fakeRepo{
+		questionContent: "What is a goroutine?",
+		questionTopic:   "Golang",
+		questionLevel:   "Junior",
+		session:         session,
+	}
+	ai := &fakeAI{
+		score:     70,
+		feedback:  "Good start.",
+		followUps: []string{"How are goroutines scheduled?", " ", "What happens when one blocks?", "Extra question"},
+	}
+	svc := NewPracticeService(repo, repo, ai, true, nil)
+
+	questionID := uuid.New()
+	attempt, _, err := svc.SubmitAnswer(context.Background(), session.ID, questionID, "A lightweight thread.", "en", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(attempt.FollowUps) != maxFollowUps {
+		t.Fatalf("expected %d follow-ups, got %d", maxFollowUps, len(attempt.FollowUps))
+	}
+
+	followUp := attempt.FollowUps[0]
+	if followUp.ParentAttemptID != attempt.ID || followUp.QuestionID != questionID {
+		t.Fatalf("expected follow-up linked to parent attempt and question")
+	}
+
+	ai.score = 50
+	child, err := svc.SubmitFollowUpAnswer(context.Background(), session.ID, followUp.ID, "The Go runtime multiplexes them.", "en", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if child.ParentAttemptID == nil || *child.ParentAttemptID != attempt.ID {
+		t.Fatalf("expected follow-up attempt linked to parent attempt")
+	}
+	if session.Score != 120 {
+		t.Fatalf("expected follow-up to be scored like any attempt, got session score %d", session.Score)
+	}
+
+	_, err = svc.SubmitFollowUpAnswer(context.Background(), session.ID, followUp.ID, "Again.", "en", true)
+	if !errors.Is(err, domain.ErrFollowUpAnswered) {
+		t.Fatalf("expected a repeated follow-up answer to be rejected, got %v", err)
+	}
+	if session.Score != 120 {
+		t.Fatalf("expected a rejected answer to leave the score alone, got %d", session.Score)
+	}
+}
+
+func TestSubmitAnswer_NoFollowUpsUnlessEnabled(t *testing.T) {
+	session := domain.NewPracticeSession(uuid.New())
+	repo := &fakeRepo{session: session}
+	ai := &fakeAI{score: 80, followUps: []string{"Why?"}}
+	svc := NewPracticeService(repo, repo, ai, true, nil)
+
+	attempt, _, err := svc.SubmitAnswer(context.Background(), session.ID, uuid.New(), "Answer", "en", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if attempt.FollowUps != nil {
+		t.Fatalf("expected no follow-ups when not enabled in session config")
+	}
+}
+
+func TestSubmitAnswer_MarksUngradedAttempts(t *testing.T) {
+	session := domain.NewPracticeSession(uuid.New())
+	repo := &fakeRepo{session: session}
+	ai := &fakeAI{score: 40}
+	svc := NewPracticeService(repo, repo, ai, true, nil)
+
+	attempt, _, err := svc.SubmitAnswer(context.Background(), session.ID, uuid.New(), "Answer", "en", true)
+	if err != nil || !attempt.Graded || attempt.Score != 40 {
+		t.Fatalf("expected a graded attempt, got %+v, %v", attempt, err)
+	}
+
+	// Neither a failed evaluation nor a request without AI counts as a 0% score
+	ai.err = errors.New("timeout")
+	failed, _, err := svc.SubmitAnswer(context.Background(), session.ID, uuid.New(), "Answer", "en", true)
+	if err != nil || failed.Graded {
+		t.Fatalf("expected an ungraded attempt when the AI fails, got %+v, %v", failed, err)
+	}
+	skipped, _, err := svc.SubmitAnswer(context.Background(), session.ID, uuid.New(), "Answer", "en", false)
+	if err != nil || skipped.Graded {
+		t.Fatalf("expected an ungraded attempt without AI, got %+v, %v", skipped, err)
+	}
+}
+
+func TestSuggestAnswer_UsesTranslationInRequestedLanguage(t *testing.T) {
+	enID, viID := uuid.New(), uuid.New()
+	repo := &fakeRepo{
+		correctAnswer: "Đáp án mẫu.",
+		translations:  map[uuid.UUID]map[string]uuid.UUID{enID: {"vi": viID}},
+	}
+	svc := NewPracticeService(repo, repo, &fakeAI{}, false, nil)
+
+	if _, _, _, _, err := svc.SuggestAnswer(context.Background(), enID, "", "vi", ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.contentLoaded) != 1 || repo.contentLoaded[0] != viID {
+		t.Fatalf("expected the Vietnamese translation to be loaded, got %v", repo.contentLoaded)
+	}
+
+	// Without a translation in the requested language the original question is used
+	repo.contentLoaded = nil
+	if _, _, _, _, err := svc.SuggestAnswer(context.Background(), enID, "", "en", ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.contentLoaded) != 1 || repo.contentLoaded[0] != enID {
+		t.Fatalf("expected the original question to be loaded, got %v", repo.contentLoaded)
+	}
+}
+
+func TestSuggestAnswer_PrefersCanonicalAnswerForLevel(t *testing.T) {
+	repo := &fakeRepo{
+		questionLevel: "Mid",
+		correctAnswer: "Correct answer.",
+		sampleAnswer:  "AI sample.",
+	}
+	questionID := uuid.New()
+	author := uuid.New()
+	answers := &fakeAnswers{answers: []domain.Answer{
+		{QuestionID: questionID, AnswerType: "canonical", LevelTarget: "junior", AuthorID: author, Content: "Junior canonical."},
+		{QuestionID: questionID, AnswerType: "canonical", LevelTarget: "mid", AuthorID: author, Content: "Mid canonical."},
+		{QuestionID: questionID, AnswerType: "canonical", LevelTarget: "senior", Content: "Promoted AI sample."},
+	}}
+	svc := NewPracticeService(repo, repo, &fakeAI{}, true, answers)
+	ctx := context.Background()
+
+	cases := []struct{ level, want string }{
+		{"Fresher", "Junior canonical."},
+		{"", "Mid canonical."},      // The question's level when the session has none
+		{"Senior", "AI sample."},    // A generated canonical answer is not human-written
+		{"Principal", "AI sample."}, // answer-service has no level target for it
+	}
+	for _, c := range cases {
+		_, _, _, sample, err := svc.SuggestAnswer(ctx, questionID, "", "en", c.level)
+		if err != nil || sample != c.want {
+			t.Fatalf("level %q: expected %q, got %q (%v)", c.level, c.want, sample, err)
+		}
+	}
+}
+
+func TestSuggestAnswer_PublishesGeneratedSample(t *testing.T) {
+	repo := &fakeRepo{questionLevel: "Fresher", correctAnswer: "Correct answer."}
+	answers := &fakeAnswers{err: errors.New("answer service down")}
+	svc := NewPracticeService(repo, repo, &fakeAI{feedback: "Good", improvedAnswer: "AI sample."}, true, answers)
+	ctx := context.Background()
+	questionID := uuid.New()
+
+	_, _, _, sample, err := svc.SuggestAnswer(ctx, questionID, "", "en", "Senior")
+	if err != nil || sample != "AI sample." {
+		t.Fatalf("expected the AI sample despite the publish failure, got %q (%v)", sample, err)
+	}
+	want := domain.SuggestedAnswer{QuestionID: questionID, Content: "AI sample.", LevelTarget: "Fresher", Attribution: domain.SuggestedAnswerAttribution}
+	if len(answers.published) != 1 || answers.published[0] != want {
+		t.Fatalf("expected the sample published for the question's level, got %+v", answers.published)
+	}
+
+	// Evaluating a candidate's answer is not a sample and is not published
+	if _, _, _, _, err := svc.SuggestAnswer(ctx, questionID, "My answer", "en", ""); err != nil || len(answers.published) != 1 {
+		t.Fatalf("expected only samples to be published, got %d (%v)", len(answers.published), err)
+	}
+}
+
+func TestStartSession_WeakestMode(t *testing.T) {
+	repo := &fakeRepo{}
+	svc := NewPracticeService(repo, repo, &fakeAI{}, true, nil)
+	ctx := context.Background()
+
+	invalid := map[string]interface{}{"mode": domain.ModeWeakest, domain.ConfigWeakThreshold: 150.0}
+	if _, _, err := svc.StartSession(ctx, uuid.New(), nil, nil, "en", invalid); err == nil || errors.Is(err, domain.ErrNoWeakQuestions) {
+		t.Fatalf("expected an out-of-range threshold to be rejected, got %v", err)
+	}
+
+	// fakeRepo finds no question; with an empty pool that means there is nothing to drill
+	config := map[string]interface{}{"mode": domain.ModeWeakest, domain.ConfigWeakThreshold: 70.0}
+	if _, _, err := svc.StartSession(ctx, uuid.New(), nil, nil, "en", config); !errors.Is(err, domain.ErrNoWeakQuestions) {
+		t.Fatalf("expected no weak questions, got %v", err)
+	}
+	repo.weakCount = 3
+	if _, _, err := svc.StartSession(ctx, uuid.New(), nil, nil, "en", config); err == nil || errors.Is(err, domain.ErrNoWeakQuestions) {
+		t.Fatalf("expected other selection failures to pass through, got %v", err)
+	}
+	if threshold := domain.WeakThreshold(map[string]interface{}{"mode": domain.ModeWeakest}); threshold != domain.DefaultWeakThreshold {
+		t.Fatalf("expected the default threshold, got %d", threshold)
+	}
+}
+
+func TestCompareAttempt_CanonicalForLevelAndTopCommunityAnswers(t *testing.T) {
+	questionID := uuid.New()
+	level := "Senior"
+	session := domain.NewPracticeSession(uuid.New())
+	session.Level = &level
+	attempt := &domain.PracticeAttempt{ID: uuid.New(), SessionID: session.ID, QuestionID: questionID, UserAnswer: "Use a mutex to guard shared state"}
+	repo := &fakeRepo{questionLevel: "Mid", correctAnswer: "Correct answer.", session: session, attempts: []*domain.PracticeAttempt{attempt}}
+	answers := &fakeAnswers{answers: []domain.Answer{
+		{ID: uuid.New(), QuestionID: questionID, AnswerType: "canonical", LevelTarget: "mid", Content: "Mid canonical"},
+		{ID: uuid.New(), QuestionID: questionID, AnswerType: "canonical", LevelTarget: "senior", Content: "Guard shared state with a mutex"},
+		{ID: uuid.New(), QuestionID: questionID, AnswerType: "community", VoteCount: 5, Content: "use a MUTEX to guard shared state"},
+		{ID: uuid.New(), QuestionID: questionID, AnswerType: "community", VoteCount: 2, Content: "Channels"},
+		{ID: uuid.New(), QuestionID: questionID, AnswerType: "community", VoteCount: 1, Content: "Atomics"},
+	}}
+	svc := NewPracticeService(repo, repo, &fakeAI{}, true, answers)
+	ctx := context.Background()
+
+	comparison, err := svc.CompareAttempt(ctx, session.ID, attempt.ID, 2)
+	if err != nil {
+		t.Fatalf("expected a comparison, got %v", err)
+	}
+	if comparison.Canonical == nil || comparison.Canonical.Content != "Guard shared state with a mutex" || comparison.Canonical.Similarity <= 0 {
+		t.Fatalf("expected the senior canonical answer with a similarity, got %+v", comparison.Canonical)
+	}
+	if len(comparison.Community) != 2 || comparison.Community[0].Similarity != 1 || comparison.Community[1].Similarity != 0 {
+		t.Fatalf("expected the two top community answers scored 1 and 0, got %+v", comparison.Community)
+	}
+
+	// Without answer-service the question's correct answer stands in
+	comparison, err = NewPracticeService(repo, repo, &fakeAI{}, true, nil).CompareAttempt(ctx, session.ID, attempt.ID, 0)
+	if err != nil || comparison.Canonical == nil || comparison.Canonical.Source != domain.ComparedQuestion || len(comparison.Community) != 0 {
+		t.Fatalf("expected only the correct answer, got %+v (%v)", comparison, err)
+	}
+	if _, err := svc.CompareAttempt(ctx, uuid.New(), attempt.ID, 0); err == nil {
+		t.Fatal("expected an attempt from another session to be rejected")
+	}
+}
+
+func TestCreateQuestion_DelegatesToCatalog(t *testing.T) {
+	similar := []domain.SimilarQuestion{{QuestionID: uuid.New(), Content: "What is a goroutine?", Similarity: 0.7}}
+	repo := &fakeRepo{duplicates: similar}
+	svc := NewPracticeService(repo, repo, &fakeAI{}, true, nil)
+	ctx := context.Background()
+
+	question, matches, err := svc.CreateQuestion(ctx, "What is a goroutine in Go?", "Go", "Junior", "", "A lightweight thread.", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.created) != 1 || repo.created[0] != question || question.TopicName != "Go" || question.CorrectAnswer != "A lightweight thread." || question.Language != "en" {
+		t.Fatalf("expected the question to be stored through the catalog, got %+v", repo.created)
+	}
+	if len(matches) != 1 || matches[0].QuestionID != similar[0].QuestionID {
+		t.Fatalf("expected the catalog's weaker matches as warnings, got %+v", matches)
+	}
+
+	question, _, err = svc.CreateQuestion(ctx, "Goroutine là gì?", "Go", "Junior", "vi", "", "")
+	if err != nil || question.Language != "vi" {
+		t.Fatalf("expected a vi question, got %+v (%v)", question, err)
+	}
+	if _, _, err := svc.CreateQuestion(ctx, "Was ist eine Goroutine?", "Go", "Junior", "de", "", ""); err == nil || !strings.HasPrefix(err.Error(), "invalid question") {
+		t.Fatalf("expected an invalid question error for an unknown language, got %v", err)
+	}
+
+	repo.createErr = &domain.DuplicateError{Matches: []domain.SimilarQuestion{{QuestionID: uuid.New(), Similarity: 0.95}}}
+	var dupErr *domain.DuplicateError
+	if _, _, err := svc.CreateQuestion(ctx, "What is a goroutine in Go?", "Go", "Junior", "", "", ""); !errors.As(err, &dupErr) {
+		t.Fatalf("expected the catalog's duplicate rejection, got %v", err)
+	}
+
+	repo.createErr = errors.New("topic not found: Rust")
+	if _, _, err := svc.CreateQuestion(ctx, "What is ownership?", "Rust", "Junior", "", "", ""); err == nil || err.Error() != "topic not found: Rust" {
+		t.Fatalf("expected the catalog's error unchanged, got %v", err)
+	}
+}
@@ -1,15 +1,22 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/question-interviewer/question-service/internal/adapters/ai"
 	http_adapter "github.com/question-interviewer/question-service/internal/adapters/http"
 	"github.com/question-interviewer/question-service/internal/adapters/postgres"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
 	"github.com/question-interviewer/question-service/internal/services"
 )
 
@@ -56,11 +63,65 @@ func main() {
 		log.Println("Connected to Database")
 	}
 
+	// JWT Config (tokens are issued by the BFF with the same secret)
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET is required")
+	}
+
+	// Near-duplicate thresholds (trigram similarity of title + content, 0..1)
+	duplicates, err := domain.LoadDuplicatePolicy(os.Getenv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Crawled question classification: confidence at which items are approved without review (0 disables)
+	classification := domain.DefaultClassificationPolicy()
+	if v := os.Getenv("CLASSIFY_AUTO_APPROVE_THRESHOLD"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 || f > 1 {
+			log.Fatalf("Invalid CLASSIFY_AUTO_APPROVE_THRESHOLD: must be a number in [0, 1]")
+		}
+		classification.AutoApproveThreshold = f
+	}
+
+	// AI Service Config (optional; crawled questions are classified by keyword rules without it)
+	var aiClient ports.AIService
+	if aiServiceURL := os.Getenv("AI_SERVICE_URL"); aiServiceURL != "" {
+		aiClient = ai.NewAIClient(aiServiceURL)
+	} else {
+		log.Println("AI_SERVICE_URL not set, classifying crawled questions by rules only")
+	}
+
 	// Dependency Injection
 	repo := postgres.NewQuestionRepository(db)
 	topicRepo := postgres.NewTopicRepository(db)
-	svc := services.NewQuestionService(repo, topicRepo)
+	tagRepo := postgres.NewTagRepository(db)
+	revisionRepo := postgres.NewRevisionRepository(db)
+	tx := postgres.NewTransactor(db)
+	svc := services.NewQuestionService(repo, topicRepo, tagRepo, revisionRepo, tx, duplicates)
 	handler := http_adapter.NewQuestionHandler(svc)
+	reviewSvc := services.NewReviewService(postgres.NewCrawledQuestionRepository(db), repo, topicRepo, revisionRepo, tx, aiClient, duplicates, classification)
+	reviewHandler := http_adapter.NewReviewHandler(reviewSvc)
+
+	// Background classification of newly crawled questions, auto-approving as CLASSIFY_ACTOR_ID
+	// (a moderator account); CLASSIFY_INTERVAL=0 leaves classification to POST /crawled-questions/classify
+	classifyInterval := time.Minute
+	if v := os.Getenv("CLASSIFY_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			log.Fatalf("Invalid CLASSIFY_INTERVAL: must be a duration such as 30s")
+		}
+		classifyInterval = d
+	}
+	if classifyInterval > 0 {
+		actorID, err := uuid.Parse(os.Getenv("CLASSIFY_ACTOR_ID"))
+		if err != nil {
+			log.Println("CLASSIFY_ACTOR_ID not set to a user ID, crawled questions are classified only on request")
+		} else {
+			go services.RunClassifier(context.Background(), reviewSvc, classifyInterval, actorID)
+		}
+	}
 
 	// Router Setup
 	r := gin.Default()
@@ -77,11 +138,16 @@ func main() {
 		c.Next()
 	})
 
+	// Auth Middleware (injects the authenticated user ID)
+	r.Use(http_adapter.Authenticate([]byte(jwtSecret)))
+	r.Use(http_adapter.Authorize(http_adapter.RoutePolicy, postgres.NewUserRoleRepository(db)))
+
 	r.GET("/health", func(c *gin.Context) {
 		c.String(200, "OK")
 	})
 
 	handler.RegisterRoutes(r)
+	reviewHandler.RegisterRoutes(r)
 
 	// Start Server
 	log.Println("Server listening on :8080")
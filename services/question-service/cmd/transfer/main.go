@@ -0,0 +1,186 @@
+// Command transfer bulk imports and exports questions as JSON Lines, CSV or Markdown.
+//
+//	transfer import -file questions.csv [-format csv] [-dry-run]
+//	transfer export -format markdown -out questions.md [-status published] [-topic Go]
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/question-interviewer/question-service/internal/adapters/postgres"
+	"github.com/question-interviewer/question-service/internal/adapters/transfer"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+	"github.com/question-interviewer/question-service/internal/services"
+)
+
+// seedUserID matches the user the seeders attribute questions to
+const seedUserID = "123e4567-e89b-12d3-a456-426614174000"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	dbHost := getenvDefault("DB_HOST", "localhost")
+	dbPort := getenvDefault("DB_PORT", "5432")
+	dbUser := getenvDefault("DB_USER", "user")
+	dbPassword := getenvDefault("DB_PASSWORD", "password")
+	dbName := getenvDefault("DB_NAME", "question_db")
+
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		dbHost, dbPort, dbUser, dbPassword, dbName)
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		log.Fatalf("Failed to open database connection: %v", err)
+	}
+	defer db.Close()
+
+	// Imports apply the same near-duplicate thresholds as the API
+	duplicates, err := domain.LoadDuplicatePolicy(os.Getenv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	svc := services.NewQuestionService(
+		postgres.NewQuestionRepository(db),
+		postgres.NewTopicRepository(db),
+		postgres.NewTagRepository(db),
+		postgres.NewRevisionRepository(db),
+		postgres.NewTransactor(db),
+		duplicates,
+	)
+
+	switch os.Args[1] {
+	case "import":
+		runImport(svc, os.Args[2:])
+	case "export":
+		runExport(svc, os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: transfer import -file <path> [-format jsonl|csv|markdown] [-dry-run] [-created-by <user id>]")
+	fmt.Fprintln(os.Stderr, "       transfer export [-format jsonl|csv|markdown] [-out <path>] [-status s] [-level l] [-language en|vi] [-topic name]")
+	os.Exit(2)
+}
+
+func runImport(svc ports.QuestionService, args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	file := fs.String("file", "", "file to import (- for stdin)")
+	formatName := fs.String("format", "", "jsonl, csv or markdown (defaults to the file extension)")
+	dryRun := fs.Bool("dry-run", false, "validate without writing")
+	createdBy := fs.String("created-by", seedUserID, "user ID recorded as the author of new questions")
+	fs.Parse(args)
+
+	if *file == "" {
+		log.Fatal("-file is required")
+	}
+	actorID, err := uuid.Parse(*createdBy)
+	if err != nil {
+		log.Fatalf("Invalid -created-by: %v", err)
+	}
+
+	format := *formatName
+	if format == "" {
+		format, err = transfer.FormatFromFilename(*file)
+	} else {
+		format, err = transfer.ParseFormat(format)
+	}
+	if err != nil {
+		log.Fatalf("Failed to determine format: %v", err)
+	}
+
+	var in io.Reader = os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			log.Fatalf("Failed to open %s: %v", *file, err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	records, rowErrors, err := transfer.Decode(format, in)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", *file, err)
+	}
+
+	result, err := svc.ImportQuestions(context.Background(), records, actorID, domain.RoleAdmin, *dryRun)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+	result.AddRowErrors(rowErrors)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		log.Fatalf("Failed to write result: %v", err)
+	}
+	if result.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func runExport(svc ports.QuestionService, args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	formatName := fs.String("format", domain.FormatJSONL, "jsonl, csv or markdown")
+	out := fs.String("out", "-", "output file (- for stdout)")
+	status := fs.String("status", "", "only questions with this status")
+	level := fs.String("level", "", "only questions at this level")
+	language := fs.String("language", "", "only questions in this language")
+	topic := fs.String("topic", "", "only questions in this topic (name, slug or alias) and its subtopics")
+	fs.Parse(args)
+
+	format, err := transfer.ParseFormat(*formatName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	filter := domain.QuestionFilter{Status: *status, Level: *level, Language: *language}
+	if *topic != "" {
+		t, err := svc.GetTopicByName(ctx, *topic)
+		if err != nil {
+			log.Fatalf("Failed to resolve topic %s: %v", *topic, err)
+		}
+		filter.TopicID = &t.ID
+	}
+
+	records, err := svc.ExportQuestions(ctx, filter)
+	if err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := transfer.Encode(format, w, records); err != nil {
+		log.Fatalf("Failed to write export: %v", err)
+	}
+	log.Printf("Exported %d questions", len(records))
+}
+
+func getenvDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
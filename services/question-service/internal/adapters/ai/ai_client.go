@@ -0,0 +1,79 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+type AIClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewAIClient(baseURL string) ports.AIService {
+	return &AIClient{
+		baseURL: baseURL,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+type ClassificationRequest struct {
+	Title   string   `json:"title"`
+	Content string   `json:"content"`
+	Topics  []string `json:"topics"`
+}
+
+type ClassificationResponse struct {
+	Topic           string  `json:"topic"`
+	TopicConfidence float64 `json:"topic_confidence"`
+	Level           string  `json:"level"`
+	LevelConfidence float64 `json:"level_confidence"`
+	Role            string  `json:"role"`
+	RoleConfidence  float64 `json:"role_confidence"`
+}
+
+func (c *AIClient) ClassifyQuestion(ctx context.Context, title, content string, topics []string) (*domain.Classification, error) {
+	jsonBody, err := json.Marshal(ClassificationRequest{Title: title, Content: content, Topics: topics})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/api/v1/classify", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call AI service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("AI service returned status: %d", resp.StatusCode)
+	}
+
+	var classResp ClassificationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&classResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &domain.Classification{
+		Topic:           classResp.Topic,
+		TopicConfidence: classResp.TopicConfidence,
+		Level:           classResp.Level,
+		LevelConfidence: classResp.LevelConfidence,
+		Role:            classResp.Role,
+		RoleConfidence:  classResp.RoleConfidence,
+	}, nil
+}
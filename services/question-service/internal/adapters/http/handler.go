@@ -1,11 +1,15 @@
 package http_adapter
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
 	"github.com/question-interviewer/question-service/internal/ports"
 )
 
@@ -28,17 +32,27 @@ type CreateQuestionRequest struct {
 	Hint          string `json:"hint"`
 	CorrectAnswer string `json:"correct_answer"`
 	TopicID       string `json:"topic_id" binding:"required,uuid"`
-	CreatedBy     string `json:"created_by" binding:"required,uuid"` // TODO: Get from context/auth
+	// Tags are existing tag slugs or names
+	Tags []string `json:"tags"`
+	// AllowDuplicate lets moderators create a question despite a near-duplicate rejection
+	AllowDuplicate bool `json:"allow_duplicate"`
+}
+
+// CreateQuestionResponse is the created question plus any existing questions it resembles
+type CreateQuestionResponse struct {
+	*domain.Question
+	PossibleDuplicates []domain.DuplicateMatch `json:"possible_duplicates,omitempty"`
 }
 
 // CreateQuestion godoc
 // @Summary Create a new question
-// @Description Create a new question
+// @Description Create a new question. Near-duplicates of existing questions are rejected with 409, weaker matches are returned in possible_duplicates.
 // @Tags questions
 // @Accept json
 // @Produce json
 // @Param question body CreateQuestionRequest true "Question Data"
-// @Success 201 {object} domain.Question
+// @Success 201 {object} CreateQuestionResponse
+// @Failure 409 {object} map[string]interface{}
 // @Router /questions [post]
 func (h *QuestionHandler) CreateQuestion(c *gin.Context) {
 	var req CreateQuestionRequest
@@ -53,9 +67,14 @@ func (h *QuestionHandler) CreateQuestion(c *gin.Context) {
 		return
 	}
 
-	createdBy, err := uuid.Parse(req.CreatedBy)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid created_by"})
+	createdBy, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	if req.AllowDuplicate && !roleFromContext(c).IsModerator() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only moderators can set allow_duplicate"})
 		return
 	}
 
@@ -64,13 +83,22 @@ func (h *QuestionHandler) CreateQuestion(c *gin.Context) {
 		language = "en"
 	}
 
-	question, err := h.service.CreateQuestion(c.Request.Context(), req.Title, req.Content, req.Level, language, req.Role, req.Hint, req.CorrectAnswer, topicID, createdBy)
+	question, duplicates, err := h.service.CreateQuestion(c.Request.Context(), req.Title, req.Content, req.Level, language, req.Role, req.Hint, req.CorrectAnswer, topicID, createdBy, req.Tags, req.AllowDuplicate)
 	if err != nil {
+		var dupErr *domain.DuplicateError
+		if errors.As(err, &dupErr) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "duplicates": dupErr.Matches})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "tag not found") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusCreated, question)
+	c.JSON(http.StatusCreated, CreateQuestionResponse{Question: question, PossibleDuplicates: duplicates})
 }
 
 // GetQuestion godoc
@@ -92,7 +120,7 @@ func (h *QuestionHandler) GetQuestion(c *gin.Context) {
 
 	question, err := h.service.GetQuestion(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		writeQuestionError(c, err)
 		return
 	}
 
@@ -101,33 +129,609 @@ func (h *QuestionHandler) GetQuestion(c *gin.Context) {
 
 // ListQuestions godoc
 // @Summary List questions
-// @Description List questions with pagination
+// @Description List questions as a bare array with offset pagination; the filters and sorting match /api/v2/questions. Non-moderators see published questions, or any status of their own with created_by set to themselves.
 // @Tags questions
 // @Accept json
 // @Produce json
-// @Param limit query int false "Limit"
+// @Param topic_id query string false "Topic ID"
+// @Param level query string false "Level"
+// @Param language query string false "Language (en, vi)"
+// @Param role query string false "Role"
+// @Param status query string false "Status (draft, review, published, archived); default published, all statuses for moderators"
+// @Param created_by query string false "Author user ID"
+// @Param created_from query string false "Created at or after (RFC3339 or YYYY-MM-DD)"
+// @Param created_to query string false "Created before (RFC3339 or YYYY-MM-DD)"
+// @Param tags query string false "Comma-separated tag slugs; questions must carry all of them"
+// @Param sort query string false "created_at (default), updated_at or popularity"
+// @Param order query string false "desc (default) or asc"
+// @Param limit query int false "Limit (default 10, max 100)"
 // @Param offset query int false "Offset"
 // @Success 200 {array} domain.Question
 // @Router /questions [get]
 func (h *QuestionHandler) ListQuestions(c *gin.Context) {
-	limitStr := c.DefaultQuery("limit", "10")
-	offsetStr := c.DefaultQuery("offset", "0")
+	filter, ok := listFilter(c)
+	if !ok {
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offset"})
+		return
+	}
+	filter.Offset = offset
+
+	page, ok := h.listQuestions(c, filter)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, page.Items)
+}
+
+// ListQuestionsPage godoc
+// @Summary List questions (paginated)
+// @Description List questions with filters, sorting and keyset cursor pagination, with the total match count. Non-moderators see published questions, or any status of their own with created_by set to themselves.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param topic_id query string false "Topic ID"
+// @Param level query string false "Level"
+// @Param language query string false "Language (en, vi)"
+// @Param role query string false "Role"
+// @Param status query string false "Status (draft, review, published, archived); default published, all statuses for moderators"
+// @Param created_by query string false "Author user ID"
+// @Param created_from query string false "Created at or after (RFC3339 or YYYY-MM-DD)"
+// @Param created_to query string false "Created before (RFC3339 or YYYY-MM-DD)"
+// @Param tags query string false "Comma-separated tag slugs; questions must carry all of them"
+// @Param sort query string false "created_at (default), updated_at or popularity"
+// @Param order query string false "desc (default) or asc"
+// @Param cursor query string false "next_cursor from the previous page"
+// @Param limit query int false "Limit (default 10, max 100)"
+// @Success 200 {object} domain.QuestionPage
+// @Router /v2/questions [get]
+func (h *QuestionHandler) ListQuestionsPage(c *gin.Context) {
+	filter, ok := listFilter(c)
+	if !ok {
+		return
+	}
+	filter.Cursor = c.Query("cursor")
+
+	page, ok := h.listQuestions(c, filter)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// BatchQuestionsRequest names the questions to look up at once
+type BatchQuestionsRequest struct {
+	IDs []uuid.UUID `json:"ids" binding:"required"`
+}
+
+// GetQuestions godoc
+// @Summary Get questions by ID
+// @Description Look up to 100 questions at once, as GET /questions/{id} does for one. Questions that do not exist are left out, so callers can tell which are gone.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param request body BatchQuestionsRequest true "Question IDs"
+// @Success 200 {array} domain.Question
+// @Router /questions/batch [post]
+func (h *QuestionHandler) GetQuestions(c *gin.Context) {
+	var req BatchQuestionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	questions, err := h.service.GetQuestions(c.Request.Context(), req.IDs)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid batch") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, questions)
+}
+
+// ListPool godoc
+// @Summary List a practice pool
+// @Description List the ID, translation group and level of every published question matching a practice session's filters, for practice-service to draw from. The filter is a JSON body because groups_of can name many questions.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param filter body domain.PoolFilter true "Pool filter"
+// @Success 200 {array} domain.PoolQuestion
+// @Router /questions/pool [post]
+func (h *QuestionHandler) ListPool(c *gin.Context) {
+	var filter domain.PoolFilter
+	if err := c.ShouldBindJSON(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pool, err := h.service.ListPool(c.Request.Context(), filter)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid pool filter") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, pool)
+}
+
+func (h *QuestionHandler) listQuestions(c *gin.Context, filter domain.QuestionFilter) (*domain.QuestionPage, bool) {
+	actorID, _ := userIDFromContext(c)
+	page, err := h.service.ListQuestions(c.Request.Context(), filter, actorID, roleFromContext(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case strings.HasPrefix(err.Error(), "invalid cursor"), strings.HasPrefix(err.Error(), "invalid sort"):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return nil, false
+	}
+	return page, true
+}
 
-	limit, _ := strconv.Atoi(limitStr)
-	offset, _ := strconv.Atoi(offsetStr)
+// listFilter reads the filters and sorting shared by both listing versions, answering 400 on bad input
+func listFilter(c *gin.Context) (domain.QuestionFilter, bool) {
+	filter := domain.QuestionFilter{
+		Level:    c.Query("level"),
+		Language: c.Query("language"),
+		Role:     c.Query("role"),
+		Status:   c.Query("status"),
+		Sort:     c.Query("sort"),
+		Tags:     tagsQuery(c),
+	}
 
-	questions, err := h.service.ListQuestions(c.Request.Context(), limit, offset)
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return filter, false
+	}
+	filter.Limit = limit
+
+	switch c.DefaultQuery("order", "desc") {
+	case "asc":
+		filter.Ascending = true
+	case "desc":
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "order must be asc or desc"})
+		return filter, false
+	}
+
+	if v := c.Query("topic_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid topic_id"})
+			return filter, false
+		}
+		filter.TopicID = &id
+	}
+	if v := c.Query("created_by"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid created_by"})
+			return filter, false
+		}
+		filter.CreatedBy = &id
+	}
+	if v := c.Query("created_from"); v != "" {
+		t, err := parseDateParam(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid created_from"})
+			return filter, false
+		}
+		filter.CreatedFrom = &t
+	}
+	if v := c.Query("created_to"); v != "" {
+		t, err := parseDateParam(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid created_to"})
+			return filter, false
+		}
+		filter.CreatedTo = &t
+	}
+	return filter, true
+}
+
+// SearchQuestions godoc
+// @Summary Search questions
+// @Description Ranked full-text search over title, content and correct answer with highlighted snippets and topic/level facets
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text (websearch syntax: quotes, OR, -exclude)"
+// @Param language query string false "Language (en, vi); empty searches both"
+// @Param topic_id query string false "Topic ID"
+// @Param level query string false "Level"
+// @Param status query string false "Status (default published; other statuses need a moderator)"
+// @Param tags query string false "Comma-separated tag slugs; hits must carry all of them"
+// @Param limit query int false "Limit (default 10, max 100)"
+// @Param offset query int false "Offset"
+// @Success 200 {object} domain.SearchResult
+// @Router /questions/search [get]
+func (h *QuestionHandler) SearchQuestions(c *gin.Context) {
+	query := domain.SearchQuery{
+		Text:     c.Query("q"),
+		Language: c.Query("language"),
+		Level:    c.Query("level"),
+		Status:   c.Query("status"),
+		Tags:     tagsQuery(c),
+	}
+	if strings.TrimSpace(query.Text) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q is required"})
+		return
+	}
+	if query.Language != "" && query.Language != "en" && query.Language != "vi" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "language must be en or vi"})
+		return
+	}
+
+	limit, errLimit := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, errOffset := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if errLimit != nil || errOffset != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit or offset"})
+		return
+	}
+	query.Limit = limit
+	query.Offset = offset
+
+	if v := c.Query("topic_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid topic_id"})
+			return
+		}
+		query.TopicID = &id
+	}
+
+	result, err := h.service.SearchQuestions(c.Request.Context(), query, roleFromContext(c))
+	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "search text is required") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, questions)
+	c.JSON(http.StatusOK, result)
+}
+
+// ListDuplicateClusters godoc
+// @Summary List near-duplicate question clusters
+// @Description Group non-archived questions whose trigram similarity reaches the threshold, so they can be reviewed and merged
+// @Tags questions
+// @Produce json
+// @Param threshold query number false "Similarity threshold between 0 and 1 (default: the service warn threshold)"
+// @Param limit query int false "Most similar pairs to cluster (default 500, max 5000)"
+// @Success 200 {array} domain.DuplicateCluster
+// @Router /questions/duplicates [get]
+func (h *QuestionHandler) ListDuplicateClusters(c *gin.Context) {
+	threshold := 0.0
+	if v := c.Query("threshold"); v != "" {
+		t, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid threshold"})
+			return
+		}
+		threshold = t
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return
+	}
+
+	clusters, err := h.service.ListDuplicateClusters(c.Request.Context(), threshold, limit)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid threshold") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, clusters)
+}
+
+// parseDateParam accepts RFC3339 timestamps or plain YYYY-MM-DD dates
+func parseDateParam(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, v)
+}
+
+type UpdateQuestionRequest struct {
+	Title         string `json:"title" binding:"required"`
+	Content       string `json:"content" binding:"required"`
+	Level         string `json:"level" binding:"required"`
+	Language      string `json:"language"`
+	Role          string `json:"role"`
+	Hint          string `json:"hint"`
+	CorrectAnswer string `json:"correct_answer"`
+	TopicID       string `json:"topic_id" binding:"required,uuid"`
+}
+
+type PatchQuestionRequest struct {
+	Title         *string `json:"title"`
+	Content       *string `json:"content"`
+	Level         *string `json:"level"`
+	Language      *string `json:"language"`
+	Role          *string `json:"role"`
+	Hint          *string `json:"hint"`
+	CorrectAnswer *string `json:"correct_answer"`
+	TopicID       *string `json:"topic_id" binding:"omitempty,uuid"`
+	Status        *string `json:"status" binding:"omitempty,oneof=draft review published archived"`
+}
+
+// UpdateQuestion godoc
+// @Summary Replace a question
+// @Description Replace the editable fields of a question
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param question body UpdateQuestionRequest true "Question Data"
+// @Success 200 {object} domain.Question
+// @Router /questions/{id} [put]
+func (h *QuestionHandler) UpdateQuestion(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req UpdateQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	topicID, err := uuid.Parse(req.TopicID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid topic_id"})
+		return
+	}
+
+	language := req.Language
+	if language == "" {
+		language = "en"
+	}
+
+	patch := domain.QuestionPatch{
+		Title:         &req.Title,
+		Content:       &req.Content,
+		Level:         &req.Level,
+		Language:      &language,
+		Role:          &req.Role,
+		Hint:          &req.Hint,
+		CorrectAnswer: &req.CorrectAnswer,
+		TopicID:       &topicID,
+	}
+	h.applyPatch(c, id, patch)
+}
+
+// PatchQuestion godoc
+// @Summary Partially update a question
+// @Description Update some fields of a question and/or move it through the draft → review → published → archived workflow
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param question body PatchQuestionRequest true "Fields to change"
+// @Success 200 {object} domain.Question
+// @Router /questions/{id} [patch]
+func (h *QuestionHandler) PatchQuestion(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req PatchQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	patch := domain.QuestionPatch{
+		Title:         req.Title,
+		Content:       req.Content,
+		Level:         req.Level,
+		Language:      req.Language,
+		Role:          req.Role,
+		Hint:          req.Hint,
+		CorrectAnswer: req.CorrectAnswer,
+		Status:        req.Status,
+	}
+	if req.TopicID != nil {
+		topicID, err := uuid.Parse(*req.TopicID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid topic_id"})
+			return
+		}
+		patch.TopicID = &topicID
+	}
+	h.applyPatch(c, id, patch)
+}
+
+func (h *QuestionHandler) applyPatch(c *gin.Context, id uuid.UUID, patch domain.QuestionPatch) {
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	question, err := h.service.UpdateQuestion(c.Request.Context(), id, patch, actorID, roleFromContext(c))
+	if err != nil {
+		writeQuestionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, question)
+}
+
+// DeleteQuestion godoc
+// @Summary Delete a question
+// @Description Delete a question (authors: own drafts only; moderators: any)
+// @Tags questions
+// @Param id path string true "Question ID"
+// @Success 204
+// @Router /questions/{id} [delete]
+func (h *QuestionHandler) DeleteQuestion(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	if err := h.service.DeleteQuestion(c.Request.Context(), id, actorID, roleFromContext(c)); err != nil {
+		writeQuestionError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListRevisions godoc
+// @Summary List question revisions
+// @Description List the revision history of a question, newest first
+// @Tags questions
+// @Produce json
+// @Param id path string true "Question ID"
+// @Success 200 {array} domain.QuestionRevision
+// @Router /questions/{id}/revisions [get]
+func (h *QuestionHandler) ListRevisions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	revisions, err := h.service.ListRevisions(c.Request.Context(), id)
+	if err != nil {
+		writeQuestionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
+// DiffRevisions godoc
+// @Summary Diff two question revisions
+// @Description Field-level diff between two revisions of a question
+// @Tags questions
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param from query int true "From revision number"
+// @Param to query int true "To revision number"
+// @Success 200 {array} domain.FieldDiff
+// @Router /questions/{id}/revisions/diff [get]
+func (h *QuestionHandler) DiffRevisions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	from, errFrom := strconv.Atoi(c.Query("from"))
+	to, errTo := strconv.Atoi(c.Query("to"))
+	if errFrom != nil || errTo != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from and to must be revision numbers"})
+		return
+	}
+
+	diffs, err := h.service.DiffRevisions(c.Request.Context(), id, from, to)
+	if err != nil {
+		writeQuestionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"from":    from,
+		"to":      to,
+		"changes": diffs,
+	})
+}
+
+// RollbackRevision godoc
+// @Summary Roll back a question to a revision
+// @Description Restore a question's content from an earlier revision (recorded as a new revision)
+// @Tags questions
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param revision path int true "Revision number"
+// @Success 200 {object} domain.Question
+// @Router /questions/{id}/revisions/{revision}/rollback [post]
+func (h *QuestionHandler) RollbackRevision(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	revisionNumber, err := strconv.Atoi(c.Param("revision"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision number"})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	question, err := h.service.RollbackToRevision(c.Request.Context(), id, revisionNumber, actorID, roleFromContext(c))
+	if err != nil {
+		writeQuestionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, question)
+}
+
+// writeQuestionError maps service errors to HTTP status codes
+func writeQuestionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidStatusTransition), strings.HasPrefix(err.Error(), "translation already exists"):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case strings.Contains(err.Error(), "question not found"), strings.Contains(err.Error(), "revision not found"),
+		strings.Contains(err.Error(), "tag not found"):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
 }
 
 type CreateTopicRequest struct {
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description"`
+	Name        string   `json:"name" binding:"required"`
+	Description string   `json:"description"`
+	ParentID    *string  `json:"parent_id" binding:"omitempty,uuid"`
+	Aliases     []string `json:"aliases"`
 }
 
 // CreateTopic godoc
@@ -146,21 +750,110 @@ func (h *QuestionHandler) CreateTopic(c *gin.Context) {
 		return
 	}
 
-	topic, err := h.service.CreateTopic(c.Request.Context(), req.Name, req.Description)
+	var parentID *uuid.UUID
+	if req.ParentID != nil {
+		id, err := uuid.Parse(*req.ParentID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid parent_id"})
+			return
+		}
+		parentID = &id
+	}
+
+	topic, err := h.service.CreateTopic(c.Request.Context(), req.Name, req.Description, parentID, req.Aliases)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeTopicError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, topic)
 }
 
+// GetTopic godoc
+// @Summary Get a topic by ID
+// @Description Get a topic by ID
+// @Tags topics
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Success 200 {object} domain.Topic
+// @Router /topics/{id} [get]
+func (h *QuestionHandler) GetTopic(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	topic, err := h.service.GetTopic(c.Request.Context(), id)
+	if err != nil {
+		writeTopicError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, topic)
+}
+
+// UpdateTopic godoc
+// @Summary Update a topic
+// @Description Replace a topic's name, description, parent and aliases
+// @Tags topics
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param topic body CreateTopicRequest true "Topic Data"
+// @Success 200 {object} domain.Topic
+// @Router /topics/{id} [put]
+func (h *QuestionHandler) UpdateTopic(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req CreateTopicRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var parentID *uuid.UUID
+	if req.ParentID != nil {
+		pid, err := uuid.Parse(*req.ParentID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid parent_id"})
+			return
+		}
+		parentID = &pid
+	}
+
+	topic, err := h.service.UpdateTopic(c.Request.Context(), id, req.Name, req.Description, parentID, req.Aliases)
+	if err != nil {
+		writeTopicError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, topic)
+}
+
+// writeTopicError maps topic service errors to HTTP status codes
+func writeTopicError(c *gin.Context, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "invalid parent"):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case err.Error() == "topic not found":
+		c.JSON(http.StatusNotFound, gin.H{"error": "Topic not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
 // ListTopics godoc
 // @Summary List topics
-// @Description List all topics
+// @Description List all topics (each with its parent_id), or resolve one by name, slug or alias with ?name=
 // @Tags topics
 // @Accept json
 // @Produce json
+// @Param name query string false "Topic name, slug or alias"
 // @Success 200 {array} domain.Topic
 // @Router /topics [get]
 func (h *QuestionHandler) ListTopics(c *gin.Context) {
@@ -191,11 +884,44 @@ func (h *QuestionHandler) ListTopics(c *gin.Context) {
 func (h *QuestionHandler) RegisterRoutes(router *gin.Engine) {
 	v1 := router.Group("/api/v1")
 	{
-		v1.POST("/questions", h.CreateQuestion)
+		v1.POST("/questions", RequireAuth(), h.CreateQuestion)
+		v1.GET("/questions/search", h.SearchQuestions)
+		v1.GET("/questions/duplicates", RequireAuth(), h.ListDuplicateClusters)
+		v1.POST("/questions/import", RequireAuth(), h.ImportQuestions)
+		v1.GET("/questions/export", RequireAuth(), h.ExportQuestions)
 		v1.GET("/questions/:id", h.GetQuestion)
 		v1.GET("/questions", h.ListQuestions)
+		v1.POST("/questions/pool", h.ListPool)
+		v1.POST("/questions/batch", h.GetQuestions)
+		v1.PUT("/questions/:id", RequireAuth(), h.UpdateQuestion)
+		v1.PATCH("/questions/:id", RequireAuth(), h.PatchQuestion)
+		v1.DELETE("/questions/:id", RequireAuth(), h.DeleteQuestion)
+		v1.GET("/questions/:id/revisions", h.ListRevisions)
+		v1.GET("/questions/:id/revisions/diff", h.DiffRevisions)
+		v1.POST("/questions/:id/revisions/:revision/rollback", RequireAuth(), h.RollbackRevision)
+
+		v1.PUT("/questions/:id/tags", RequireAuth(), h.SetQuestionTags)
 
-		v1.POST("/topics", h.CreateTopic)
+		v1.GET("/questions/:id/translations", h.ListTranslations)
+		v1.POST("/questions/:id/translations", RequireAuth(), h.CreateTranslation)
+		v1.PUT("/questions/:id/translations/:translation_id", RequireAuth(), h.LinkTranslation)
+		v1.DELETE("/questions/:id/translations/:translation_id", RequireAuth(), h.UnlinkTranslation)
+
+		v1.POST("/topics", RequireAuth(), h.CreateTopic)
 		v1.GET("/topics", h.ListTopics)
+		v1.GET("/topics/:id", h.GetTopic)
+		v1.PUT("/topics/:id", RequireAuth(), h.UpdateTopic)
+
+		v1.POST("/tags", RequireAuth(), h.CreateTag)
+		v1.GET("/tags", h.ListTags)
+		v1.GET("/tags/:id", h.GetTag)
+		v1.PUT("/tags/:id", RequireAuth(), h.UpdateTag)
+		v1.DELETE("/tags/:id", RequireAuth(), h.DeleteTag)
+	}
+
+	// v2 listings return a page envelope instead of a bare array
+	v2 := router.Group("/api/v2")
+	{
+		v2.GET("/questions", h.ListQuestionsPage)
 	}
 }
@@ -0,0 +1,34 @@
+package http_adapter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+func TestWriteQuestionError_StatusCodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cases := map[string]struct {
+		err  error
+		want int
+	}{
+		"forbidden":            {fmt.Errorf("%w: not your question", domain.ErrForbidden), http.StatusForbidden},
+		"bad transition":       {fmt.Errorf("%w: draft → archived", domain.ErrInvalidStatusTransition), http.StatusConflict},
+		"language conflict":    {errors.New("translation already exists for language: vi"), http.StatusConflict},
+		"missing question":     {errors.New("question not found: 42"), http.StatusNotFound},
+		"database unavailable": {errors.New("connection refused"), http.StatusInternalServerError},
+	}
+	for name, tc := range cases {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		writeQuestionError(c, tc.err)
+		if w.Code != tc.want {
+			t.Errorf("%s: expected %d, got %d", name, tc.want, w.Code)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package http_adapter
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+// RoutePolicy maps "METHOD /route/pattern" to the roles allowed to call it.
+// Routes that are not listed are open to any caller.
+var RoutePolicy = map[string][]domain.Role{
+	"POST /api/v1/questions":       {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"PUT /api/v1/questions/:id":    {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"PATCH /api/v1/questions/:id":  {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"DELETE /api/v1/questions/:id": {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/topics":          {domain.RoleModerator, domain.RoleAdmin},
+	"PUT /api/v1/topics/:id":       {domain.RoleModerator, domain.RoleAdmin},
+
+	"GET /api/v1/questions/duplicates": {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/questions/import":    {domain.RoleModerator, domain.RoleAdmin},
+	"GET /api/v1/questions/export":     {domain.RoleModerator, domain.RoleAdmin},
+
+	"PUT /api/v1/questions/:id/tags": {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/tags":              {domain.RoleModerator, domain.RoleAdmin},
+	"PUT /api/v1/tags/:id":           {domain.RoleModerator, domain.RoleAdmin},
+	"DELETE /api/v1/tags/:id":        {domain.RoleModerator, domain.RoleAdmin},
+
+	"POST /api/v1/questions/:id/revisions/:revision/rollback": {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+
+	"POST /api/v1/questions/:id/translations":                   {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"PUT /api/v1/questions/:id/translations/:translation_id":    {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+	"DELETE /api/v1/questions/:id/translations/:translation_id": {domain.RoleContributor, domain.RoleModerator, domain.RoleAdmin},
+
+	"GET /api/v1/crawled-questions":               {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/crawled-questions/approve":      {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/crawled-questions/reject":       {domain.RoleModerator, domain.RoleAdmin},
+	"GET /api/v1/crawled-questions/:id":           {domain.RoleModerator, domain.RoleAdmin},
+	"PATCH /api/v1/crawled-questions/:id":         {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/crawled-questions/:id/approve":  {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/crawled-questions/:id/reject":   {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/crawled-questions/classify":     {domain.RoleModerator, domain.RoleAdmin},
+	"POST /api/v1/crawled-questions/:id/classify": {domain.RoleModerator, domain.RoleAdmin},
+}
+
+// Authorize enforces a route policy. The role in the token is only as fresh as the token, so for
+// listed routes the current role is read through roles and replaces it for the rest of the request;
+// a promotion or demotion applies to these routes at once. Unlisted routes keep the token's role
+// until it expires (JWT_TTL). It must be registered after Authenticate and before the routes it guards.
+func Authorize(policy map[string][]domain.Role, roles ports.RoleReader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, ok := policy[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		userID, ok := userIDFromContext(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+			return
+		}
+
+		role, err := roles.GetUserRole(c.Request.Context(), userID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "User no longer exists"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Set(userRoleKey, role)
+
+		for _, r := range allowed {
+			if role == r {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Role " + string(role) + " is not allowed to call this route"})
+	}
+}
@@ -0,0 +1,117 @@
+package http_adapter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// fakeRoles holds the current roles, as the users table would
+type fakeRoles struct {
+	roles map[uuid.UUID]domain.Role
+	err   error
+}
+
+func (f *fakeRoles) GetUserRole(ctx context.Context, userID uuid.UUID) (domain.Role, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	role, ok := f.roles[userID]
+	if !ok {
+		return "", domain.ErrUserNotFound
+	}
+	return role, nil
+}
+
+// policyRouter guards GET /open and POST /moderate, echoing the role handlers see
+func policyRouter(roles *fakeRoles) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Authenticate(testSecret))
+	r.Use(Authorize(map[string][]domain.Role{
+		"POST /moderate": {domain.RoleModerator, domain.RoleAdmin},
+	}, roles))
+	echo := func(c *gin.Context) {
+		c.String(http.StatusOK, string(roleFromContext(c)))
+	}
+	r.GET("/open", echo)
+	r.POST("/moderate", echo)
+	return r
+}
+
+func call(r http.Handler, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthorize_UsesCurrentRoleOnListedRoutes(t *testing.T) {
+	promoted, demoted, deleted := uuid.New(), uuid.New(), uuid.New()
+	roles := &fakeRoles{roles: map[uuid.UUID]domain.Role{
+		promoted: domain.RoleModerator,
+		demoted:  domain.RoleUser,
+	}}
+	r := policyRouter(roles)
+	// Tokens still carry the roles from before the change
+	promotedToken := signToken(t, jwt.SigningMethodHS256, testSecret, validClaims(promoted.String(), "user"))
+	demotedToken := signToken(t, jwt.SigningMethodHS256, testSecret, validClaims(demoted.String(), "moderator"))
+	deletedToken := signToken(t, jwt.SigningMethodHS256, testSecret, validClaims(deleted.String(), "admin"))
+
+	if w := call(r, http.MethodPost, "/moderate", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("anonymous: expected 401, got %d", w.Code)
+	}
+	if w := call(r, http.MethodPost, "/moderate", promotedToken); w.Code != http.StatusOK || w.Body.String() != "moderator" {
+		t.Fatalf("promoted: expected 200 as moderator, got %d %s", w.Code, w.Body)
+	}
+	if w := call(r, http.MethodPost, "/moderate", demotedToken); w.Code != http.StatusForbidden {
+		t.Fatalf("demoted: expected 403, got %d", w.Code)
+	}
+	if w := call(r, http.MethodPost, "/moderate", deletedToken); w.Code != http.StatusUnauthorized {
+		t.Fatalf("deleted user: expected 401, got %d", w.Code)
+	}
+
+	// Unlisted routes are open and keep the token's role
+	if w := call(r, http.MethodGet, "/open", ""); w.Code != http.StatusOK {
+		t.Fatalf("open route: expected 200, got %d", w.Code)
+	}
+	if w := call(r, http.MethodGet, "/open", demotedToken); w.Body.String() != "moderator" {
+		t.Fatalf("open route: expected the token's role, got %s", w.Body)
+	}
+
+	roles.err = errors.New("database down")
+	if w := call(r, http.MethodPost, "/moderate", promotedToken); w.Code != http.StatusInternalServerError {
+		t.Fatalf("lookup failure: expected 500, got %d", w.Code)
+	}
+}
+
+// TestRoutePolicy_MatchesRegisteredRoutes catches policy entries that no longer guard anything,
+// such as after a route is renamed
+func TestRoutePolicy_MatchesRegisteredRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	NewQuestionHandler(nil).RegisterRoutes(r)
+	NewReviewHandler(nil).RegisterRoutes(r)
+	registered := map[string]bool{}
+	for _, route := range r.Routes() {
+		registered[route.Method+" "+route.Path] = true
+	}
+	for route, allowed := range RoutePolicy {
+		if !registered[route] {
+			t.Errorf("RoutePolicy lists %s, which is not a registered route", route)
+		}
+		if len(allowed) == 0 {
+			t.Errorf("RoutePolicy allows no role on %s", route)
+		}
+	}
+}
@@ -0,0 +1,413 @@
+package http_adapter
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+// ReviewHandler serves the moderation queue for crawled questions
+type ReviewHandler struct {
+	service ports.ReviewService
+}
+
+func NewReviewHandler(service ports.ReviewService) *ReviewHandler {
+	return &ReviewHandler{
+		service: service,
+	}
+}
+
+type UpdateCrawledRequest struct {
+	Title         *string `json:"title"`
+	Content       *string `json:"content"`
+	Level         *string `json:"level"`
+	Role          *string `json:"role"`
+	Language      *string `json:"language"`
+	TopicID       *string `json:"topic_id" binding:"omitempty,uuid"`
+	Hint          *string `json:"hint"`
+	CorrectAnswer *string `json:"correct_answer"`
+}
+
+type ApproveCrawledRequest struct {
+	// AllowDuplicate approves the item despite a near-duplicate rejection
+	AllowDuplicate bool `json:"allow_duplicate"`
+}
+
+type RejectCrawledRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type BulkApproveCrawledRequest struct {
+	IDs            []uuid.UUID `json:"ids" binding:"required"`
+	AllowDuplicate bool        `json:"allow_duplicate"`
+}
+
+type BulkRejectCrawledRequest struct {
+	IDs    []uuid.UUID `json:"ids" binding:"required"`
+	Reason string      `json:"reason" binding:"required"`
+}
+
+// ApproveCrawledResponse is the promoted question plus any existing questions it resembles
+type ApproveCrawledResponse struct {
+	*domain.Question
+	PossibleDuplicates []domain.DuplicateMatch `json:"possible_duplicates,omitempty"`
+}
+
+// writeReviewError maps review service errors to HTTP status codes
+func writeReviewError(c *gin.Context, err error) {
+	var dupErr *domain.DuplicateError
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.As(err, &dupErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "duplicates": dupErr.Matches})
+	case strings.HasPrefix(err.Error(), "crawled question already reviewed"):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "crawled question not found"):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "invalid"), strings.HasPrefix(err.Error(), "topic not found"):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// ListCrawled godoc
+// @Summary List crawled questions
+// @Description List the crawler's staged questions, oldest first. Shows pending items unless another status is asked for.
+// @Tags review
+// @Produce json
+// @Param status query string false "pending (default), approved or rejected"
+// @Param source query string false "Crawler source"
+// @Param topic query string false "Detected topic"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Items to skip"
+// @Success 200 {object} domain.CrawledPage
+// @Router /crawled-questions [get]
+func (h *ReviewHandler) ListCrawled(c *gin.Context) {
+	filter := domain.CrawledFilter{
+		Status: c.Query("status"),
+		Source: c.Query("source"),
+		Topic:  c.Query("topic"),
+	}
+	for param, dest := range map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset} {
+		if v := c.Query(param); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + param})
+				return
+			}
+			*dest = n
+		}
+	}
+
+	page, err := h.service.ListCrawled(c.Request.Context(), filter)
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetCrawled godoc
+// @Summary Get a crawled question
+// @Description Get a crawled question with both its crawled values and its reviewed values
+// @Tags review
+// @Produce json
+// @Param id path string true "Crawled question ID"
+// @Success 200 {object} domain.CrawledQuestion
+// @Router /crawled-questions/{id} [get]
+func (h *ReviewHandler) GetCrawled(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	item, err := h.service.GetCrawled(c.Request.Context(), id)
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// UpdateCrawled godoc
+// @Summary Edit a crawled question
+// @Description Edit a pending crawled question before approving it. The crawled values are kept in raw_title, raw_content and detected_*.
+// @Tags review
+// @Accept json
+// @Produce json
+// @Param id path string true "Crawled question ID"
+// @Param item body UpdateCrawledRequest true "Fields to change"
+// @Success 200 {object} domain.CrawledQuestion
+// @Failure 409 {object} map[string]interface{}
+// @Router /crawled-questions/{id} [patch]
+func (h *ReviewHandler) UpdateCrawled(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req UpdateCrawledRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	patch := domain.CrawledPatch{
+		Title:         req.Title,
+		Content:       req.Content,
+		Level:         req.Level,
+		Role:          req.Role,
+		Language:      req.Language,
+		Hint:          req.Hint,
+		CorrectAnswer: req.CorrectAnswer,
+	}
+	if req.TopicID != nil {
+		topicID, err := uuid.Parse(*req.TopicID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid topic_id"})
+			return
+		}
+		patch.TopicID = &topicID
+	}
+
+	item, err := h.service.UpdateCrawled(c.Request.Context(), id, patch, roleFromContext(c))
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// ApproveCrawled godoc
+// @Summary Approve a crawled question
+// @Description Promote a pending crawled question into a published question. The topic is topic_id if a reviewer set it, otherwise the detected topic resolved by name, slug or alias. Near-duplicates are rejected with 409 unless allow_duplicate is set.
+// @Tags review
+// @Accept json
+// @Produce json
+// @Param id path string true "Crawled question ID"
+// @Param options body ApproveCrawledRequest false "Approval options"
+// @Success 201 {object} ApproveCrawledResponse
+// @Failure 409 {object} map[string]interface{}
+// @Router /crawled-questions/{id}/approve [post]
+func (h *ReviewHandler) ApproveCrawled(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req ApproveCrawledRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	question, duplicates, err := h.service.ApproveCrawled(c.Request.Context(), id, req.AllowDuplicate, actorID, roleFromContext(c))
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ApproveCrawledResponse{Question: question, PossibleDuplicates: duplicates})
+}
+
+// RejectCrawled godoc
+// @Summary Reject a crawled question
+// @Description Reject a pending crawled question, recording the reason
+// @Tags review
+// @Accept json
+// @Produce json
+// @Param id path string true "Crawled question ID"
+// @Param rejection body RejectCrawledRequest true "Rejection reason"
+// @Success 200 {object} domain.CrawledQuestion
+// @Failure 409 {object} map[string]interface{}
+// @Router /crawled-questions/{id}/reject [post]
+func (h *ReviewHandler) RejectCrawled(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req RejectCrawledRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	item, err := h.service.RejectCrawled(c.Request.Context(), id, req.Reason, actorID, roleFromContext(c))
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// BulkApproveCrawled godoc
+// @Summary Approve crawled questions in bulk
+// @Description Approve up to 100 pending crawled questions in the given order. Items are approved independently and failures are reported per item.
+// @Tags review
+// @Accept json
+// @Produce json
+// @Param items body BulkApproveCrawledRequest true "IDs to approve"
+// @Success 200 {object} domain.BulkReviewResult
+// @Router /crawled-questions/approve [post]
+func (h *ReviewHandler) BulkApproveCrawled(c *gin.Context) {
+	var req BulkApproveCrawledRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	result, err := h.service.BulkApproveCrawled(c.Request.Context(), req.IDs, req.AllowDuplicate, actorID, roleFromContext(c))
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// BulkRejectCrawled godoc
+// @Summary Reject crawled questions in bulk
+// @Description Reject up to 100 pending crawled questions with one reason. Failures are reported per item.
+// @Tags review
+// @Accept json
+// @Produce json
+// @Param items body BulkRejectCrawledRequest true "IDs to reject and the reason"
+// @Success 200 {object} domain.BulkReviewResult
+// @Router /crawled-questions/reject [post]
+func (h *ReviewHandler) BulkRejectCrawled(c *gin.Context) {
+	var req BulkRejectCrawledRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	result, err := h.service.BulkRejectCrawled(c.Request.Context(), req.IDs, req.Reason, actorID, roleFromContext(c))
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+type ClassifyPendingRequest struct {
+	// Limit caps how many items are classified (default and max 100)
+	Limit int `json:"limit"`
+	// Reclassify also classifies items that were classified before
+	Reclassify bool `json:"reclassify"`
+}
+
+// ClassifyCrawled godoc
+// @Summary Classify a crawled question
+// @Description Propose a topic, level and role with a confidence for each, using keyword and topic alias rules plus the AI service when configured. The proposal fills in any field a reviewer has not edited.
+// @Tags review
+// @Produce json
+// @Param id path string true "Crawled question ID"
+// @Success 200 {object} domain.CrawledQuestion
+// @Router /crawled-questions/{id}/classify [post]
+func (h *ReviewHandler) ClassifyCrawled(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	item, err := h.service.ClassifyCrawled(c.Request.Context(), id, roleFromContext(c))
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// ClassifyPending godoc
+// @Summary Classify pending crawled questions
+// @Description Classify pending crawled questions oldest first. Items whose confidence reaches CLASSIFY_AUTO_APPROVE_THRESHOLD are approved into published questions unless they are near-duplicates; the rest wait for review.
+// @Tags review
+// @Accept json
+// @Produce json
+// @Param options body ClassifyPendingRequest false "Classification options"
+// @Success 200 {object} domain.ClassifyResult
+// @Router /crawled-questions/classify [post]
+func (h *ReviewHandler) ClassifyPending(c *gin.Context) {
+	var req ClassifyPendingRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	result, err := h.service.ClassifyPending(c.Request.Context(), req.Limit, req.Reclassify, actorID, roleFromContext(c))
+	if err != nil {
+		writeReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *ReviewHandler) RegisterRoutes(router *gin.Engine) {
+	v1 := router.Group("/api/v1")
+	{
+		v1.GET("/crawled-questions", RequireAuth(), h.ListCrawled)
+		v1.POST("/crawled-questions/approve", RequireAuth(), h.BulkApproveCrawled)
+		v1.POST("/crawled-questions/reject", RequireAuth(), h.BulkRejectCrawled)
+		v1.POST("/crawled-questions/classify", RequireAuth(), h.ClassifyPending)
+		v1.GET("/crawled-questions/:id", RequireAuth(), h.GetCrawled)
+		v1.PATCH("/crawled-questions/:id", RequireAuth(), h.UpdateCrawled)
+		v1.POST("/crawled-questions/:id/approve", RequireAuth(), h.ApproveCrawled)
+		v1.POST("/crawled-questions/:id/reject", RequireAuth(), h.RejectCrawled)
+		v1.POST("/crawled-questions/:id/classify", RequireAuth(), h.ClassifyCrawled)
+	}
+}
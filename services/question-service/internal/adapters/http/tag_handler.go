@@ -0,0 +1,203 @@
+package http_adapter
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TagRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+type SetQuestionTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+// tagsQuery reads tag filters given as ?tags=a,b and/or repeated ?tags=a&tags=b
+func tagsQuery(c *gin.Context) []string {
+	var tags []string
+	for _, v := range c.QueryArray("tags") {
+		for _, t := range strings.Split(v, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				tags = append(tags, t)
+			}
+		}
+	}
+	return tags
+}
+
+// writeTagError maps tag service errors to HTTP status codes
+func writeTagError(c *gin.Context, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "tag not found"):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "tag already exists"):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "invalid tag name"):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// CreateTag godoc
+// @Summary Create a tag
+// @Description Create a tag; its slug is derived from the name
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body TagRequest true "Tag Data"
+// @Success 201 {object} domain.Tag
+// @Router /tags [post]
+func (h *QuestionHandler) CreateTag(c *gin.Context) {
+	var req TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag, err := h.service.CreateTag(c.Request.Context(), req.Name, req.Description)
+	if err != nil {
+		writeTagError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, tag)
+}
+
+// ListTags godoc
+// @Summary List tags
+// @Description List all tags
+// @Tags tags
+// @Produce json
+// @Success 200 {array} domain.Tag
+// @Router /tags [get]
+func (h *QuestionHandler) ListTags(c *gin.Context) {
+	tags, err := h.service.ListTags(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// GetTag godoc
+// @Summary Get a tag by ID
+// @Description Get a tag by ID
+// @Tags tags
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Success 200 {object} domain.Tag
+// @Router /tags/{id} [get]
+func (h *QuestionHandler) GetTag(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	tag, err := h.service.GetTag(c.Request.Context(), id)
+	if err != nil {
+		writeTagError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// UpdateTag godoc
+// @Summary Update a tag
+// @Description Rename a tag (the slug follows the name) and replace its description
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Param tag body TagRequest true "Tag Data"
+// @Success 200 {object} domain.Tag
+// @Router /tags/{id} [put]
+func (h *QuestionHandler) UpdateTag(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag, err := h.service.UpdateTag(c.Request.Context(), id, req.Name, req.Description)
+	if err != nil {
+		writeTagError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// DeleteTag godoc
+// @Summary Delete a tag
+// @Description Delete a tag and remove it from all questions
+// @Tags tags
+// @Param id path string true "Tag ID"
+// @Success 204
+// @Router /tags/{id} [delete]
+func (h *QuestionHandler) DeleteTag(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	if err := h.service.DeleteTag(c.Request.Context(), id); err != nil {
+		writeTagError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetQuestionTags godoc
+// @Summary Replace the tags of a question
+// @Description Replace a question's tags with existing tags given by slug or name (same permissions as editing the question)
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param tags body SetQuestionTagsRequest true "Tag slugs"
+// @Success 200 {object} domain.Question
+// @Router /questions/{id}/tags [put]
+func (h *QuestionHandler) SetQuestionTags(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req SetQuestionTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	question, err := h.service.SetQuestionTags(c.Request.Context(), id, req.Tags, actorID, roleFromContext(c))
+	if err != nil {
+		writeQuestionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, question)
+}
@@ -0,0 +1,164 @@
+package http_adapter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/adapters/transfer"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// maxImportSize caps an uploaded import file
+const maxImportSize = 32 << 20
+
+// ImportQuestions godoc
+// @Summary Import questions
+// @Description Bulk create or update questions from JSON Lines, CSV or Markdown with YAML front matter. Records whose external_id matches an existing question update it. Topics are resolved by name, slug or alias and tags by slug. Rows are imported independently and failures are reported per row.
+// @Tags questions
+// @Accept plain
+// @Accept multipart/form-data
+// @Produce json
+// @Param format query string false "jsonl, csv or markdown (defaults to the uploaded file's extension)"
+// @Param dry_run query bool false "Validate without writing"
+// @Param file formData file false "Import file (alternatively send the file as the request body)"
+// @Success 200 {object} domain.ImportResult
+// @Router /questions/import [post]
+func (h *QuestionHandler) ImportQuestions(c *gin.Context) {
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid dry_run"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+	body, filename, err := importBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var format string
+	switch {
+	case c.Query("format") != "":
+		format, err = transfer.ParseFormat(c.Query("format"))
+	case filename != "":
+		format, err = transfer.FormatFromFilename(filename)
+	default:
+		err = fmt.Errorf("format is required")
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	records, rowErrors, err := transfer.Decode(format, bytes.NewReader(body))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.service.ImportQuestions(c.Request.Context(), records, actorID, roleFromContext(c), dryRun)
+	if err != nil {
+		writeQuestionError(c, err)
+		return
+	}
+	result.AddRowErrors(rowErrors)
+
+	c.JSON(http.StatusOK, result)
+}
+
+// importBody reads the import file from a multipart "file" field or, failing that, the raw body
+func importBody(c *gin.Context) ([]byte, string, error) {
+	if fileHeader, err := c.FormFile("file"); err == nil {
+		f, err := fileHeader.Open()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to open uploaded file: %w", err)
+		}
+		defer f.Close()
+		body, err := io.ReadAll(f)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read uploaded file: %w", err)
+		}
+		return body, fileHeader.Filename, nil
+	} else if !errors.Is(err, http.ErrNotMultipart) && !errors.Is(err, http.ErrMissingFile) {
+		return nil, "", fmt.Errorf("failed to read upload: %w", err)
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read request body: %w", err)
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, "", fmt.Errorf("import file is empty")
+	}
+	return body, "", nil
+}
+
+// ExportQuestions godoc
+// @Summary Export questions
+// @Description Export all questions matching the filters, oldest first, in a format ImportQuestions accepts. Questions without an external_id are exported under their ID.
+// @Tags questions
+// @Produce plain
+// @Param format query string false "jsonl (default), csv or markdown"
+// @Param topic_id query string false "Topic ID (includes descendant topics)"
+// @Param level query string false "Level"
+// @Param language query string false "Language (en, vi)"
+// @Param role query string false "Role"
+// @Param status query string false "Status (draft, review, published, archived)"
+// @Param tags query string false "Comma-separated tag slugs; questions must carry all of them"
+// @Success 200 {file} file
+// @Router /questions/export [get]
+func (h *QuestionHandler) ExportQuestions(c *gin.Context) {
+	format, err := transfer.ParseFormat(c.DefaultQuery("format", domain.FormatJSONL))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := domain.QuestionFilter{
+		Level:    c.Query("level"),
+		Language: c.Query("language"),
+		Role:     c.Query("role"),
+		Status:   c.Query("status"),
+		Tags:     tagsQuery(c),
+	}
+	if v := c.Query("topic_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid topic_id"})
+			return
+		}
+		filter.TopicID = &id
+	}
+
+	records, err := h.service.ExportQuestions(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Encode before writing headers so an encoding failure can still be reported as JSON
+	var buf bytes.Buffer
+	if err := transfer.Encode(format, &buf, records); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	extension := format
+	if format == domain.FormatMarkdown {
+		extension = "md"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="questions.%s"`, extension))
+	c.Data(http.StatusOK, transfer.ContentType(format), buf.Bytes())
+}
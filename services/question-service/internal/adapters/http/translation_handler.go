@@ -0,0 +1,174 @@
+package http_adapter
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+type CreateTranslationRequest struct {
+	Language      string `json:"language" binding:"required"`
+	Title         string `json:"title" binding:"required"`
+	Content       string `json:"content" binding:"required"`
+	Hint          string `json:"hint"`
+	CorrectAnswer string `json:"correct_answer"`
+}
+
+// writeTranslationError maps translation service errors to HTTP status codes
+func writeTranslationError(c *gin.Context, err error) {
+	var dupErr *domain.DuplicateError
+	switch {
+	case errors.As(err, &dupErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "duplicates": dupErr.Matches})
+	case strings.HasPrefix(err.Error(), "translation already exists"), strings.HasPrefix(err.Error(), "translation group conflict"):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "invalid translation"):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		writeQuestionError(c, err)
+	}
+}
+
+// ListTranslations godoc
+// @Summary List the translations of a question
+// @Description List every language version of a question (its translation group), canonical question first
+// @Tags questions
+// @Produce json
+// @Param id path string true "Question ID"
+// @Success 200 {array} domain.Question
+// @Router /questions/{id}/translations [get]
+func (h *QuestionHandler) ListTranslations(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	translations, err := h.service.ListTranslations(c.Request.Context(), id)
+	if err != nil {
+		writeTranslationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, translations)
+}
+
+// CreateTranslation godoc
+// @Summary Translate a question
+// @Description Create a draft of the question in another language. It joins the question's translation group and copies its topic, level, role and tags.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param translation body CreateTranslationRequest true "Translated content"
+// @Success 201 {object} domain.Question
+// @Failure 409 {object} map[string]interface{}
+// @Router /questions/{id}/translations [post]
+func (h *QuestionHandler) CreateTranslation(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var req CreateTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	translation, err := h.service.CreateTranslation(c.Request.Context(), id, req.Language, req.Title, req.Content, req.Hint, req.CorrectAnswer, actorID)
+	if err != nil {
+		writeTranslationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, translation)
+}
+
+// LinkTranslation godoc
+// @Summary Link an existing question as a translation
+// @Description Add an existing question in another language to this question's translation group. The linked question must not have translations of its own.
+// @Tags questions
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param translation_id path string true "ID of the question to link"
+// @Success 200 {object} domain.Question
+// @Failure 409 {object} map[string]interface{}
+// @Router /questions/{id}/translations/{translation_id} [put]
+func (h *QuestionHandler) LinkTranslation(c *gin.Context) {
+	id, translationID, ok := translationParams(c)
+	if !ok {
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	translation, err := h.service.LinkTranslation(c.Request.Context(), id, translationID, actorID, roleFromContext(c))
+	if err != nil {
+		writeTranslationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, translation)
+}
+
+// UnlinkTranslation godoc
+// @Summary Unlink a translation
+// @Description Detach a question from this question's translation group; it becomes a standalone question
+// @Tags questions
+// @Produce json
+// @Param id path string true "Question ID"
+// @Param translation_id path string true "ID of the translation to unlink"
+// @Success 200 {object} domain.Question
+// @Failure 409 {object} map[string]interface{}
+// @Router /questions/{id}/translations/{translation_id} [delete]
+func (h *QuestionHandler) UnlinkTranslation(c *gin.Context) {
+	id, translationID, ok := translationParams(c)
+	if !ok {
+		return
+	}
+
+	actorID, ok := userIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	translation, err := h.service.UnlinkTranslation(c.Request.Context(), id, translationID, actorID, roleFromContext(c))
+	if err != nil {
+		writeTranslationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, translation)
+}
+
+// translationParams parses the question and translation IDs from the path
+func translationParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	translationID, err := uuid.Parse(c.Param("translation_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid translation ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	return id, translationID, true
+}
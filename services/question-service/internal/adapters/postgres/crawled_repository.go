@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+type CrawledQuestionRepository struct {
+	db *sql.DB
+}
+
+func NewCrawledQuestionRepository(db *sql.DB) ports.CrawledQuestionRepository {
+	return &CrawledQuestionRepository{
+		db: db,
+	}
+}
+
+// crawledColumns reads reviewer edits where there are any, then the classifier's proposals, then the crawled values
+const crawledColumns = `
+	id, source, COALESCE(url, ''), raw_title, COALESCE(raw_content, ''),
+	COALESCE(detected_topic, ''), COALESCE(detected_level, ''), COALESCE(detected_role, ''),
+	COALESCE(title, raw_title), COALESCE(content, raw_content, ''),
+	COALESCE(level, suggested_level, detected_level, ''), COALESCE(role, suggested_role, detected_role, ''),
+	language, COALESCE(topic_id, suggested_topic_id), COALESCE(hint, ''), COALESCE(correct_answer, ''),
+	status, COALESCE(rejection_reason, ''), reviewed_by, reviewed_at, question_id,
+	COALESCE(created_at, CURRENT_TIMESTAMP), COALESCE(updated_at, created_at, CURRENT_TIMESTAMP),
+	suggested_topic_id, COALESCE((SELECT t.name FROM topics t WHERE t.id = suggested_topic_id), ''),
+	COALESCE(topic_confidence, 0), COALESCE(suggested_level, ''), COALESCE(level_confidence, 0),
+	COALESCE(suggested_role, ''), COALESCE(role_confidence, 0), COALESCE(classified_by, ''), classified_at`
+
+func scanCrawled(row rowScanner) (*domain.CrawledQuestion, error) {
+	var c domain.CrawledQuestion
+	var topicID, reviewedBy, questionID uuid.NullUUID
+	var reviewedAt, classifiedAt sql.NullTime
+	var suggested domain.Classification
+	var suggestedTopicID uuid.NullUUID
+	err := row.Scan(
+		&c.ID, &c.Source, &c.URL, &c.RawTitle, &c.RawContent,
+		&c.DetectedTopic, &c.DetectedLevel, &c.DetectedRole,
+		&c.Title, &c.Content, &c.Level, &c.Role,
+		&c.Language, &topicID, &c.Hint, &c.CorrectAnswer,
+		&c.Status, &c.RejectionReason, &reviewedBy, &reviewedAt, &questionID,
+		&c.CreatedAt, &c.UpdatedAt,
+		&suggestedTopicID, &suggested.Topic, &suggested.TopicConfidence, &suggested.Level, &suggested.LevelConfidence,
+		&suggested.Role, &suggested.RoleConfidence, &suggested.Method, &classifiedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if classifiedAt.Valid {
+		suggested.ClassifiedAt = classifiedAt.Time
+		if suggestedTopicID.Valid {
+			suggested.TopicID = &suggestedTopicID.UUID
+		}
+		c.Classification = &suggested
+	}
+	if topicID.Valid {
+		c.TopicID = &topicID.UUID
+	}
+	if reviewedBy.Valid {
+		c.ReviewedBy = &reviewedBy.UUID
+	}
+	if reviewedAt.Valid {
+		c.ReviewedAt = &reviewedAt.Time
+	}
+	if questionID.Valid {
+		c.QuestionID = &questionID.UUID
+	}
+	return &c, nil
+}
+
+func (r *CrawledQuestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CrawledQuestion, error) {
+	item, err := scanCrawled(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+crawledColumns+` FROM crawled_questions WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("crawled question not found")
+		}
+		return nil, fmt.Errorf("failed to get crawled question: %w", err)
+	}
+	return item, nil
+}
+
+// List returns one page of crawled questions, oldest first
+func (r *CrawledQuestionRepository) List(ctx context.Context, filter domain.CrawledFilter) (*domain.CrawledPage, error) {
+	whereClauses := []string{"status = $1"}
+	args := []interface{}{filter.Status}
+	argIdx := 2
+
+	if filter.Source != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("source = $%d", argIdx))
+		args = append(args, filter.Source)
+		argIdx++
+	}
+	if filter.Topic != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("lower(detected_topic) = lower($%d)", argIdx))
+		args = append(args, filter.Topic)
+		argIdx++
+	}
+	if filter.Unclassified {
+		whereClauses = append(whereClauses, "classified_at IS NULL")
+	}
+	whereStr := " WHERE " + strings.Join(whereClauses, " AND ")
+
+	var total int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM crawled_questions"+whereStr, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count crawled questions: %w", err)
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM crawled_questions%s ORDER BY created_at ASC, id ASC LIMIT $%d OFFSET $%d`,
+		crawledColumns, whereStr, argIdx, argIdx+1)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list crawled questions: %w", err)
+	}
+	defer rows.Close()
+
+	page := &domain.CrawledPage{Items: []*domain.CrawledQuestion{}, Total: total}
+	for rows.Next() {
+		item, err := scanCrawled(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan crawled question: %w", err)
+		}
+		page.Items = append(page.Items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return page, nil
+}
+
+// Update stores the reviewer's edits of a pending item. Values equal to what the classifier or the
+// crawler proposed are stored as NULL, so the row keeps recording which fields a reviewer actually changed.
+func (r *CrawledQuestionRepository) Update(ctx context.Context, item *domain.CrawledQuestion) error {
+	query := `
+		UPDATE crawled_questions
+		SET title = NULLIF($2, raw_title),
+			content = NULLIF($3, COALESCE(raw_content, '')),
+			level = NULLIF($4, COALESCE(suggested_level, detected_level, '')),
+			role = NULLIF($5, COALESCE(suggested_role, detected_role, '')),
+			language = $6,
+			topic_id = CASE WHEN $7::uuid IS NOT DISTINCT FROM suggested_topic_id THEN NULL ELSE $7::uuid END,
+			hint = NULLIF($8, ''),
+			correct_answer = NULLIF($9, ''),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending'
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		item.ID, item.Title, item.Content, item.Level, item.Role, item.Language, item.TopicID, item.Hint, item.CorrectAnswer)
+	if err != nil {
+		return fmt.Errorf("failed to update crawled question: %w", err)
+	}
+	return checkPendingUpdated(result)
+}
+
+// SaveClassification stores the classifier's proposal for a pending item
+func (r *CrawledQuestionRepository) SaveClassification(ctx context.Context, id uuid.UUID, c *domain.Classification) error {
+	query := `
+		UPDATE crawled_questions
+		SET suggested_topic_id = $2, topic_confidence = $3,
+			suggested_level = NULLIF($4, ''), level_confidence = $5,
+			suggested_role = NULLIF($6, ''), role_confidence = $7,
+			classified_by = $8, classified_at = $9
+		WHERE id = $1 AND status = 'pending'
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		id, c.TopicID, c.TopicConfidence, c.Level, c.LevelConfidence, c.Role, c.RoleConfidence, c.Method, c.ClassifiedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save classification: %w", err)
+	}
+	return checkPendingUpdated(result)
+}
+
+// Reject records the rejection of a pending item
+func (r *CrawledQuestionRepository) Reject(ctx context.Context, item *domain.CrawledQuestion) error {
+	query := `
+		UPDATE crawled_questions
+		SET status = $2, rejection_reason = $3, reviewed_by = $4, reviewed_at = $5, updated_at = $6
+		WHERE id = $1 AND status = 'pending'
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		item.ID, item.Status, item.RejectionReason, item.ReviewedBy, item.ReviewedAt, item.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to reject crawled question: %w", err)
+	}
+	return checkPendingUpdated(result)
+}
+
+// Promote inserts the question and marks the item approved in one transaction, so an item
+// reviewed concurrently by someone else does not produce a second question
+func (r *CrawledQuestionRepository) Promote(ctx context.Context, item *domain.CrawledQuestion, question *domain.Question) error {
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		tx := conn(ctx, r.db)
+		if err := insertQuestion(ctx, tx, question); err != nil {
+			return err
+		}
+		result, err := tx.ExecContext(ctx, `
+			UPDATE crawled_questions
+			SET status = $2, question_id = $3, reviewed_by = $4, reviewed_at = $5, updated_at = $6
+			WHERE id = $1 AND status = 'pending'
+		`, item.ID, item.Status, question.ID, item.ReviewedBy, item.ReviewedAt, item.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to approve crawled question: %w", err)
+		}
+		return checkPendingUpdated(result)
+	})
+}
+
+// checkPendingUpdated reports an item that left the pending state between being read and written
+func checkPendingUpdated(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("crawled question already reviewed")
+	}
+	return nil
+}
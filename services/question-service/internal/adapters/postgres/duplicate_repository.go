@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// questionDocument is the indexed expression from migration 000019; queries must repeat it verbatim to use the index
+const questionDocument = "lower(COALESCE(%[1]s.title, '') || ' ' || %[1]s.content)"
+
+// withSimilarityThreshold runs fn in a transaction whose pg_trgm '%' operator matches at threshold,
+// so trigram lookups can use the GIN index instead of computing similarity() for every row
+func (r *QuestionRepository) withSimilarityThreshold(ctx context.Context, threshold float64, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('pg_trgm.similarity_threshold', $1, true)", strconv.FormatFloat(threshold, 'f', -1, 64)); err != nil {
+		return fmt.Errorf("failed to set similarity threshold: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// FindSimilar returns non-archived questions in the same language whose title + content
+// reach threshold similarity to the given text, most similar first
+func (r *QuestionRepository) FindSimilar(ctx context.Context, title, content, language string, threshold float64, limit int) ([]domain.DuplicateMatch, error) {
+	doc := fmt.Sprintf(questionDocument, "q")
+	query := fmt.Sprintf(`
+		SELECT q.id, COALESCE(q.title, ''), q.status, similarity(%[1]s, lower($1)) AS sim
+		FROM questions q
+		WHERE %[1]s %% lower($1) AND q.language = $2 AND q.status <> 'archived'
+		ORDER BY sim DESC
+		LIMIT $3
+	`, doc)
+
+	matches := []domain.DuplicateMatch{}
+	err := r.withSimilarityThreshold(ctx, threshold, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, title+" "+content, language, limit)
+		if err != nil {
+			return fmt.Errorf("failed to find similar questions: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var m domain.DuplicateMatch
+			if err := rows.Scan(&m.QuestionID, &m.Title, &m.Status, &m.Similarity); err != nil {
+				return fmt.Errorf("failed to scan similar question: %w", err)
+			}
+			matches = append(matches, m)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// ListSimilarPairs returns up to limit pairs of non-archived same-language questions reaching
+// threshold similarity, most similar first
+func (r *QuestionRepository) ListSimilarPairs(ctx context.Context, threshold float64, limit int) ([]domain.SimilarPair, error) {
+	docA, docB := fmt.Sprintf(questionDocument, "a"), fmt.Sprintf(questionDocument, "b")
+	query := fmt.Sprintf(`
+		SELECT a.id, COALESCE(a.title, ''), a.status, b.id, COALESCE(b.title, ''), b.status, similarity(%[1]s, %[2]s) AS sim
+		FROM questions a
+		JOIN questions b ON a.id < b.id AND %[2]s %% %[1]s AND a.language = b.language
+		WHERE a.status <> 'archived' AND b.status <> 'archived'
+		ORDER BY sim DESC
+		LIMIT $1
+	`, docA, docB)
+
+	pairs := []domain.SimilarPair{}
+	err := r.withSimilarityThreshold(ctx, threshold, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, limit)
+		if err != nil {
+			return fmt.Errorf("failed to list similar questions: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var p domain.SimilarPair
+			if err := rows.Scan(&p.A.QuestionID, &p.A.Title, &p.A.Status, &p.B.QuestionID, &p.B.Title, &p.B.Status, &p.Similarity); err != nil {
+				return fmt.Errorf("failed to scan similar pair: %w", err)
+			}
+			pairs = append(pairs, p)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
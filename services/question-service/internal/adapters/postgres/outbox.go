@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// insertEvent appends an event to the outbox; pass the transaction of the change the event describes
+func insertEvent(ctx context.Context, db dbtx, event domain.Event) error {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event payload: %w", err)
+	}
+	// outbox_emit (migration 000036) assigns the event's ID and time
+	_, err = db.ExecContext(ctx, `SELECT outbox_emit($1, $2, $3, $4)`, event.AggregateType, event.AggregateID, event.Type, payload)
+	if err != nil {
+		return fmt.Errorf("failed to write outbox event: %w", err)
+	}
+	return nil
+}
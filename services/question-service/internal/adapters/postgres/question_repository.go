@@ -3,10 +3,15 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/question-interviewer/question-service/internal/domain"
 	"github.com/question-interviewer/question-service/internal/ports"
@@ -23,11 +28,19 @@ func NewQuestionRepository(db *sql.DB) ports.QuestionRepository {
 }
 
 func (r *QuestionRepository) Create(ctx context.Context, question *domain.Question) error {
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		return insertQuestion(ctx, conn(ctx, r.db), question)
+	})
+}
+
+// insertQuestion inserts the question and its question.created (and question.published) events;
+// run it in a transaction
+func insertQuestion(ctx context.Context, db dbtx, question *domain.Question) error {
 	query := `
-		INSERT INTO questions (id, title, content, level, language, role, hint, correct_answer, topic_id, created_by, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		INSERT INTO questions (id, title, content, level, language, role, hint, correct_answer, topic_id, created_by, status, created_at, updated_at, external_id, translation_group_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULLIF($14, ''), $15)
 	`
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := db.ExecContext(ctx, query,
 		question.ID,
 		question.Title,
 		question.Content,
@@ -41,14 +54,50 @@ func (r *QuestionRepository) Create(ctx context.Context, question *domain.Questi
 		question.Status,
 		question.CreatedAt,
 		question.UpdatedAt,
+		question.ExternalID,
+		question.TranslationGroupID,
 	)
 	if err != nil {
+		if isTranslationConflict(err) {
+			return translationExistsError(question.Language)
+		}
 		return fmt.Errorf("failed to create question: %w", err)
 	}
+	for _, event := range domain.NewQuestionInsertedEvents(question) {
+		if err := insertEvent(ctx, db, event); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (r *QuestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Question, error) {
+	return r.getOne(ctx, "id = $1 LIMIT 1", id)
+}
+
+// GetByIDForUpdate reads a question and locks its row until the transaction in ctx ends,
+// so concurrent edits apply one after the other instead of overwriting each other
+func (r *QuestionRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Question, error) {
+	return r.getOne(ctx, "id = $1 LIMIT 1 FOR UPDATE", id)
+}
+
+// GetByExternalID finds a question by its import external ID, or by its own ID so that
+// exports of questions without an external ID upsert cleanly when re-imported. The two
+// lookups are separate so each uses its index (an OR across the columns scans the table).
+func (r *QuestionRepository) GetByExternalID(ctx context.Context, externalID string) (*domain.Question, error) {
+	question, err := r.getOne(ctx, "external_id = $1", externalID)
+	if err == nil || err.Error() != "question not found" {
+		return question, err
+	}
+	id, parseErr := uuid.Parse(externalID)
+	if parseErr != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+// getOne reads the first question matching where, which may end in LIMIT and locking clauses
+func (r *QuestionRepository) getOne(ctx context.Context, where string, arg interface{}) (*domain.Question, error) {
 	query := `
 		SELECT 
 			id, 
@@ -63,11 +112,13 @@ func (r *QuestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 			COALESCE(created_by, '00000000-0000-0000-0000-000000000000') AS created_by, 
 			status, 
 			created_at, 
-			updated_at
+			updated_at,
+			COALESCE(external_id, '') AS external_id,
+			translation_group_id
 		FROM questions
-		WHERE id = $1
+		WHERE ` + where + `
 	`
-	row := r.db.QueryRowContext(ctx, query, id)
+	row := conn(ctx, r.db).QueryRowContext(ctx, query, arg)
 
 	var q domain.Question
 	err := row.Scan(
@@ -84,6 +135,8 @@ func (r *QuestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 		&q.Status,
 		&q.CreatedAt,
 		&q.UpdatedAt,
+		&q.ExternalID,
+		&q.TranslationGroupID,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -94,35 +147,182 @@ func (r *QuestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 	return &q, nil
 }
 
-func (r *QuestionRepository) List(ctx context.Context, limit, offset int) ([]*domain.Question, error) {
-	query := `
-		SELECT 
-			id, 
-			title, 
-			content, 
-			level, 
-			language, 
-			COALESCE(role, '') AS role,
-			COALESCE(hint, '') AS hint,
-			COALESCE(correct_answer, '') AS correct_answer,
-			topic_id, 
-			COALESCE(created_by, '00000000-0000-0000-0000-000000000000') AS created_by, 
-			status, 
-			created_at, 
-			updated_at
-		FROM questions
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+// topicSubtreeClause matches questions in topic $argIdx or any of its descendants
+func topicSubtreeClause(argIdx int) string {
+	return fmt.Sprintf(`q.topic_id IN (
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM topics WHERE id = $%d
+			UNION
+			SELECT c.id FROM topics c JOIN subtree s ON c.parent_id = s.id
+		) SELECT id FROM subtree)`, argIdx)
+}
+
+// tagFilterClause matches questions carrying every tag slug in $argIdx (a distinct list whose length is $argIdx+1)
+func tagFilterClause(argIdx int) string {
+	return fmt.Sprintf(`q.id IN (
+		SELECT qt.question_id FROM question_tags qt JOIN tags t ON t.id = qt.tag_id
+		WHERE t.slug = ANY($%d) GROUP BY qt.question_id HAVING COUNT(*) = $%d)`, argIdx, argIdx+1)
+}
+
+// listCursor is the decoded form of QuestionPage.NextCursor
+type listCursor struct {
+	Sort string    `json:"s"`
+	Key  string    `json:"k"`
+	ID   uuid.UUID `json:"id"`
+}
+
+func encodeCursor(c listCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	return c, nil
+}
+
+// sortColumns maps sort keys to their SQL expression and the type used to compare cursor keys
+var sortColumns = map[string]struct{ expr, cast string }{
+	domain.SortCreatedAt:  {"q.created_at", "timestamptz"},
+	domain.SortUpdatedAt:  {"q.updated_at", "timestamptz"},
+	domain.SortPopularity: {"(SELECT COUNT(*) FROM practice_attempts pa WHERE pa.question_id = q.id)", "bigint"},
+}
+
+func (r *QuestionRepository) List(ctx context.Context, filter domain.QuestionFilter) (*domain.QuestionPage, error) {
+	sortCol, ok := sortColumns[filter.Sort]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort: %s", filter.Sort)
+	}
+
+	whereClauses := []string{}
+	args := []interface{}{}
+	argIdx := 1
+
+	if filter.TopicID != nil {
+		whereClauses = append(whereClauses, topicSubtreeClause(argIdx))
+		args = append(args, *filter.TopicID)
+		argIdx++
+	}
+	if filter.Level != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.level = $%d", argIdx))
+		args = append(args, filter.Level)
+		argIdx++
+	}
+	if filter.Language != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.language = $%d", argIdx))
+		args = append(args, filter.Language)
+		argIdx++
+	}
+	if filter.Role != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.role = $%d", argIdx))
+		args = append(args, filter.Role)
+		argIdx++
+	}
+	if filter.Status != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.status = $%d", argIdx))
+		args = append(args, filter.Status)
+		argIdx++
+	}
+	if filter.CreatedBy != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.created_by = $%d", argIdx))
+		args = append(args, *filter.CreatedBy)
+		argIdx++
+	}
+	if filter.CreatedFrom != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.created_at >= $%d", argIdx))
+		args = append(args, *filter.CreatedFrom)
+		argIdx++
+	}
+	if filter.CreatedTo != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("q.created_at < $%d", argIdx))
+		args = append(args, *filter.CreatedTo)
+		argIdx++
+	}
+	if len(filter.Tags) > 0 {
+		whereClauses = append(whereClauses, tagFilterClause(argIdx))
+		args = append(args, filter.Tags, len(filter.Tags))
+		argIdx += 2
+	}
+
+	whereStr := ""
+	if len(whereClauses) > 0 {
+		whereStr = " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	// Total ignores the cursor so every page reports the size of the whole result set
+	var total int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM questions q"+whereStr, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count questions: %w", err)
+	}
+
+	direction, comparator := "DESC", "<"
+	if filter.Ascending {
+		direction, comparator = "ASC", ">"
+	}
+
+	cursorStr := ""
+	if filter.Cursor != "" && filter.Offset > 0 {
+		return nil, fmt.Errorf("invalid cursor: cannot be combined with offset")
+	}
+	if filter.Cursor != "" {
+		cursor, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.Sort != filter.Sort {
+			return nil, fmt.Errorf("invalid cursor: issued for sort %s", cursor.Sort)
+		}
+		cursorStr = fmt.Sprintf(" WHERE (sub.sort_value, sub.id) %s ($%d::%s, $%d)", comparator, argIdx, sortCol.cast, argIdx+1)
+		args = append(args, cursor.Key, cursor.ID)
+		argIdx += 2
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, title, content, level, language, role, hint, correct_answer, topic_id, created_by, status, created_at, updated_at, external_id, translation_group_id, sort_value::text
+		FROM (
+			SELECT 
+				q.id, 
+				q.title, 
+				q.content, 
+				q.level, 
+				q.language, 
+				COALESCE(q.role, '') AS role,
+				COALESCE(q.hint, '') AS hint,
+				COALESCE(q.correct_answer, '') AS correct_answer,
+				q.topic_id, 
+				COALESCE(q.created_by, '00000000-0000-0000-0000-000000000000') AS created_by, 
+				q.status, 
+				q.created_at, 
+				q.updated_at,
+				COALESCE(q.external_id, '') AS external_id,
+				q.translation_group_id,
+				%s AS sort_value
+			FROM questions q%s
+		) sub%s
+		ORDER BY sub.sort_value %s, sub.id %s
+		LIMIT $%d OFFSET $%d
+	`, sortCol.expr, whereStr, cursorStr, direction, direction, argIdx, argIdx+1)
+	// Fetch one extra row to know whether another page exists
+	args = append(args, filter.Limit+1, filter.Offset)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list questions: %w", err)
 	}
 	defer rows.Close()
 
-	var questions []*domain.Question
+	page := &domain.QuestionPage{Items: []*domain.Question{}, Total: total}
+	var lastKey string
 	for rows.Next() {
 		var q domain.Question
+		var sortKey string
 		err := rows.Scan(
 			&q.ID,
 			&q.Title,
@@ -137,60 +337,327 @@ func (r *QuestionRepository) List(ctx context.Context, limit, offset int) ([]*do
 			&q.Status,
 			&q.CreatedAt,
 			&q.UpdatedAt,
+			&q.ExternalID,
+			&q.TranslationGroupID,
+			&sortKey,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan question: %w", err)
 		}
-		questions = append(questions, &q)
+		if len(page.Items) == filter.Limit {
+			page.NextCursor = encodeCursor(listCursor{Sort: filter.Sort, Key: lastKey, ID: page.Items[len(page.Items)-1].ID})
+			break
+		}
+		page.Items = append(page.Items, &q)
+		lastKey = sortKey
 	}
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
-	return questions, nil
+	return page, nil
 }
 
+// poolWhere builds the WHERE clause selecting the published questions q of a practice pool, with its arguments
+func poolWhere(filter domain.PoolFilter) (string, []interface{}) {
+	whereClauses := []string{"q.status = 'published'", "q.language = $1"}
+	args := []interface{}{filter.Language}
+	argIdx := 2
+
+	if filter.TopicID != nil {
+		whereClauses = append(whereClauses, topicSubtreeClause(argIdx))
+		args = append(args, *filter.TopicID)
+		argIdx++
+	} else if len(filter.Stacks) > 0 {
+		// Stack names ("Go", "Node.js", "PostgreSQL") resolve to topics through their slugs and aliases
+		whereClauses = append(whereClauses, fmt.Sprintf(`q.topic_id IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM topics WHERE lower(name) = ANY($%[1]d) OR slug = ANY($%[1]d) OR aliases && $%[1]d::text[]
+				UNION
+				SELECT c.id FROM topics c JOIN subtree s ON c.parent_id = s.id
+			) SELECT id FROM subtree)`, argIdx))
+		args = append(args, filter.Stacks)
+		argIdx++
+	}
+	if len(filter.Roles) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("(q.role = ANY($%d) OR q.role = 'Any')", argIdx))
+		args = append(args, filter.Roles)
+		argIdx++
+	}
+	if len(filter.Tags) > 0 {
+		if filter.AllTags {
+			whereClauses = append(whereClauses, tagFilterClause(argIdx))
+			args = append(args, filter.Tags, len(filter.Tags))
+			argIdx += 2
+		} else {
+			whereClauses = append(whereClauses, fmt.Sprintf(`q.id IN (
+				SELECT qt.question_id FROM question_tags qt JOIN tags t ON t.id = qt.tag_id WHERE t.slug = ANY($%d))`, argIdx))
+			args = append(args, filter.Tags)
+			argIdx++
+		}
+	}
+	if len(filter.GroupsOf) > 0 {
+		ids := make([]string, len(filter.GroupsOf))
+		for i, id := range filter.GroupsOf {
+			ids[i] = id.String()
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf(
+			"q.translation_group_id IN (SELECT translation_group_id FROM questions WHERE id = ANY($%d::uuid[]))", argIdx))
+		args = append(args, ids)
+	}
+	return " WHERE " + strings.Join(whereClauses, " AND "), args
+}
+
+// ListPool lists the published questions a practice session can draw from
+func (r *QuestionRepository) ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error) {
+	whereStr, args := poolWhere(filter)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, "SELECT q.id, q.translation_group_id, q.level FROM questions q"+whereStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question pool: %w", err)
+	}
+	defer rows.Close()
+
+	pool := []domain.PoolQuestion{}
+	for rows.Next() {
+		var q domain.PoolQuestion
+		if err := rows.Scan(&q.ID, &q.TranslationGroupID, &q.Level); err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		pool = append(pool, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return pool, nil
+}
+
+// Update saves the question with its question.updated event, and question.published or question.archived
+// when the save moves it into that status
 func (r *QuestionRepository) Update(ctx context.Context, question *domain.Question) error {
+	// The subquery reads the row before the update, so RETURNING gives the status being replaced
 	query := `
-		UPDATE questions
-		SET title = $1, content = $2, level = $3, topic_id = $4, status = $5, updated_at = $6
-		WHERE id = $7
+		UPDATE questions q
+		SET title = $1, content = $2, level = $3, language = $4, role = $5, hint = $6, correct_answer = $7,
+			topic_id = $8, status = $9, updated_at = $10, external_id = NULLIF($11, '')
+		FROM (SELECT id, status FROM questions WHERE id = $12 FOR UPDATE) old
+		WHERE q.id = old.id
+		RETURNING old.status
 	`
 	question.UpdatedAt = time.Now()
-	result, err := r.db.ExecContext(ctx, query,
-		question.Title,
-		question.Content,
-		question.Level,
-		question.TopicID,
-		question.Status,
-		question.UpdatedAt,
-		question.ID,
-	)
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		db := conn(ctx, r.db)
+		var previousStatus string
+		err := db.QueryRowContext(ctx, query,
+			question.Title,
+			question.Content,
+			question.Level,
+			question.Language,
+			question.Role,
+			question.Hint,
+			question.CorrectAnswer,
+			question.TopicID,
+			question.Status,
+			question.UpdatedAt,
+			question.ExternalID,
+			question.ID,
+		).Scan(&previousStatus)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("question not found")
+			}
+			if isTranslationConflict(err) {
+				return translationExistsError(question.Language)
+			}
+			return fmt.Errorf("failed to update question: %w", err)
+		}
+
+		for _, event := range domain.NewQuestionChangedEvents(question, previousStatus) {
+			if err := insertEvent(ctx, db, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete removes a question together with its question.deleted event
+func (r *QuestionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM questions WHERE id = $1 RETURNING language, status, translation_group_id`
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		db := conn(ctx, r.db)
+		deleted := domain.Question{ID: id}
+		err := db.QueryRowContext(ctx, query, id).Scan(&deleted.Language, &deleted.Status, &deleted.TranslationGroupID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("question not found")
+			}
+			return fmt.Errorf("failed to delete question: %w", err)
+		}
+		return insertEvent(ctx, db, domain.NewQuestionDeletedEvent(&deleted))
+	})
+}
+
+// translationGroupIndex enforces one question per language in a translation group
+const translationGroupIndex = "idx_questions_translation_group_language"
+
+func isTranslationConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == translationGroupIndex
+}
+
+func translationExistsError(language string) error {
+	return fmt.Errorf("translation already exists for language: %s", language)
+}
+
+// GetByIDs returns the questions that exist among ids
+func (r *QuestionRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Question, error) {
+	query := `
+		SELECT
+			id,
+			title,
+			content,
+			level,
+			language,
+			COALESCE(role, '') AS role,
+			COALESCE(hint, '') AS hint,
+			COALESCE(correct_answer, '') AS correct_answer,
+			topic_id,
+			COALESCE(created_by, '00000000-0000-0000-0000-000000000000') AS created_by,
+			status,
+			created_at,
+			updated_at,
+			COALESCE(external_id, '') AS external_id,
+			translation_group_id
+		FROM questions
+		WHERE id = ANY($1::uuid[])
+	`
+	idStrings := make([]string, len(ids))
+	for i, id := range ids {
+		idStrings[i] = id.String()
+	}
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, idStrings)
 	if err != nil {
-		return fmt.Errorf("failed to update question: %w", err)
+		return nil, fmt.Errorf("failed to get questions: %w", err)
 	}
+	defer rows.Close()
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to check rows affected: %w", err)
+	questions := []*domain.Question{}
+	for rows.Next() {
+		var q domain.Question
+		err := rows.Scan(
+			&q.ID,
+			&q.Title,
+			&q.Content,
+			&q.Level,
+			&q.Language,
+			&q.Role,
+			&q.Hint,
+			&q.CorrectAnswer,
+			&q.TopicID,
+			&q.CreatedBy,
+			&q.Status,
+			&q.CreatedAt,
+			&q.UpdatedAt,
+			&q.ExternalID,
+			&q.TranslationGroupID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		questions = append(questions, &q)
 	}
-	if rowsAffected == 0 {
-		return fmt.Errorf("question not found")
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
-	return nil
+	return questions, nil
 }
 
-func (r *QuestionRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM questions WHERE id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+// ListTranslations returns the questions of a translation group, canonical first, then by language
+func (r *QuestionRepository) ListTranslations(ctx context.Context, groupID uuid.UUID) ([]*domain.Question, error) {
+	query := `
+		SELECT
+			id,
+			title,
+			content,
+			level,
+			language,
+			COALESCE(role, '') AS role,
+			COALESCE(hint, '') AS hint,
+			COALESCE(correct_answer, '') AS correct_answer,
+			topic_id,
+			COALESCE(created_by, '00000000-0000-0000-0000-000000000000') AS created_by,
+			status,
+			created_at,
+			updated_at,
+			COALESCE(external_id, '') AS external_id,
+			translation_group_id
+		FROM questions
+		WHERE translation_group_id = $1
+		ORDER BY (id = translation_group_id) DESC, language
+	`
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, groupID)
 	if err != nil {
-		return fmt.Errorf("failed to delete question: %w", err)
+		return nil, fmt.Errorf("failed to list translations: %w", err)
 	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to check rows affected: %w", err)
+	defer rows.Close()
+
+	questions := []*domain.Question{}
+	for rows.Next() {
+		var q domain.Question
+		err := rows.Scan(
+			&q.ID,
+			&q.Title,
+			&q.Content,
+			&q.Level,
+			&q.Language,
+			&q.Role,
+			&q.Hint,
+			&q.CorrectAnswer,
+			&q.TopicID,
+			&q.CreatedBy,
+			&q.Status,
+			&q.CreatedAt,
+			&q.UpdatedAt,
+			&q.ExternalID,
+			&q.TranslationGroupID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		questions = append(questions, &q)
 	}
-	if rowsAffected == 0 {
-		return fmt.Errorf("question not found")
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
-	return nil
+	return questions, nil
+}
+
+// SetTranslationGroup saves a question's translation group (its own ID detaches it from any group)
+func (r *QuestionRepository) SetTranslationGroup(ctx context.Context, question *domain.Question) error {
+	// The subquery reads the row before the update, so RETURNING gives the group being left
+	query := `
+		UPDATE questions q
+		SET translation_group_id = $1, updated_at = $2
+		FROM (SELECT id, translation_group_id FROM questions WHERE id = $3 FOR UPDATE) old
+		WHERE q.id = old.id
+		RETURNING old.translation_group_id
+	`
+	question.UpdatedAt = time.Now()
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		db := conn(ctx, r.db)
+		var previousGroupID uuid.UUID
+		err := db.QueryRowContext(ctx, query, question.TranslationGroupID, question.UpdatedAt, question.ID).Scan(&previousGroupID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("question not found")
+			}
+			if isTranslationConflict(err) {
+				return translationExistsError(question.Language)
+			}
+			return fmt.Errorf("failed to set translation group: %w", err)
+		}
+		if previousGroupID == question.TranslationGroupID {
+			return nil
+		}
+		return insertEvent(ctx, db, domain.NewQuestionRegroupedEvent(question, previousGroupID))
+	})
 }
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+func TestPoolWhere_NumbersItsArguments(t *testing.T) {
+	bookmarked := uuid.New()
+	where, args := poolWhere(domain.PoolFilter{
+		Stacks:   []string{"go"},
+		Roles:    []string{"BackEnd", "DevOps"},
+		Tags:     []string{"concurrency", "channels"},
+		AllTags:  true,
+		Language: "vi",
+		GroupsOf: []uuid.UUID{bookmarked},
+	})
+
+	want := []interface{}{"vi", []string{"go"}, []string{"BackEnd", "DevOps"}, []string{"concurrency", "channels"}, 2, []string{bookmarked.String()}}
+	if !reflect.DeepEqual(args, want) {
+		t.Fatalf("unexpected arguments %#v", args)
+	}
+	for _, clause := range []string{
+		"q.status = 'published' AND q.language = $1",
+		"aliases && $2::text[]",
+		"(q.role = ANY($3) OR q.role = 'Any')",
+		"WHERE t.slug = ANY($4) GROUP BY qt.question_id HAVING COUNT(*) = $5",
+		"WHERE id = ANY($6::uuid[])",
+	} {
+		if !strings.Contains(where, clause) {
+			t.Errorf("expected %q in %s", clause, where)
+		}
+	}
+
+	// A topic wins over stacks, and any-tag matching does not count tags
+	topicID := uuid.New()
+	where, args = poolWhere(domain.PoolFilter{TopicID: &topicID, Stacks: []string{"go"}, Tags: []string{"go"}, Language: "en"})
+	if len(args) != 3 || args[1] != topicID || strings.Contains(where, "aliases") || strings.Contains(where, "HAVING") {
+		t.Fatalf("unexpected clause %s %#v", where, args)
+	}
+}
@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// searchQueries builds the tsquery for each language filter. Rows in 'en' are indexed with the
+// stemming 'english' config and everything else with 'simple' (see migration 000018), so a search
+// across all languages ORs both parses of the input.
+var searchQueries = map[string]string{
+	"en": "websearch_to_tsquery('english', $1)",
+	"vi": "websearch_to_tsquery('simple', $1)",
+	"":   "(websearch_to_tsquery('english', $1) || websearch_to_tsquery('simple', $1))",
+}
+
+// ts_headline does not escape the text it returns, so matches are delimited with private-use
+// characters (removed from the text beforehand) and become <mark> only after HTML escaping
+const (
+	highlightStart = "\ue000"
+	highlightStop  = "\ue001"
+
+	titleHighlightOptions = `StartSel="` + highlightStart + `", StopSel="` + highlightStop + `", HighlightAll=true`
+	snippetOptions        = `StartSel="` + highlightStart + `", StopSel="` + highlightStop + `", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" … "`
+)
+
+// highlightText strips the highlight delimiters from stored text before ts_headline sees it
+const highlightText = "translate(%s, '" + highlightStart + highlightStop + "', '')"
+
+// markHighlights HTML-escapes a ts_headline result and turns its delimiters into <mark> tags
+func markHighlights(headline string) string {
+	escaped := html.EscapeString(headline)
+	escaped = strings.ReplaceAll(escaped, highlightStart, "<mark>")
+	return strings.ReplaceAll(escaped, highlightStop, "</mark>")
+}
+
+func (r *QuestionRepository) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchResult, error) {
+	tsQuery, ok := searchQueries[query.Language]
+	if !ok {
+		return nil, fmt.Errorf("invalid language: %s", query.Language)
+	}
+
+	// Facets are computed without the topic/level filters so that each facet still shows
+	// the alternatives to the topic/level the caller has already selected.
+	baseClauses := []string{"q.search_vector @@ sq.query", "q.status = $2"}
+	args := []interface{}{query.Text, query.Status}
+	argIdx := 3
+
+	if query.Language != "" {
+		baseClauses = append(baseClauses, fmt.Sprintf("q.language = $%d", argIdx))
+		args = append(args, query.Language)
+		argIdx++
+	}
+	if len(query.Tags) > 0 {
+		baseClauses = append(baseClauses, tagFilterClause(argIdx))
+		args = append(args, query.Tags, len(query.Tags))
+		argIdx += 2
+	}
+
+	// The topic filter includes descendant topics; facets need to know which rows it keeps
+	inTopic := "TRUE"
+	topicArg := 0
+	if query.TopicID != nil {
+		topicArg = argIdx
+		inTopic = topicSubtreeClause(topicArg)
+		args = append(args, *query.TopicID)
+		argIdx++
+	}
+
+	from := fmt.Sprintf(" FROM questions q CROSS JOIN (SELECT %s AS query) sq", tsQuery)
+	facets, total, err := r.searchFacets(ctx, from, strings.Join(baseClauses, " AND "), inTopic, args, query.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	clauses := baseClauses
+	if query.TopicID != nil {
+		clauses = append(clauses, topicSubtreeClause(topicArg))
+	}
+	if query.Level != "" {
+		clauses = append(clauses, fmt.Sprintf("q.level = $%d", argIdx))
+		args = append(args, query.Level)
+		argIdx++
+	}
+
+	// Rank and page first, then build headlines for the page only: ts_headline re-parses the text
+	sqlQuery := fmt.Sprintf(`
+		SELECT
+			m.id, m.title, m.content, m.level, m.language, m.role, m.hint, m.correct_answer,
+			m.topic_id, m.created_by, m.status, m.created_at, m.updated_at, m.external_id, m.translation_group_id, m.rank,
+			ts_headline(m.config, %s, m.query, '%s') AS title_highlight,
+			ts_headline(m.config, %s, m.query, '%s') AS snippet
+		FROM (
+			SELECT
+				q.id,
+				q.title,
+				q.content,
+				q.level,
+				q.language,
+				COALESCE(q.role, '') AS role,
+				COALESCE(q.hint, '') AS hint,
+				COALESCE(q.correct_answer, '') AS correct_answer,
+				q.topic_id,
+				COALESCE(q.created_by, '00000000-0000-0000-0000-000000000000') AS created_by,
+				q.status,
+				q.created_at,
+				q.updated_at,
+				COALESCE(q.external_id, '') AS external_id,
+				q.translation_group_id,
+				ts_rank_cd(q.search_vector, sq.query, 32) AS rank,
+				CASE WHEN q.language = 'en' THEN 'english'::regconfig ELSE 'simple'::regconfig END AS config,
+				sq.query
+			%s
+			WHERE %s
+			ORDER BY rank DESC, q.id
+			LIMIT $%d OFFSET $%d
+		) m
+		ORDER BY m.rank DESC, m.id
+	`, fmt.Sprintf(highlightText, "m.title"), titleHighlightOptions, fmt.Sprintf(highlightText, "m.content"), snippetOptions, from, strings.Join(clauses, " AND "), argIdx, argIdx+1)
+	args = append(args, query.Limit, query.Offset)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search questions: %w", err)
+	}
+	defer rows.Close()
+
+	result := &domain.SearchResult{Hits: []*domain.SearchHit{}, Total: total, Facets: *facets}
+	for rows.Next() {
+		var q domain.Question
+		hit := &domain.SearchHit{Question: &q}
+		err := rows.Scan(
+			&q.ID,
+			&q.Title,
+			&q.Content,
+			&q.Level,
+			&q.Language,
+			&q.Role,
+			&q.Hint,
+			&q.CorrectAnswer,
+			&q.TopicID,
+			&q.CreatedBy,
+			&q.Status,
+			&q.CreatedAt,
+			&q.UpdatedAt,
+			&q.ExternalID,
+			&q.TranslationGroupID,
+			&hit.Rank,
+			&hit.TitleHighlight,
+			&hit.Snippet,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+		hit.TitleHighlight = markHighlights(hit.TitleHighlight)
+		hit.Snippet = markHighlights(hit.Snippet)
+		result.Hits = append(result.Hits, hit)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return result, nil
+}
+
+// searchFacets groups text matches by (topic, level) in one query and folds the groups into
+// facet counts: topic counts respect the level filter, level counts respect the topic filter
+// (inTopic, which covers descendant topics), and the total respects both.
+func (r *QuestionRepository) searchFacets(ctx context.Context, from, where, inTopic string, args []interface{}, level string) (*domain.SearchFacets, int, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT q.topic_id, COALESCE(t.name, ''), q.level, `+inTopic+` AS in_topic, COUNT(*)`+from+`
+		LEFT JOIN topics t ON t.id = q.topic_id
+		WHERE `+where+`
+		GROUP BY q.topic_id, t.name, q.level, in_topic
+	`, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to compute search facets: %w", err)
+	}
+	defer rows.Close()
+
+	groups := []facetGroup{}
+	for rows.Next() {
+		var g facetGroup
+		if err := rows.Scan(&g.TopicID, &g.TopicName, &g.Level, &g.InTopic, &g.Count); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan search facet: %w", err)
+		}
+		groups = append(groups, g)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("rows iteration error: %w", err)
+	}
+	facets, total := foldFacets(groups, level)
+	return facets, total, nil
+}
+
+// facetGroup counts the matches sharing a topic and level; InTopic is whether the topic filter keeps them
+type facetGroup struct {
+	TopicID   uuid.UUID
+	TopicName string
+	Level     string
+	InTopic   bool
+	Count     int
+}
+
+// foldFacets sums groups into facet counts and the total, given the level filter ("" for any)
+func foldFacets(groups []facetGroup, level string) (*domain.SearchFacets, int) {
+	topics := map[uuid.UUID]*domain.FacetCount{}
+	levels := map[string]*domain.FacetCount{}
+	total := 0
+	for _, g := range groups {
+		levelMatches := level == "" || level == g.Level
+		if levelMatches {
+			if topics[g.TopicID] == nil {
+				topics[g.TopicID] = &domain.FacetCount{Value: g.TopicID.String(), Label: g.TopicName}
+			}
+			topics[g.TopicID].Count += g.Count
+		}
+		if g.InTopic {
+			if levels[g.Level] == nil {
+				levels[g.Level] = &domain.FacetCount{Value: g.Level}
+			}
+			levels[g.Level].Count += g.Count
+		}
+		if g.InTopic && levelMatches {
+			total += g.Count
+		}
+	}
+
+	facets := &domain.SearchFacets{Topics: []domain.FacetCount{}, Levels: []domain.FacetCount{}}
+	for _, f := range topics {
+		facets.Topics = append(facets.Topics, *f)
+	}
+	for _, f := range levels {
+		facets.Levels = append(facets.Levels, *f)
+	}
+	sortFacets(facets.Topics)
+	sortFacets(facets.Levels)
+	return facets, total
+}
+
+// sortFacets orders facet values by count, most common first
+func sortFacets(facets []domain.FacetCount) {
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return facets[i].Value < facets[j].Value
+	})
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+func TestMarkHighlights_EscapesStoredText(t *testing.T) {
+	headline := `<script>alert("x")</script> uses a ` + highlightStart + "goroutine" + highlightStop + " & a channel"
+	want := `&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; uses a <mark>goroutine</mark> &amp; a channel`
+	if got := markHighlights(headline); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	// Authors cannot smuggle in their own marks: literal tags are escaped like any other text
+	if got := markHighlights("<mark>fake</mark>"); got != "&lt;mark&gt;fake&lt;/mark&gt;" {
+		t.Fatalf("expected literal tags escaped, got %q", got)
+	}
+}
+
+func TestFoldFacets(t *testing.T) {
+	goTopic, dbTopic := uuid.New(), uuid.New()
+	groups := []facetGroup{
+		{TopicID: goTopic, TopicName: "Go", Level: "Junior", InTopic: true, Count: 3},
+		{TopicID: goTopic, TopicName: "Go", Level: "Senior", InTopic: true, Count: 2},
+		{TopicID: dbTopic, TopicName: "Databases", Level: "Senior", InTopic: false, Count: 4},
+		{TopicID: dbTopic, TopicName: "Databases", Level: "Junior", InTopic: false, Count: 2},
+	}
+
+	facets, total := foldFacets(groups, "")
+	if total != 5 {
+		t.Fatalf("expected total 5 (the selected topic at any level), got %d", total)
+	}
+	assertFacets(t, "topics", facets.Topics, []domain.FacetCount{
+		{Value: dbTopic.String(), Label: "Databases", Count: 6},
+		{Value: goTopic.String(), Label: "Go", Count: 5},
+	})
+	assertFacets(t, "levels", facets.Levels, []domain.FacetCount{
+		{Value: "Junior", Count: 3},
+		{Value: "Senior", Count: 2},
+	})
+
+	// With a level selected, topic counts follow the level and level counts still follow the topic
+	facets, total = foldFacets(groups, "Senior")
+	if total != 2 {
+		t.Fatalf("expected total 2, got %d", total)
+	}
+	assertFacets(t, "topics", facets.Topics, []domain.FacetCount{
+		{Value: dbTopic.String(), Label: "Databases", Count: 4},
+		{Value: goTopic.String(), Label: "Go", Count: 2},
+	})
+	assertFacets(t, "levels", facets.Levels, []domain.FacetCount{
+		{Value: "Junior", Count: 3},
+		{Value: "Senior", Count: 2},
+	})
+
+	facets, total = foldFacets(nil, "")
+	if total != 0 || facets.Topics == nil || facets.Levels == nil {
+		t.Fatalf("expected empty, non-nil facets, got %+v %d", facets, total)
+	}
+}
+
+func assertFacets(t *testing.T, name string, got, want []domain.FacetCount) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: expected %+v, got %+v", name, want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s: expected %+v, got %+v", name, want, got)
+		}
+	}
+}
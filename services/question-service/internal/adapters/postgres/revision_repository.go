@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+type RevisionRepository struct {
+	db *sql.DB
+}
+
+func NewRevisionRepository(db *sql.DB) ports.RevisionRepository {
+	return &RevisionRepository{
+		db: db,
+	}
+}
+
+func (r *RevisionRepository) Create(ctx context.Context, revision *domain.QuestionRevision) error {
+	// Revision numbers are sequential per question; the unique constraint guards concurrent writers
+	query := `
+		INSERT INTO question_revisions (id, question_id, revision_number, title, content, level, hint, correct_answer, author_id, created_at)
+		SELECT $1, $2, COALESCE(MAX(revision_number), 0) + 1, $3, $4, $5, $6, $7, $8, $9
+		FROM question_revisions
+		WHERE question_id = $2
+		RETURNING revision_number
+	`
+	var authorID interface{}
+	if revision.AuthorID != uuid.Nil {
+		authorID = revision.AuthorID
+	}
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		revision.ID,
+		revision.QuestionID,
+		revision.Title,
+		revision.Content,
+		revision.Level,
+		revision.Hint,
+		revision.CorrectAnswer,
+		authorID,
+		revision.CreatedAt,
+	).Scan(&revision.RevisionNumber)
+	if err != nil {
+		return fmt.Errorf("failed to create question revision: %w", err)
+	}
+	return nil
+}
+
+func (r *RevisionRepository) ListByQuestionID(ctx context.Context, questionID uuid.UUID) ([]*domain.QuestionRevision, error) {
+	query := `
+		SELECT id, question_id, revision_number, COALESCE(title, ''), content, COALESCE(level, ''),
+			COALESCE(hint, ''), COALESCE(correct_answer, ''),
+			COALESCE(author_id, '00000000-0000-0000-0000-000000000000'), created_at
+		FROM question_revisions
+		WHERE question_id = $1
+		ORDER BY revision_number DESC
+	`
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []*domain.QuestionRevision{}
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return revisions, nil
+}
+
+func (r *RevisionRepository) GetByNumber(ctx context.Context, questionID uuid.UUID, revisionNumber int) (*domain.QuestionRevision, error) {
+	query := `
+		SELECT id, question_id, revision_number, COALESCE(title, ''), content, COALESCE(level, ''),
+			COALESCE(hint, ''), COALESCE(correct_answer, ''),
+			COALESCE(author_id, '00000000-0000-0000-0000-000000000000'), created_at
+		FROM question_revisions
+		WHERE question_id = $1 AND revision_number = $2
+	`
+	rev, err := scanRevision(conn(ctx, r.db).QueryRowContext(ctx, query, questionID, revisionNumber))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("revision not found")
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRevision(row rowScanner) (*domain.QuestionRevision, error) {
+	var rev domain.QuestionRevision
+	err := row.Scan(
+		&rev.ID,
+		&rev.QuestionID,
+		&rev.RevisionNumber,
+		&rev.Title,
+		&rev.Content,
+		&rev.Level,
+		&rev.Hint,
+		&rev.CorrectAnswer,
+		&rev.AuthorID,
+		&rev.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan question revision: %w", err)
+	}
+	return &rev, nil
+}
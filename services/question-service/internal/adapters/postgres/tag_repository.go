@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+// uniqueViolation is the Postgres SQLSTATE for unique constraint violations
+const uniqueViolation = "23505"
+
+type TagRepository struct {
+	db *sql.DB
+}
+
+func NewTagRepository(db *sql.DB) ports.TagRepository {
+	return &TagRepository{
+		db: db,
+	}
+}
+
+func (r *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	query := `
+		INSERT INTO tags (id, name, slug, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, tag.ID, tag.Name, tag.Slug, tag.Description, tag.CreatedAt, tag.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return fmt.Errorf("tag already exists: %s", tag.Slug)
+		}
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+	return nil
+}
+
+const tagColumns = `id, name, slug, COALESCE(description, ''), created_at, updated_at`
+
+func scanTag(row rowScanner) (*domain.Tag, error) {
+	var t domain.Tag
+	if err := row.Scan(&t.ID, &t.Name, &t.Slug, &t.Description, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *TagRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Tag, error) {
+	tag, err := scanTag(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+tagColumns+` FROM tags WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("tag not found")
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return tag, nil
+}
+
+// GetBySlugs returns the tags with the given slugs; unknown slugs are skipped
+func (r *TagRepository) GetBySlugs(ctx context.Context, slugs []string) ([]*domain.Tag, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+tagColumns+` FROM tags WHERE slug = ANY($1) ORDER BY name ASC`, slugs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []*domain.Tag{}
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return tags, nil
+}
+
+func (r *TagRepository) List(ctx context.Context) ([]*domain.Tag, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+tagColumns+` FROM tags ORDER BY name ASC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []*domain.Tag{}
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return tags, nil
+}
+
+func (r *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE tags SET name = $1, slug = $2, description = $3, updated_at = $4 WHERE id = $5`,
+		tag.Name, tag.Slug, tag.Description, tag.UpdatedAt, tag.ID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return fmt.Errorf("tag already exists: %s", tag.Slug)
+		}
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("tag not found")
+	}
+	return nil
+}
+
+// Delete removes a tag; question_tags rows go with it (ON DELETE CASCADE)
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM tags WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("tag not found")
+	}
+	return nil
+}
+
+// SetQuestionTags replaces the tags of a question in one transaction
+func (r *TagRepository) SetQuestionTags(ctx context.Context, questionID uuid.UUID, tagIDs []uuid.UUID) error {
+	return withinTx(ctx, r.db, func(ctx context.Context) error {
+		tx := conn(ctx, r.db)
+		if _, err := tx.ExecContext(ctx, `DELETE FROM question_tags WHERE question_id = $1`, questionID); err != nil {
+			return fmt.Errorf("failed to clear question tags: %w", err)
+		}
+		for _, tagID := range tagIDs {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO question_tags (question_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+				questionID, tagID); err != nil {
+				return fmt.Errorf("failed to tag question: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// ListByQuestionIDs returns the tags of each question, keyed by question ID
+func (r *TagRepository) ListByQuestionIDs(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID][]*domain.Tag, error) {
+	ids := make([]string, len(questionIDs))
+	for i, id := range questionIDs {
+		ids[i] = id.String()
+	}
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT qt.question_id, t.id, t.name, t.slug, COALESCE(t.description, ''), t.created_at, t.updated_at
+		FROM question_tags qt
+		JOIN tags t ON t.id = qt.tag_id
+		WHERE qt.question_id = ANY($1::uuid[])
+		ORDER BY t.name ASC
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make(map[uuid.UUID][]*domain.Tag)
+	for rows.Next() {
+		var questionID uuid.UUID
+		var t domain.Tag
+		if err := rows.Scan(&questionID, &t.ID, &t.Name, &t.Slug, &t.Description, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan question tag: %w", err)
+		}
+		tags[questionID] = append(tags[questionID], &t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return tags, nil
+}
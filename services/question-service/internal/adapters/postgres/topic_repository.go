@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -20,16 +21,34 @@ func NewTopicRepository(db *sql.DB) ports.TopicRepository {
 	}
 }
 
+// topicColumns reads aliases as JSON so they scan without a driver-specific array type
+const topicColumns = `id, name, slug, COALESCE(description, ''), parent_id, array_to_json(aliases)::text`
+
+func scanTopic(row rowScanner) (*domain.Topic, error) {
+	var t domain.Topic
+	var aliases string
+	if err := row.Scan(&t.ID, &t.Name, &t.Slug, &t.Description, &t.ParentID, &aliases); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(aliases), &t.Aliases); err != nil {
+		return nil, fmt.Errorf("failed to decode topic aliases: %w", err)
+	}
+	return &t, nil
+}
+
 func (r *TopicRepository) Create(ctx context.Context, topic *domain.Topic) error {
 	query := `
-		INSERT INTO topics (id, name, description)
-		VALUES ($1, $2, $3)
+		INSERT INTO topics (id, name, description, parent_id, aliases)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING slug
 	`
-	_, err := r.db.ExecContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		topic.ID,
 		topic.Name,
 		topic.Description,
-	)
+		topic.ParentID,
+		topic.Aliases,
+	).Scan(&topic.Slug)
 	if err != nil {
 		return fmt.Errorf("failed to create topic: %w", err)
 	}
@@ -37,58 +56,42 @@ func (r *TopicRepository) Create(ctx context.Context, topic *domain.Topic) error
 }
 
 func (r *TopicRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Topic, error) {
-	query := `
-		SELECT id, name, description
-		FROM topics
-		WHERE id = $1
-	`
-	row := r.db.QueryRowContext(ctx, query, id)
-
-	var t domain.Topic
-	err := row.Scan(
-		&t.ID,
-		&t.Name,
-		&t.Description,
-	)
+	t, err := scanTopic(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+topicColumns+` FROM topics WHERE id = $1`, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("topic not found")
 		}
 		return nil, fmt.Errorf("failed to get topic: %w", err)
 	}
-	return &t, nil
+	return t, nil
 }
 
+// GetByName resolves a topic by exact name, slug or alias, preferring an exact name match
 func (r *TopicRepository) GetByName(ctx context.Context, name string) (*domain.Topic, error) {
 	query := `
-		SELECT id, name, description
+		SELECT ` + topicColumns + `
 		FROM topics
-		WHERE name = $1
+		WHERE name = $1 OR slug = lower($1) OR lower($1) = ANY(aliases)
+		ORDER BY (name = $1) DESC
+		LIMIT 1
 	`
-	row := r.db.QueryRowContext(ctx, query, name)
-
-	var t domain.Topic
-	err := row.Scan(
-		&t.ID,
-		&t.Name,
-		&t.Description,
-	)
+	t, err := scanTopic(conn(ctx, r.db).QueryRowContext(ctx, query, name))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("topic not found")
 		}
 		return nil, fmt.Errorf("failed to get topic: %w", err)
 	}
-	return &t, nil
+	return t, nil
 }
 
 func (r *TopicRepository) List(ctx context.Context) ([]*domain.Topic, error) {
 	query := `
-		SELECT id, name, description
+		SELECT ` + topicColumns + `
 		FROM topics
 		ORDER BY name ASC
 	`
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list topics: %w", err)
 	}
@@ -96,19 +99,67 @@ func (r *TopicRepository) List(ctx context.Context) ([]*domain.Topic, error) {
 
 	var topics []*domain.Topic
 	for rows.Next() {
-		var t domain.Topic
-		err := rows.Scan(
-			&t.ID,
-			&t.Name,
-			&t.Description,
-		)
+		t, err := scanTopic(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan topic: %w", err)
 		}
-		topics = append(topics, &t)
+		topics = append(topics, t)
 	}
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 	return topics, nil
 }
+
+func (r *TopicRepository) Update(ctx context.Context, topic *domain.Topic) error {
+	query := `
+		UPDATE topics
+		SET name = $1, description = $2, parent_id = $3, aliases = $4
+		WHERE id = $5
+		RETURNING slug
+	`
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		topic.Name,
+		topic.Description,
+		topic.ParentID,
+		topic.Aliases,
+		topic.ID,
+	).Scan(&topic.Slug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("topic not found")
+		}
+		return fmt.Errorf("failed to update topic: %w", err)
+	}
+	return nil
+}
+
+// ListSubtreeIDs returns the topic and all of its descendants
+func (r *TopicRepository) ListSubtreeIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM topics WHERE id = $1
+			UNION
+			SELECT c.id FROM topics c JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT id FROM subtree
+	`
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list topic subtree: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var topicID uuid.UUID
+		if err := rows.Scan(&topicID); err != nil {
+			return nil, fmt.Errorf("failed to scan topic: %w", err)
+		}
+		ids = append(ids, topicID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,345 @@
+// Package transfer reads and writes question files for bulk import and export
+// in JSON Lines, CSV and Markdown-with-front-matter formats.
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// maxLineSize bounds one JSON Lines record (long answers are common, so be generous)
+const maxLineSize = 4 << 20
+
+// csvColumns is the CSV header written on export; imports accept these columns in any order
+var csvColumns = []string{"external_id", "title", "content", "level", "language", "role", "topic", "status", "tags", "hint", "correct_answer", "translation_of"}
+
+// csvTagSeparator joins tags within one CSV cell
+const csvTagSeparator = "|"
+
+// Markdown body headings that split a question document into content, hint and answer
+const (
+	markdownFence         = "---"
+	markdownHintHeading   = "## Hint"
+	markdownAnswerHeading = "## Answer"
+)
+
+// ParseFormat normalises a format name ("md" and "json" are accepted as aliases)
+func ParseFormat(format string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "jsonl", "ndjson", "json":
+		return domain.FormatJSONL, nil
+	case "csv":
+		return domain.FormatCSV, nil
+	case "markdown", "md":
+		return domain.FormatMarkdown, nil
+	}
+	return "", fmt.Errorf("unsupported format: %q (expected jsonl, csv or markdown)", format)
+}
+
+// FormatFromFilename guesses the format from a file extension
+func FormatFromFilename(name string) (string, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(name), "."))
+}
+
+// ContentType is the HTTP content type for an export in the given format
+func ContentType(format string) string {
+	switch format {
+	case domain.FormatCSV:
+		return "text/csv; charset=utf-8"
+	case domain.FormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	}
+	return "application/x-ndjson"
+}
+
+// Decode parses a file into records. Malformed records are reported as row errors so the rest
+// of the file can still be imported; the error return is for files that cannot be read at all.
+func Decode(format string, r io.Reader) ([]domain.QuestionRecord, []domain.ImportRowError, error) {
+	switch format {
+	case domain.FormatJSONL:
+		return decodeJSONL(r)
+	case domain.FormatCSV:
+		return decodeCSV(r)
+	case domain.FormatMarkdown:
+		return decodeMarkdown(r)
+	}
+	return nil, nil, fmt.Errorf("unsupported format: %q", format)
+}
+
+// Encode writes records in the given format
+func Encode(format string, w io.Writer, records []domain.QuestionRecord) error {
+	switch format {
+	case domain.FormatJSONL:
+		return encodeJSONL(w, records)
+	case domain.FormatCSV:
+		return encodeCSV(w, records)
+	case domain.FormatMarkdown:
+		return encodeMarkdown(w, records)
+	}
+	return fmt.Errorf("unsupported format: %q", format)
+}
+
+func decodeJSONL(r io.Reader) ([]domain.QuestionRecord, []domain.ImportRowError, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+
+	var records []domain.QuestionRecord
+	var rowErrors []domain.ImportRowError
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var rec domain.QuestionRecord
+		dec := json.NewDecoder(strings.NewReader(text))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec); err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: line, Error: "invalid JSON: " + err.Error()})
+			continue
+		}
+		rec.Row = line
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read JSON Lines: %w", err)
+	}
+	return records, rowErrors, nil
+}
+
+func encodeJSONL(w io.Writer, records []domain.QuestionRecord) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for i := range records {
+		if err := enc.Encode(&records[i]); err != nil {
+			return fmt.Errorf("failed to write JSON Lines: %w", err)
+		}
+	}
+	return nil
+}
+
+func decodeCSV(r io.Reader) ([]domain.QuestionRecord, []domain.ImportRowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	known := make(map[string]bool, len(csvColumns))
+	for _, col := range csvColumns {
+		known[col] = true
+	}
+	index := make(map[string]int, len(header))
+	for i, col := range header {
+		col = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(col, "\ufeff")))
+		if !known[col] {
+			return nil, nil, fmt.Errorf("unknown CSV column: %q", col)
+		}
+		index[col] = i
+	}
+
+	var records []domain.QuestionRecord
+	var rowErrors []domain.ImportRowError
+	// Rows are numbered from the first data row, matching what a spreadsheet shows below the header
+	for row := 1; ; row++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: row, Error: "invalid CSV: " + err.Error()})
+			continue
+		}
+		if len(fields) != len(header) {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: row, Error: fmt.Sprintf("expected %d fields, got %d", len(header), len(fields))})
+			continue
+		}
+		get := func(col string) string {
+			if i, ok := index[col]; ok {
+				return fields[i]
+			}
+			return ""
+		}
+		rec := domain.QuestionRecord{
+			Row:           row,
+			ExternalID:    strings.TrimSpace(get("external_id")),
+			Title:         get("title"),
+			Content:       get("content"),
+			Level:         strings.TrimSpace(get("level")),
+			Language:      strings.TrimSpace(get("language")),
+			Role:          strings.TrimSpace(get("role")),
+			Topic:         strings.TrimSpace(get("topic")),
+			Status:        strings.TrimSpace(get("status")),
+			Hint:          get("hint"),
+			CorrectAnswer: get("correct_answer"),
+			TranslationOf: strings.TrimSpace(get("translation_of")),
+		}
+		if tags := strings.TrimSpace(get("tags")); tags != "" {
+			rec.Tags = strings.Split(tags, csvTagSeparator)
+		}
+		records = append(records, rec)
+	}
+	return records, rowErrors, nil
+}
+
+func encodeCSV(w io.Writer, records []domain.QuestionRecord) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvColumns); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	for _, rec := range records {
+		if err := writer.Write([]string{
+			rec.ExternalID, rec.Title, rec.Content, rec.Level, rec.Language, rec.Role,
+			rec.Topic, rec.Status, strings.Join(rec.Tags, csvTagSeparator), rec.Hint, rec.CorrectAnswer, rec.TranslationOf,
+		}); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// decodeMarkdown reads a sequence of documents, each a YAML front matter block between "---"
+// lines followed by the question content. "## Hint" and "## Answer" headings in the body hold the
+// hint and correct answer. A "---" line ends the body and starts the next question, so content
+// must use "***" for horizontal rules; exports backslash-escape such lines (see escapeMarkdownLines).
+func decodeMarkdown(r io.Reader) ([]domain.QuestionRecord, []domain.ImportRowError, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read Markdown: %w", err)
+	}
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+
+	var records []domain.QuestionRecord
+	var rowErrors []domain.ImportRowError
+	i := 0
+	for doc := 1; ; doc++ {
+		for i < len(lines) && strings.TrimSpace(lines[i]) == "" {
+			i++
+		}
+		if i >= len(lines) {
+			break
+		}
+		if strings.TrimSpace(lines[i]) != markdownFence {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: doc, Error: fmt.Sprintf("line %d: expected %q to open front matter", i+1, markdownFence)})
+			break
+		}
+
+		start := i + 1
+		end := start
+		for end < len(lines) && strings.TrimSpace(lines[end]) != markdownFence {
+			end++
+		}
+		if end >= len(lines) {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: doc, Error: fmt.Sprintf("line %d: front matter is not closed with %q", i+1, markdownFence)})
+			break
+		}
+		frontMatter := strings.Join(lines[start:end], "\n")
+
+		bodyStart := end + 1
+		bodyEnd := bodyStart
+		for bodyEnd < len(lines) && strings.TrimSpace(lines[bodyEnd]) != markdownFence {
+			bodyEnd++
+		}
+		i = bodyEnd
+
+		var rec domain.QuestionRecord
+		if err := yaml.UnmarshalWithOptions([]byte(frontMatter), &rec, yaml.DisallowUnknownField()); err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: doc, Error: "invalid front matter: " + err.Error()})
+			continue
+		}
+		rec.Row = doc
+		rec.Content, rec.Hint, rec.CorrectAnswer = splitMarkdownBody(lines[bodyStart:bodyEnd])
+		records = append(records, rec)
+	}
+	return records, rowErrors, nil
+}
+
+// splitMarkdownBody separates the question content from the hint and answer sections
+func splitMarkdownBody(lines []string) (content, hint, answer string) {
+	sections := map[string]*strings.Builder{"": {}, markdownHintHeading: {}, markdownAnswerHeading: {}}
+	current := ""
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.EqualFold(trimmed, markdownHintHeading) {
+			current = markdownHintHeading
+			continue
+		}
+		if strings.EqualFold(trimmed, markdownAnswerHeading) {
+			current = markdownAnswerHeading
+			continue
+		}
+		sections[current].WriteString(unescapeMarkdownLine(line))
+		sections[current].WriteString("\n")
+	}
+	return strings.TrimSpace(sections[""].String()),
+		strings.TrimSpace(sections[markdownHintHeading].String()),
+		strings.TrimSpace(sections[markdownAnswerHeading].String())
+}
+
+func encodeMarkdown(w io.Writer, records []domain.QuestionRecord) error {
+	for i, rec := range records {
+		frontMatter, err := yaml.Marshal(&rec)
+		if err != nil {
+			return fmt.Errorf("failed to write front matter: %w", err)
+		}
+
+		var buf bytes.Buffer
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(markdownFence + "\n")
+		buf.Write(frontMatter)
+		buf.WriteString(markdownFence + "\n\n")
+		buf.WriteString(escapeMarkdownLines(rec.Content) + "\n")
+		if rec.Hint != "" {
+			buf.WriteString("\n" + markdownHintHeading + "\n\n" + escapeMarkdownLines(rec.Hint) + "\n")
+		}
+		if rec.CorrectAnswer != "" {
+			buf.WriteString("\n" + markdownAnswerHeading + "\n\n" + escapeMarkdownLines(rec.CorrectAnswer) + "\n")
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return fmt.Errorf("failed to write Markdown: %w", err)
+		}
+	}
+	return nil
+}
+
+// isMarkdownDelimiter reports whether a body line, ignoring surrounding space and any leading
+// backslashes, would be read as a document fence or a section heading
+func isMarkdownDelimiter(line string) bool {
+	bare := strings.TrimLeft(strings.TrimSpace(line), "\\")
+	return bare == markdownFence || strings.EqualFold(bare, markdownHintHeading) || strings.EqualFold(bare, markdownAnswerHeading)
+}
+
+// escapeMarkdownLines backslash-escapes lines of a text field that would otherwise end the body or
+// start a section. One more backslash is added to lines already escaped, so any text round-trips;
+// Markdown renders "\---" and "\## Hint" as the literal line.
+func escapeMarkdownLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if isMarkdownDelimiter(line) {
+			indent := len(line) - len(strings.TrimLeft(line, " \t"))
+			lines[i] = line[:indent] + "\\" + line[indent:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// unescapeMarkdownLine removes the backslash escapeMarkdownLines added
+func unescapeMarkdownLine(line string) string {
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	if isMarkdownDelimiter(line) && strings.HasPrefix(line[indent:], "\\") {
+		return line[:indent] + line[indent+1:]
+	}
+	return line
+}
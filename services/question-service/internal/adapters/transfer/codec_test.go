@@ -0,0 +1,111 @@
+package transfer
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+func sampleRecords() []domain.QuestionRecord {
+	return []domain.QuestionRecord{
+		{
+			ExternalID: "go-001", Title: "Goroutines", Content: "What is a goroutine?\n\nCompare it with an OS thread.",
+			Level: "Junior", Language: "en", Role: "BackEnd", Topic: "Go", Status: "published",
+			Tags: []string{"concurrency", "runtime"}, Hint: "Think about the scheduler.", CorrectAnswer: "A function running concurrently, multiplexed onto OS threads.",
+		},
+		{
+			ExternalID: "go-001-vi", Title: "Goroutine", Content: "Goroutine là gì, và \"nhẹ\" hơn thread ở đâu?",
+			Level: "Junior", Language: "vi", Topic: "Go", TranslationOf: "go-001",
+		},
+		{
+			// Text that looks like the Markdown layout must survive every format
+			ExternalID: "md-001", Title: "Front matter, commas, \"quotes\"", Content: "Explain this file:\n---\ntitle: x\n---\n## Hint\n\\---\n  ## answer",
+			Level: "Senior", Language: "en", Topic: "Tooling", Hint: "Line one\n---\nLine two", CorrectAnswer: "## Answer\n<b>YAML</b> & Markdown",
+		},
+	}
+}
+
+func roundTrip(t *testing.T, format string, records []domain.QuestionRecord) []domain.QuestionRecord {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Encode(format, &buf, records); err != nil {
+		t.Fatalf("%s: Encode: %v", format, err)
+	}
+	decoded, rowErrors, err := Decode(format, &buf)
+	if err != nil || len(rowErrors) != 0 {
+		t.Fatalf("%s: Decode: %v %+v\n%s", format, err, rowErrors, buf.String())
+	}
+	for i := range decoded {
+		if decoded[i].Row != i+1 {
+			t.Errorf("%s: record %d has row %d", format, i, decoded[i].Row)
+		}
+		decoded[i].Row = 0
+	}
+	return decoded
+}
+
+func TestEncodeDecode_RoundTrips(t *testing.T) {
+	for _, format := range []string{domain.FormatJSONL, domain.FormatCSV, domain.FormatMarkdown} {
+		records := sampleRecords()
+		if got := roundTrip(t, format, records); !reflect.DeepEqual(got, records) {
+			t.Errorf("%s: round trip changed the records\nwant %+v\ngot  %+v", format, records, got)
+		}
+	}
+}
+
+func TestEncodeMarkdown_EscapesLayoutLines(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Encode(domain.FormatMarkdown, &buf, sampleRecords()[2:]); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"\n\\---\ntitle: x\n\\---\n\\## Hint\n\\\\---\n  \\## answer\n", "\n## Answer\n\n\\## Answer\n"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in the export, got\n%s", want, out)
+		}
+	}
+	// Exactly one document: two fences around the front matter
+	if n := strings.Count(out, "\n"+markdownFence+"\n") + strings.Count(out[:4], markdownFence+"\n"); n != 2 {
+		t.Fatalf("expected only the front matter fences unescaped, found %d\n%s", n, out)
+	}
+}
+
+func TestDecode_ReportsBadRows(t *testing.T) {
+	jsonl := `{"external_id":"a","title":"A","content":"x","level":"Mid","language":"en","topic":"Go"}
+{"external_id":"b","unknown":1}
+not json
+`
+	records, rowErrors, err := Decode(domain.FormatJSONL, strings.NewReader(jsonl))
+	if err != nil || len(records) != 1 || len(rowErrors) != 2 || rowErrors[0].Row != 2 || rowErrors[1].Row != 3 {
+		t.Fatalf("expected one record and errors on rows 2 and 3, got %+v %+v %v", records, rowErrors, err)
+	}
+
+	csvFile := "external_id,title,content,level\na,A,x,Mid\nb,B\n"
+	records, rowErrors, err = Decode(domain.FormatCSV, strings.NewReader(csvFile))
+	if err != nil || len(records) != 1 || len(rowErrors) != 1 || rowErrors[0].Row != 2 {
+		t.Fatalf("expected one record and an error on row 2, got %+v %+v %v", records, rowErrors, err)
+	}
+	if _, _, err := Decode(domain.FormatCSV, strings.NewReader("external_id,colour\n")); err == nil {
+		t.Fatal("expected an unknown CSV column to fail the file")
+	}
+
+	markdown := "---\ntitle: A\nlevel: Mid\n---\n\nContent\n---\nbogus: true\n---\n\nMore\n"
+	records, rowErrors, err = Decode(domain.FormatMarkdown, strings.NewReader(markdown))
+	if err != nil || len(records) != 1 || len(rowErrors) != 1 || rowErrors[0].Row != 2 {
+		t.Fatalf("expected one document and an error on document 2, got %+v %+v %v", records, rowErrors, err)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]string{"JSONL": domain.FormatJSONL, "json": domain.FormatJSONL, "md": domain.FormatMarkdown, " csv ": domain.FormatCSV} {
+		if got, err := ParseFormat(name); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if _, err := FormatFromFilename("questions.xlsx"); err == nil {
+		t.Fatal("expected an unsupported extension to fail")
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Classification methods: keyword rules alone, or rules combined with the AI service
+const (
+	ClassifiedByRules = "rules"
+	ClassifiedByAI    = "rules+ai"
+)
+
+// Classification is a proposed topic, level and role for a crawled question. Each confidence is in [0, 1].
+type Classification struct {
+	TopicID         *uuid.UUID `json:"topic_id"`
+	Topic           string     `json:"topic"`
+	TopicConfidence float64    `json:"topic_confidence"`
+	Level           string     `json:"level"`
+	LevelConfidence float64    `json:"level_confidence"`
+	Role            string     `json:"role"`
+	RoleConfidence  float64    `json:"role_confidence"`
+	Method          string     `json:"method"`
+	ClassifiedAt    time.Time  `json:"classified_at"`
+}
+
+// Confidence is the confidence of the classification as a whole. Topic and level are both needed to
+// publish a question, so it is the lower of the two; role is optional and does not count.
+func (c *Classification) Confidence() float64 {
+	if c.TopicID == nil {
+		return 0
+	}
+	return math.Min(c.TopicConfidence, c.LevelConfidence)
+}
+
+// ClassificationPolicy sets the confidence at which a classified crawled question is approved without review
+type ClassificationPolicy struct {
+	AutoApproveThreshold float64 // 0 disables auto-approval
+}
+
+// DefaultClassificationPolicy auto-approves at 0.9 confidence
+func DefaultClassificationPolicy() ClassificationPolicy {
+	return ClassificationPolicy{AutoApproveThreshold: 0.9}
+}
+
+// ShouldAutoApprove reports whether a classification is confident enough to skip review
+func (p ClassificationPolicy) ShouldAutoApprove(c *Classification) bool {
+	return p.AutoApproveThreshold > 0 && c.Confidence() >= p.AutoApproveThreshold
+}
+
+// ClassifiedItem is the outcome of classifying one crawled question
+type ClassifiedItem struct {
+	ID             uuid.UUID       `json:"id"`
+	Classification *Classification `json:"classification,omitempty"`
+	QuestionID     *uuid.UUID      `json:"question_id,omitempty"` // Set when the item was auto-approved
+	Error          string          `json:"error,omitempty"`       // Why classification or auto-approval failed
+}
+
+// ClassifyResult summarises a classification run over the review queue
+type ClassifyResult struct {
+	Classified   int              `json:"classified"`
+	AutoApproved int              `json:"auto_approved"`
+	Failed       int              `json:"failed"`
+	Items        []ClassifiedItem `json:"items"`
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Crawled question review statuses, as in the crawled_questions status check
+const (
+	CrawlStatusPending  = "pending"
+	CrawlStatusApproved = "approved"
+	CrawlStatusRejected = "rejected"
+)
+
+// CrawledQuestion is a question the crawler service staged for review. Approving it promotes it
+// into questions; rejecting it records why. Title through CorrectAnswer are the values a promoted
+// question gets: the reviewer's edit where there is one, then the classifier's proposal, then what
+// the crawler found.
+type CrawledQuestion struct {
+	ID            uuid.UUID `json:"id"`
+	Source        string    `json:"source"`
+	URL           string    `json:"url"`
+	RawTitle      string    `json:"raw_title"`
+	RawContent    string    `json:"raw_content"`
+	DetectedTopic string    `json:"detected_topic"`
+	DetectedLevel string    `json:"detected_level"`
+	DetectedRole  string    `json:"detected_role"`
+	Title         string    `json:"title"`
+	Content       string    `json:"content"`
+	Level         string    `json:"level"`
+	Role          string    `json:"role"`
+	Language      string    `json:"language"`
+	// TopicID is set by a reviewer or the classifier; when nil the topic is resolved from DetectedTopic on approval
+	TopicID         *uuid.UUID      `json:"topic_id"`
+	Hint            string          `json:"hint"`
+	CorrectAnswer   string          `json:"correct_answer"`
+	Status          string          `json:"status"`
+	RejectionReason string          `json:"rejection_reason,omitempty"`
+	ReviewedBy      *uuid.UUID      `json:"reviewed_by,omitempty"`
+	ReviewedAt      *time.Time      `json:"reviewed_at,omitempty"`
+	QuestionID      *uuid.UUID      `json:"question_id,omitempty"`    // The question an approved item became
+	Classification  *Classification `json:"classification,omitempty"` // Set once the item has been classified
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// CrawledPatch holds a reviewer's edits to a pending crawled question; nil fields are left untouched
+type CrawledPatch struct {
+	Title         *string
+	Content       *string
+	Level         *string
+	Role          *string
+	Language      *string
+	TopicID       *uuid.UUID
+	Hint          *string
+	CorrectAnswer *string
+}
+
+// CrawledFilter narrows the review queue; zero values mean "any" except Status, which defaults to pending
+type CrawledFilter struct {
+	Status string
+	Source string
+	Topic  string // Detected topic, case-insensitive
+	// Unclassified keeps only items the classifier has not seen yet
+	Unclassified bool
+	Limit        int
+	Offset       int
+}
+
+// CrawledPage is one page of the review queue, oldest first
+type CrawledPage struct {
+	Items []*CrawledQuestion `json:"items"`
+	Total int                `json:"total"`
+}
+
+// IsPending reports whether the item still awaits a review decision
+func (c *CrawledQuestion) IsPending() bool {
+	return c.Status == CrawlStatusPending
+}
+
+// Apply copies the non-nil fields of a patch onto the item
+func (c *CrawledQuestion) Apply(p CrawledPatch) {
+	if p.Title != nil {
+		c.Title = *p.Title
+	}
+	if p.Content != nil {
+		c.Content = *p.Content
+	}
+	if p.Level != nil {
+		c.Level = *p.Level
+	}
+	if p.Role != nil {
+		c.Role = *p.Role
+	}
+	if p.Language != nil {
+		c.Language = strings.ToLower(strings.TrimSpace(*p.Language))
+	}
+	if p.TopicID != nil {
+		c.TopicID = p.TopicID
+	}
+	if p.Hint != nil {
+		c.Hint = *p.Hint
+	}
+	if p.CorrectAnswer != nil {
+		c.CorrectAnswer = *p.CorrectAnswer
+	}
+}
+
+// Validate rejects edits that could never be approved
+func (p CrawledPatch) Validate() error {
+	if p.Language != nil && !validLanguages[strings.ToLower(strings.TrimSpace(*p.Language))] {
+		return fmt.Errorf("invalid crawled question: language %s (expected en or vi)", *p.Language)
+	}
+	return nil
+}
+
+// Validate checks that the item can become a question; the topic is resolved by the service
+func (c *CrawledQuestion) Validate() error {
+	var missing []string
+	if strings.TrimSpace(c.Title) == "" {
+		missing = append(missing, "title")
+	}
+	if strings.TrimSpace(c.Content) == "" {
+		missing = append(missing, "content")
+	}
+	if strings.TrimSpace(c.Level) == "" {
+		missing = append(missing, "level")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("invalid crawled question: missing %s", strings.Join(missing, ", "))
+	}
+	if !validLanguages[c.Language] {
+		return fmt.Errorf("invalid crawled question: language %s (expected en or vi)", c.Language)
+	}
+	return nil
+}
+
+// ToQuestion builds the question an approved item is promoted to
+func (c *CrawledQuestion) ToQuestion(topicID, reviewerID uuid.UUID) *Question {
+	return NewQuestion(strings.TrimSpace(c.Title), strings.TrimSpace(c.Content), c.Level, c.Language, c.Role, c.Hint, c.CorrectAnswer, topicID, reviewerID)
+}
+
+// MarkApproved records the promotion of the item to a question
+func (c *CrawledQuestion) MarkApproved(questionID, reviewerID uuid.UUID) {
+	c.markReviewed(CrawlStatusApproved, reviewerID)
+	c.QuestionID = &questionID
+}
+
+// MarkRejected records a rejection and its reason
+func (c *CrawledQuestion) MarkRejected(reason string, reviewerID uuid.UUID) {
+	c.markReviewed(CrawlStatusRejected, reviewerID)
+	c.RejectionReason = reason
+}
+
+func (c *CrawledQuestion) markReviewed(status string, reviewerID uuid.UUID) {
+	now := time.Now()
+	c.Status = status
+	c.ReviewedBy = &reviewerID
+	c.ReviewedAt = &now
+	c.UpdatedAt = now
+}
+
+// ReviewItemResult is the outcome for one item of a bulk review action
+type ReviewItemResult struct {
+	ID         uuid.UUID  `json:"id"`
+	QuestionID *uuid.UUID `json:"question_id,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// BulkReviewResult summarises a bulk approve or reject; items are reviewed independently
+type BulkReviewResult struct {
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Items     []ReviewItemResult `json:"items"`
+}
+
+// Add records the outcome for one item
+func (r *BulkReviewResult) Add(id uuid.UUID, questionID *uuid.UUID, err error) {
+	item := ReviewItemResult{ID: id, QuestionID: questionID}
+	if err != nil {
+		item.Error = err.Error()
+		r.Failed++
+	} else {
+		r.Succeeded++
+	}
+	r.Items = append(r.Items, item)
+}
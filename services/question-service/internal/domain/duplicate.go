@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+var ErrDuplicateQuestion = errors.New("near-duplicate of an existing question")
+
+// DuplicatePolicy sets the trigram similarity (0..1 over lower-cased title + content) at which
+// a new question is reported as a possible duplicate, and at which it is rejected outright
+type DuplicatePolicy struct {
+	WarnThreshold   float64
+	RejectThreshold float64
+}
+
+// DefaultDuplicatePolicy warns at 0.6 similarity and rejects at 0.9
+func DefaultDuplicatePolicy() DuplicatePolicy {
+	return DuplicatePolicy{WarnThreshold: 0.6, RejectThreshold: 0.9}
+}
+
+// LoadDuplicatePolicy reads DUPLICATE_WARN_THRESHOLD and DUPLICATE_REJECT_THRESHOLD through getenv
+// (normally os.Getenv), keeping the default for either one that is unset
+func LoadDuplicatePolicy(getenv func(string) string) (DuplicatePolicy, error) {
+	policy := DefaultDuplicatePolicy()
+	for _, setting := range []struct {
+		env       string
+		threshold *float64
+	}{
+		{"DUPLICATE_WARN_THRESHOLD", &policy.WarnThreshold},
+		{"DUPLICATE_REJECT_THRESHOLD", &policy.RejectThreshold},
+	} {
+		if v := getenv(setting.env); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f <= 0 || f > 1 {
+				return policy, fmt.Errorf("invalid %s: must be a number in (0, 1]", setting.env)
+			}
+			*setting.threshold = f
+		}
+	}
+	if policy.WarnThreshold > policy.RejectThreshold {
+		return policy, fmt.Errorf("invalid DUPLICATE_WARN_THRESHOLD: must not exceed DUPLICATE_REJECT_THRESHOLD (%g)", policy.RejectThreshold)
+	}
+	return policy, nil
+}
+
+// DuplicateMatch is an existing question that resembles another one
+type DuplicateMatch struct {
+	QuestionID uuid.UUID `json:"question_id"`
+	Title      string    `json:"title"`
+	Status     string    `json:"status"`
+	Similarity float64   `json:"similarity"`
+}
+
+// DuplicateError rejects a question and carries the matches that caused it
+type DuplicateError struct {
+	Matches []DuplicateMatch
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("%s (%s, similarity %.2f)", ErrDuplicateQuestion, e.Matches[0].QuestionID, e.Matches[0].Similarity)
+}
+
+func (e *DuplicateError) Unwrap() error {
+	return ErrDuplicateQuestion
+}
+
+// SimilarPair is two questions whose similarity reaches a threshold
+type SimilarPair struct {
+	A          DuplicateMatch
+	B          DuplicateMatch
+	Similarity float64
+}
+
+// DuplicateCluster is a connected group of similar questions, candidates to be merged.
+// Each member's Similarity is its highest similarity to another member.
+type DuplicateCluster struct {
+	Questions     []DuplicateMatch `json:"questions"`
+	MaxSimilarity float64          `json:"max_similarity"`
+}
@@ -0,0 +1,161 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Event types question-service appends to the outbox
+const (
+	EventQuestionCreated   = "question.created"   // Whenever a question is inserted
+	EventQuestionUpdated   = "question.updated"   // Whenever a question is saved
+	EventQuestionPublished = "question.published" // After question.created or question.updated, when the insert or save published it
+	EventQuestionArchived  = "question.archived"  // After question.updated, when the save archived it
+	EventQuestionDeleted   = "question.deleted"   // Whenever a question is deleted
+	EventQuestionRegrouped = "question.regrouped" // Whenever a question moves to another translation group
+)
+
+// Event is a domain event written to the outbox in the same transaction as the change it describes.
+// The outbox assigns its ID and time.
+type Event struct {
+	AggregateType string
+	AggregateID   uuid.UUID
+	Type          string
+	Payload       interface{}
+}
+
+// QuestionCreated is the payload of question.created
+type QuestionCreated struct {
+	QuestionID         uuid.UUID `json:"question_id"`
+	Title              string    `json:"title"`
+	Level              string    `json:"level"`
+	Language           string    `json:"language"`
+	Role               string    `json:"role"`
+	TopicID            uuid.UUID `json:"topic_id"`
+	Status             string    `json:"status"`
+	CreatedBy          uuid.UUID `json:"created_by"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+}
+
+func NewQuestionCreatedEvent(q *Question) Event {
+	return Event{
+		AggregateType: "question",
+		AggregateID:   q.ID,
+		Type:          EventQuestionCreated,
+		Payload: QuestionCreated{
+			QuestionID:         q.ID,
+			Title:              q.Title,
+			Level:              q.Level,
+			Language:           q.Language,
+			Role:               q.Role,
+			TopicID:            q.TopicID,
+			Status:             q.Status,
+			CreatedBy:          q.CreatedBy,
+			TranslationGroupID: q.TranslationGroupID,
+		},
+	}
+}
+
+// NewQuestionInsertedEvents describes an inserted question: question.created, then question.published
+// when it was inserted already published (an approved crawled question, a published import)
+func NewQuestionInsertedEvents(q *Question) []Event {
+	events := []Event{NewQuestionCreatedEvent(q)}
+	if q.Status == StatusPublished {
+		events = append(events, Event{
+			AggregateType: "question",
+			AggregateID:   q.ID,
+			Type:          EventQuestionPublished,
+			Payload:       newQuestionChanged(q, ""),
+		})
+	}
+	return events
+}
+
+// QuestionChanged is the payload of question.updated, question.published and question.archived
+type QuestionChanged struct {
+	QuestionID         uuid.UUID `json:"question_id"`
+	Title              string    `json:"title"`
+	Level              string    `json:"level"`
+	Language           string    `json:"language"`
+	Role               string    `json:"role"`
+	TopicID            uuid.UUID `json:"topic_id"`
+	Status             string    `json:"status"`
+	PreviousStatus     string    `json:"previous_status"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+}
+
+func newQuestionChanged(q *Question, previousStatus string) QuestionChanged {
+	return QuestionChanged{
+		QuestionID:         q.ID,
+		Title:              q.Title,
+		Level:              q.Level,
+		Language:           q.Language,
+		Role:               q.Role,
+		TopicID:            q.TopicID,
+		Status:             q.Status,
+		PreviousStatus:     previousStatus,
+		TranslationGroupID: q.TranslationGroupID,
+	}
+}
+
+// NewQuestionChangedEvents describes a saved question: question.updated, then question.published or
+// question.archived when the save moved it into that status
+func NewQuestionChangedEvents(q *Question, previousStatus string) []Event {
+	payload := newQuestionChanged(q, previousStatus)
+	types := []string{EventQuestionUpdated}
+	if q.Status != previousStatus {
+		switch q.Status {
+		case StatusPublished:
+			types = append(types, EventQuestionPublished)
+		case StatusArchived:
+			types = append(types, EventQuestionArchived)
+		}
+	}
+
+	events := make([]Event, len(types))
+	for i, eventType := range types {
+		events[i] = Event{AggregateType: "question", AggregateID: q.ID, Type: eventType, Payload: payload}
+	}
+	return events
+}
+
+// QuestionDeleted is the payload of question.deleted
+type QuestionDeleted struct {
+	QuestionID         uuid.UUID `json:"question_id"`
+	Language           string    `json:"language"`
+	Status             string    `json:"status"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+}
+
+func NewQuestionDeletedEvent(q *Question) Event {
+	return Event{
+		AggregateType: "question",
+		AggregateID:   q.ID,
+		Type:          EventQuestionDeleted,
+		Payload: QuestionDeleted{
+			QuestionID:         q.ID,
+			Language:           q.Language,
+			Status:             q.Status,
+			TranslationGroupID: q.TranslationGroupID,
+		},
+	}
+}
+
+// QuestionRegrouped is the payload of question.regrouped
+type QuestionRegrouped struct {
+	QuestionID                 uuid.UUID `json:"question_id"`
+	Language                   string    `json:"language"`
+	TranslationGroupID         uuid.UUID `json:"translation_group_id"`
+	PreviousTranslationGroupID uuid.UUID `json:"previous_translation_group_id"`
+}
+
+func NewQuestionRegroupedEvent(q *Question, previousGroupID uuid.UUID) Event {
+	return Event{
+		AggregateType: "question",
+		AggregateID:   q.ID,
+		Type:          EventQuestionRegrouped,
+		Payload: QuestionRegrouped{
+			QuestionID:                 q.ID,
+			Language:                   q.Language,
+			TranslationGroupID:         q.TranslationGroupID,
+			PreviousTranslationGroupID: previousGroupID,
+		},
+	}
+}
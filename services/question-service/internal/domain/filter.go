@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sort keys for question listings
+const (
+	SortCreatedAt  = "created_at"
+	SortUpdatedAt  = "updated_at"
+	SortPopularity = "popularity" // number of practice attempts
+)
+
+// QuestionFilter narrows and orders a question listing; zero values mean "any"
+type QuestionFilter struct {
+	TopicID     *uuid.UUID // Includes descendant topics
+	Level       string
+	Language    string
+	Role        string
+	Status      string
+	CreatedBy   *uuid.UUID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Tags        []string // Tag slugs; questions must carry all of them
+	Sort        string   // SortCreatedAt (default), SortUpdatedAt or SortPopularity
+	Ascending   bool
+	Cursor      string // Opaque keyset cursor from a previous page's NextCursor
+	Offset      int    // Rows to skip, for the v1 listing; cannot be combined with Cursor
+	Limit       int
+}
+
+// QuestionPage is one page of a keyset-paginated listing
+type QuestionPage struct {
+	Items      []*Question `json:"items"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	Total      int         `json:"total"`
+}
+
+// MaxBatchQuestions caps how many questions one batch lookup may name
+const MaxBatchQuestions = 100
+
+// maxPoolGroups caps how many questions a pool filter may name in GroupsOf
+const maxPoolGroups = 1000
+
+// PoolFilter selects the published questions a practice session can draw from. Practice applies its own
+// level and history rules to the pool; zero values mean "any", except the language, which defaults to en.
+type PoolFilter struct {
+	TopicID  *uuid.UUID  `json:"topic_id"`  // Includes descendant topics
+	Stacks   []string    `json:"stacks"`    // Topic names, slugs or aliases, with descendants; ignored with TopicID
+	Roles    []string    `json:"roles"`     // Questions for one of these roles or for "Any"
+	Tags     []string    `json:"tags"`      // Tag slugs or names
+	AllTags  bool        `json:"all_tags"`  // Questions must carry every tag rather than any
+	Language string      `json:"language"`  // en or vi
+	GroupsOf []uuid.UUID `json:"groups_of"` // Only the translation groups of these questions, such as bookmarks
+}
+
+// Validate checks the language and the size of GroupsOf
+func (f *PoolFilter) Validate() error {
+	if f.Language != "" && !IsValidLanguage(f.Language) {
+		return fmt.Errorf("invalid pool filter: language %s (expected en or vi)", f.Language)
+	}
+	if len(f.GroupsOf) > maxPoolGroups {
+		return fmt.Errorf("invalid pool filter: groups_of names more than %d questions", maxPoolGroups)
+	}
+	return nil
+}
+
+// PoolQuestion is a question a practice session can draw, with the fields its selection reads
+type PoolQuestion struct {
+	ID                 uuid.UUID `json:"id"`
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+	Level              string    `json:"level"`
+}
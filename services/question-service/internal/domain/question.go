@@ -1,11 +1,36 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Question statuses. Only published questions are served to practice sessions.
+const (
+	StatusDraft     = "draft"
+	StatusReview    = "review"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
+
+var (
+	ErrForbidden               = errors.New("not allowed to modify this question")
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+)
+
+// statusTransitions lists the statuses each status may move to:
+// draft → review → published → archived, with review able to bounce back to draft
+// and archived questions restorable as drafts.
+var statusTransitions = map[string][]string{
+	StatusDraft:     {StatusReview, StatusArchived},
+	StatusReview:    {StatusDraft, StatusPublished, StatusArchived},
+	StatusPublished: {StatusArchived},
+	StatusArchived:  {StatusDraft},
+}
+
 // Question represents the core domain entity for a question
 type Question struct {
 	ID            uuid.UUID `json:"id"`
@@ -21,23 +46,107 @@ type Question struct {
 	Status        string    `json:"status"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
+	ExternalID    string    `json:"external_id,omitempty"` // Import source identifier, unique when set
+	// TranslationGroupID links the language versions of one logical question (at most one per language).
+	// It is the ID of the group's canonical question.
+	TranslationGroupID uuid.UUID `json:"translation_group_id"`
+	Tags               []*Tag    `json:"tags"`
+}
+
+// QuestionPatch holds the fields to change on a question; nil fields are left untouched
+type QuestionPatch struct {
+	Title         *string
+	Content       *string
+	Level         *string
+	Language      *string
+	Role          *string
+	Hint          *string
+	CorrectAnswer *string
+	TopicID       *uuid.UUID
+	Status        *string
 }
 
-// NewQuestion creates a new question instance
+// NewQuestion creates a new question instance as a draft, canonical in a translation group of its own
 func NewQuestion(title, content, level, language, role, hint, correctAnswer string, topicID, createdBy uuid.UUID) *Question {
+	id := uuid.New()
 	return &Question{
-		ID:            uuid.New(),
-		Title:         title,
-		Content:       content,
-		Level:         level,
-		Language:      language,
-		Role:          role,
-		Hint:          hint,
-		CorrectAnswer: correctAnswer,
-		TopicID:       topicID,
-		CreatedBy:     createdBy,
-		Status:        "published",
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+		ID:                 id,
+		Title:              title,
+		Content:            content,
+		Level:              level,
+		Language:           language,
+		Role:               role,
+		Hint:               hint,
+		CorrectAnswer:      correctAnswer,
+		TopicID:            topicID,
+		CreatedBy:          createdBy,
+		Status:             StatusDraft,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+		TranslationGroupID: id,
+		Tags:               []*Tag{},
+	}
+}
+
+// NewTranslation creates a draft of the question in another language. It joins the question's
+// translation group and inherits its topic, level and role.
+func (q *Question) NewTranslation(language, title, content, hint, correctAnswer string, createdBy uuid.UUID) *Question {
+	t := NewQuestion(title, content, q.Level, language, q.Role, hint, correctAnswer, q.TopicID, createdBy)
+	t.TranslationGroupID = q.TranslationGroupID
+	return t
+}
+
+// IsCanonical reports whether the question is the one its translation group is named after
+func (q *Question) IsCanonical() bool {
+	return q.ID == q.TranslationGroupID
+}
+
+// CanTransition reports whether the state machine allows moving from one status to another
+func CanTransition(from, to string) bool {
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo moves the question to a new status if the state machine allows it
+func (q *Question) TransitionTo(status string) error {
+	if q.Status == status {
+		return nil
+	}
+	if !CanTransition(q.Status, status) {
+		return fmt.Errorf("%w: %s → %s", ErrInvalidStatusTransition, q.Status, status)
+	}
+	q.Status = status
+	return nil
+}
+
+// Apply copies the non-nil content fields of a patch onto the question (status is handled by TransitionTo)
+func (q *Question) Apply(p QuestionPatch) {
+	if p.Title != nil {
+		q.Title = *p.Title
+	}
+	if p.Content != nil {
+		q.Content = *p.Content
+	}
+	if p.Level != nil {
+		q.Level = *p.Level
+	}
+	if p.Language != nil {
+		q.Language = *p.Language
+	}
+	if p.Role != nil {
+		q.Role = *p.Role
+	}
+	if p.Hint != nil {
+		q.Hint = *p.Hint
+	}
+	if p.CorrectAnswer != nil {
+		q.CorrectAnswer = *p.CorrectAnswer
+	}
+	if p.TopicID != nil {
+		q.TopicID = *p.TopicID
 	}
 }
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuestionRevision is a snapshot of a question's editable content taken on every change
+type QuestionRevision struct {
+	ID             uuid.UUID `json:"id"`
+	QuestionID     uuid.UUID `json:"question_id"`
+	RevisionNumber int       `json:"revision_number"`
+	Title          string    `json:"title"`
+	Content        string    `json:"content"`
+	Level          string    `json:"level"`
+	Hint           string    `json:"hint"`
+	CorrectAnswer  string    `json:"correct_answer"`
+	AuthorID       uuid.UUID `json:"author_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// FieldDiff describes one field that differs between two revisions
+type FieldDiff struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// NewQuestionRevision snapshots the question; the repository assigns RevisionNumber
+func NewQuestionRevision(q *Question, authorID uuid.UUID) *QuestionRevision {
+	return &QuestionRevision{
+		ID:            uuid.New(),
+		QuestionID:    q.ID,
+		Title:         q.Title,
+		Content:       q.Content,
+		Level:         q.Level,
+		Hint:          q.Hint,
+		CorrectAnswer: q.CorrectAnswer,
+		AuthorID:      authorID,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// DiffRevisions returns the snapshot fields that changed from one revision to another
+func DiffRevisions(from, to *QuestionRevision) []FieldDiff {
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"title", from.Title, to.Title},
+		{"content", from.Content, to.Content},
+		{"level", from.Level, to.Level},
+		{"hint", from.Hint, to.Hint},
+		{"correct_answer", from.CorrectAnswer, to.CorrectAnswer},
+	}
+
+	diffs := []FieldDiff{}
+	for _, f := range fields {
+		if f.from != f.to {
+			diffs = append(diffs, FieldDiff{Field: f.name, From: f.from, To: f.to})
+		}
+	}
+	return diffs
+}
+
+// Patch builds a patch that restores the revision's snapshot fields
+func (r *QuestionRevision) Patch() QuestionPatch {
+	return QuestionPatch{
+		Title:         &r.Title,
+		Content:       &r.Content,
+		Level:         &r.Level,
+		Hint:          &r.Hint,
+		CorrectAnswer: &r.CorrectAnswer,
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "github.com/google/uuid"
+
+// SearchQuery is a full-text search over question title, content and correct answer
+type SearchQuery struct {
+	Text     string
+	Language string     // "en" or "vi"; empty searches both
+	TopicID  *uuid.UUID // Includes descendant topics
+	Level    string
+	Status   string   // Defaults to published
+	Tags     []string // Tag slugs; hits must carry all of them
+	Limit    int
+	Offset   int
+}
+
+// SearchHit is one ranked match with highlighted fragments (matches wrapped in <mark>)
+type SearchHit struct {
+	Question       *Question `json:"question"`
+	Rank           float64   `json:"rank"`
+	TitleHighlight string    `json:"title_highlight"`
+	Snippet        string    `json:"snippet"`
+}
+
+// FacetCount is the number of matches sharing one facet value
+type FacetCount struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int    `json:"count"`
+}
+
+// SearchFacets counts all matches (not just the current page) by topic and level
+type SearchFacets struct {
+	Topics []FacetCount `json:"topics"`
+	Levels []FacetCount `json:"levels"`
+}
+
+// SearchResult is a page of search hits plus facets over the whole match set
+type SearchResult struct {
+	Hits   []*SearchHit `json:"hits"`
+	Total  int          `json:"total"`
+	Facets SearchFacets `json:"facets"`
+}
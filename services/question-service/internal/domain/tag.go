@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// Tag labels questions across topics (e.g. "Concurrency" on a Golang question).
+// Questions have one topic but any number of tags.
+type Tag struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func NewTag(name, description string) *Tag {
+	now := time.Now()
+	return &Tag{
+		ID:          uuid.New(),
+		Name:        strings.TrimSpace(name),
+		Slug:        Slugify(name),
+		Description: description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Rename changes the tag name and its slug together
+func (t *Tag) Rename(name string) {
+	t.Name = strings.TrimSpace(name)
+	t.Slug = Slugify(name)
+	t.UpdatedAt = time.Now()
+}
+
+// slugSymbols keeps names like "C++" and "C#" distinct from "C"
+var slugSymbols = strings.NewReplacer("+", " plus ", "#", " sharp ")
+
+// Slugify lower-cases a name and joins its letters and digits with hyphens: "System Design" → "system-design"
+func Slugify(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(slugSymbols.Replace(name)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, "-")
+}
@@ -1,19 +1,44 @@
 package domain
 
 import (
+	"strings"
+
 	"github.com/google/uuid"
 )
 
+// Topic is a node in the topic tree. Selecting a topic also selects its descendants,
+// and aliases let stack names and user input ("go", "node.js") resolve to it.
 type Topic struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Slug        string     `json:"slug"`
+	Description string     `json:"description"`
+	ParentID    *uuid.UUID `json:"parent_id"`
+	Aliases     []string   `json:"aliases"`
 }
 
-func NewTopic(name, description string) *Topic {
+func NewTopic(name, description string, parentID *uuid.UUID, aliases []string) *Topic {
 	return &Topic{
 		ID:          uuid.New(),
 		Name:        name,
+		Slug:        Slugify(name),
 		Description: description,
+		ParentID:    parentID,
+		Aliases:     NormalizeAliases(aliases),
+	}
+}
+
+// NormalizeAliases lower-cases and trims aliases, dropping blanks and repeats
+func NormalizeAliases(aliases []string) []string {
+	seen := make(map[string]bool, len(aliases))
+	normalized := []string{}
+	for _, a := range aliases {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == "" || seen[a] {
+			continue
+		}
+		seen[a] = true
+		normalized = append(normalized, a)
 	}
+	return normalized
 }
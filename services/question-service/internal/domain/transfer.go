@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bulk transfer formats
+const (
+	FormatJSONL    = "jsonl"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown" // YAML front matter per question, content as the Markdown body
+)
+
+// QuestionRecord is one question in an import or export file. Topics are referenced by
+// name (or slug/alias) and tags by slug, so files are portable between databases.
+type QuestionRecord struct {
+	Row           int      `json:"-" yaml:"-"` // 1-based position in the source file, for error reports
+	ExternalID    string   `json:"external_id" yaml:"external_id"`
+	Title         string   `json:"title" yaml:"title"`
+	Content       string   `json:"content" yaml:"-"`
+	Level         string   `json:"level" yaml:"level"`
+	Language      string   `json:"language" yaml:"language"`
+	Role          string   `json:"role,omitempty" yaml:"role,omitempty"`
+	Topic         string   `json:"topic" yaml:"topic"`
+	Status        string   `json:"status,omitempty" yaml:"status,omitempty"`
+	Tags          []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Hint          string   `json:"hint,omitempty" yaml:"-"`
+	CorrectAnswer string   `json:"correct_answer,omitempty" yaml:"-"`
+	// TranslationOf is the external ID (or ID) of the question this record translates
+	TranslationOf string `json:"translation_of,omitempty" yaml:"translation_of,omitempty"`
+}
+
+// ImportRowError reports why one record was not imported
+type ImportRowError struct {
+	Row        int    `json:"row"`
+	ExternalID string `json:"external_id,omitempty"`
+	Error      string `json:"error"`
+}
+
+// ImportResult summarises a bulk import; rows are imported independently
+type ImportResult struct {
+	DryRun  bool             `json:"dry_run"`
+	Created int              `json:"created"`
+	Updated int              `json:"updated"`
+	Failed  int              `json:"failed"`
+	Errors  []ImportRowError `json:"errors"`
+}
+
+// AddError records a failed row
+func (r *ImportResult) AddError(row int, externalID string, err error) {
+	r.Failed++
+	r.Errors = append(r.Errors, ImportRowError{Row: row, ExternalID: externalID, Error: err.Error()})
+}
+
+// AddRowErrors records rows that could not be parsed, ahead of the rows rejected on import
+func (r *ImportResult) AddRowErrors(errs []ImportRowError) {
+	r.Failed += len(errs)
+	r.Errors = append(append([]ImportRowError{}, errs...), r.Errors...)
+}
+
+var validLanguages = map[string]bool{"en": true, "vi": true}
+
+// IsValidLanguage reports whether questions can be written in the language
+func IsValidLanguage(language string) bool {
+	return validLanguages[language]
+}
+
+// Validate checks a record's own fields; topic, tags and duplicates are checked against the database by the service
+func (r *QuestionRecord) Validate() error {
+	var missing []string
+	if strings.TrimSpace(r.Title) == "" {
+		missing = append(missing, "title")
+	}
+	if strings.TrimSpace(r.Content) == "" {
+		missing = append(missing, "content")
+	}
+	if strings.TrimSpace(r.Level) == "" {
+		missing = append(missing, "level")
+	}
+	if strings.TrimSpace(r.Topic) == "" {
+		missing = append(missing, "topic")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
+	}
+	if r.Language != "" && !validLanguages[r.Language] {
+		return fmt.Errorf("invalid language: %s (expected en or vi)", r.Language)
+	}
+	if r.Status != "" {
+		if _, ok := statusTransitions[r.Status]; !ok {
+			return fmt.Errorf("invalid status: %s", r.Status)
+		}
+	}
+	if r.TranslationOf != "" && r.TranslationOf == r.ExternalID {
+		return fmt.Errorf("invalid translation_of: a question cannot translate itself")
+	}
+	return nil
+}
+
+// DuplicateKey identifies records with the same content in the same language, as the seeder's ValidateQuestions does
+func (r *QuestionRecord) DuplicateKey() string {
+	return strings.ToLower(strings.TrimSpace(r.Language)) + "::" + strings.TrimSpace(r.Content)
+}
@@ -11,9 +11,26 @@ import (
 type QuestionRepository interface {
 	Create(ctx context.Context, question *domain.Question) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Question, error)
-	List(ctx context.Context, limit, offset int) ([]*domain.Question, error)
+	// GetByIDs returns the questions that exist among ids, in no particular order
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Question, error)
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Question, error)
+	GetByExternalID(ctx context.Context, externalID string) (*domain.Question, error)
+	List(ctx context.Context, filter domain.QuestionFilter) (*domain.QuestionPage, error)
+	ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error)
+	Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchResult, error)
+	FindSimilar(ctx context.Context, title, content, language string, threshold float64, limit int) ([]domain.DuplicateMatch, error)
+	ListSimilarPairs(ctx context.Context, threshold float64, limit int) ([]domain.SimilarPair, error)
 	Update(ctx context.Context, question *domain.Question) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	ListTranslations(ctx context.Context, groupID uuid.UUID) ([]*domain.Question, error)
+	SetTranslationGroup(ctx context.Context, question *domain.Question) error
+}
+
+// RevisionRepository defines the interface for question revision history
+type RevisionRepository interface {
+	Create(ctx context.Context, revision *domain.QuestionRevision) error
+	ListByQuestionID(ctx context.Context, questionID uuid.UUID) ([]*domain.QuestionRevision, error)
+	GetByNumber(ctx context.Context, questionID uuid.UUID, revisionNumber int) (*domain.QuestionRevision, error)
 }
 
 // TopicRepository defines the interface for topic data access
@@ -22,16 +39,105 @@ type TopicRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Topic, error)
 	GetByName(ctx context.Context, name string) (*domain.Topic, error)
 	List(ctx context.Context) ([]*domain.Topic, error)
+	Update(ctx context.Context, topic *domain.Topic) error
+	ListSubtreeIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+}
+
+// TagRepository defines the interface for tag data access and question tagging
+type TagRepository interface {
+	Create(ctx context.Context, tag *domain.Tag) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Tag, error)
+	GetBySlugs(ctx context.Context, slugs []string) ([]*domain.Tag, error)
+	List(ctx context.Context) ([]*domain.Tag, error)
+	Update(ctx context.Context, tag *domain.Tag) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	SetQuestionTags(ctx context.Context, questionID uuid.UUID, tagIDs []uuid.UUID) error
+	ListByQuestionIDs(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID][]*domain.Tag, error)
+}
+
+// CrawledQuestionRepository defines the interface for the crawler's staging table.
+// Reject and Promote only act on pending items, so concurrent reviews cannot both succeed.
+type CrawledQuestionRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.CrawledQuestion, error)
+	List(ctx context.Context, filter domain.CrawledFilter) (*domain.CrawledPage, error)
+	Update(ctx context.Context, item *domain.CrawledQuestion) error
+	SaveClassification(ctx context.Context, id uuid.UUID, classification *domain.Classification) error
+	Reject(ctx context.Context, item *domain.CrawledQuestion) error
+	// Promote stores the question and marks the item approved in one transaction
+	Promote(ctx context.Context, item *domain.CrawledQuestion, question *domain.Question) error
+}
+
+// AIService proposes a topic (one of the given names), level and role for a question.
+// It is optional: without it crawled questions are classified by keyword rules alone.
+type AIService interface {
+	ClassifyQuestion(ctx context.Context, title, content string, topics []string) (*domain.Classification, error)
 }
 
 // QuestionService defines the interface for business logic
 type QuestionService interface {
-	CreateQuestion(ctx context.Context, title, content, level, language, role, hint, correctAnswer string, topicID, createdBy uuid.UUID) (*domain.Question, error)
+	CreateQuestion(ctx context.Context, title, content, level, language, role, hint, correctAnswer string, topicID, createdBy uuid.UUID, tags []string, allowDuplicate bool) (*domain.Question, []domain.DuplicateMatch, error)
 	GetQuestion(ctx context.Context, id uuid.UUID) (*domain.Question, error)
-	ListQuestions(ctx context.Context, limit, offset int) ([]*domain.Question, error)
+	// GetQuestions looks up to MaxBatchQuestions questions at once, leaving out those that do not exist
+	GetQuestions(ctx context.Context, ids []uuid.UUID) ([]*domain.Question, error)
+	ListQuestions(ctx context.Context, filter domain.QuestionFilter, actorID uuid.UUID, actorRole domain.Role) (*domain.QuestionPage, error)
+	// ListPool lists the published questions a practice session can draw from
+	ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error)
+	SearchQuestions(ctx context.Context, query domain.SearchQuery, actorRole domain.Role) (*domain.SearchResult, error)
+	ListDuplicateClusters(ctx context.Context, threshold float64, limit int) ([]domain.DuplicateCluster, error)
+	ImportQuestions(ctx context.Context, records []domain.QuestionRecord, actorID uuid.UUID, actorRole domain.Role, dryRun bool) (*domain.ImportResult, error)
+	ExportQuestions(ctx context.Context, filter domain.QuestionFilter) ([]domain.QuestionRecord, error)
+	UpdateQuestion(ctx context.Context, id uuid.UUID, patch domain.QuestionPatch, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error)
+	DeleteQuestion(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) error
+
+	// Translation methods
+	ListTranslations(ctx context.Context, questionID uuid.UUID) ([]*domain.Question, error)
+	CreateTranslation(ctx context.Context, questionID uuid.UUID, language, title, content, hint, correctAnswer string, actorID uuid.UUID) (*domain.Question, error)
+	LinkTranslation(ctx context.Context, questionID, translationID, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error)
+	UnlinkTranslation(ctx context.Context, questionID, translationID, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error)
+
+	// Revision methods
+	ListRevisions(ctx context.Context, questionID uuid.UUID) ([]*domain.QuestionRevision, error)
+	DiffRevisions(ctx context.Context, questionID uuid.UUID, from, to int) ([]domain.FieldDiff, error)
+	RollbackToRevision(ctx context.Context, questionID uuid.UUID, revisionNumber int, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error)
 
 	// Topic methods
-	CreateTopic(ctx context.Context, name, description string) (*domain.Topic, error)
+	CreateTopic(ctx context.Context, name, description string, parentID *uuid.UUID, aliases []string) (*domain.Topic, error)
+	UpdateTopic(ctx context.Context, id uuid.UUID, name, description string, parentID *uuid.UUID, aliases []string) (*domain.Topic, error)
+	GetTopic(ctx context.Context, id uuid.UUID) (*domain.Topic, error)
 	GetTopicByName(ctx context.Context, name string) (*domain.Topic, error)
 	ListTopics(ctx context.Context) ([]*domain.Topic, error)
+
+	// Tag methods
+	CreateTag(ctx context.Context, name, description string) (*domain.Tag, error)
+	GetTag(ctx context.Context, id uuid.UUID) (*domain.Tag, error)
+	ListTags(ctx context.Context) ([]*domain.Tag, error)
+	UpdateTag(ctx context.Context, id uuid.UUID, name, description string) (*domain.Tag, error)
+	DeleteTag(ctx context.Context, id uuid.UUID) error
+	SetQuestionTags(ctx context.Context, questionID uuid.UUID, slugs []string, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error)
+}
+
+// ReviewService defines the moderation workflow for crawled questions
+type ReviewService interface {
+	ListCrawled(ctx context.Context, filter domain.CrawledFilter) (*domain.CrawledPage, error)
+	GetCrawled(ctx context.Context, id uuid.UUID) (*domain.CrawledQuestion, error)
+	UpdateCrawled(ctx context.Context, id uuid.UUID, patch domain.CrawledPatch, actorRole domain.Role) (*domain.CrawledQuestion, error)
+	ApproveCrawled(ctx context.Context, id uuid.UUID, allowDuplicate bool, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, []domain.DuplicateMatch, error)
+	RejectCrawled(ctx context.Context, id uuid.UUID, reason string, actorID uuid.UUID, actorRole domain.Role) (*domain.CrawledQuestion, error)
+	BulkApproveCrawled(ctx context.Context, ids []uuid.UUID, allowDuplicate bool, actorID uuid.UUID, actorRole domain.Role) (*domain.BulkReviewResult, error)
+	BulkRejectCrawled(ctx context.Context, ids []uuid.UUID, reason string, actorID uuid.UUID, actorRole domain.Role) (*domain.BulkReviewResult, error)
+	ClassifyCrawled(ctx context.Context, id uuid.UUID, actorRole domain.Role) (*domain.CrawledQuestion, error)
+	ClassifyPending(ctx context.Context, limit int, reclassify bool, actorID uuid.UUID, actorRole domain.Role) (*domain.ClassifyResult, error)
+	// ClassifyNew classifies the unclassified pending items for the background classifier, without a role check
+	ClassifyNew(ctx context.Context, actorID uuid.UUID) (*domain.ClassifyResult, error)
+}
+
+// RoleReader reads a user's current role, so role changes apply before the user's token expires
+type RoleReader interface {
+	GetUserRole(ctx context.Context, userID uuid.UUID) (domain.Role, error)
+}
+
+// Transactor runs fn in one transaction; repository calls made with the context fn receives
+// join it, and nothing fn wrote is kept if it returns an error
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
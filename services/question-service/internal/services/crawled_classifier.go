@@ -0,0 +1,378 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+// ClassifyCrawled proposes a topic, level and role for one pending item and stores the proposal
+func (s *reviewService) ClassifyCrawled(ctx context.Context, id uuid.UUID, actorRole domain.Role) (*domain.CrawledQuestion, error) {
+	if !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can review crawled questions", domain.ErrForbidden)
+	}
+	item, err := s.pendingItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := s.topicRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.classify(ctx, item, topics); err != nil {
+		return nil, err
+	}
+	// Reload so the proposal shows through wherever a reviewer has not edited the item
+	return s.crawledRepo.GetByID(ctx, id)
+}
+
+// ClassifyPending classifies up to limit pending items, oldest first, skipping items classified before
+// unless reclassify is set. Items whose confidence reaches the auto-approve threshold are approved as
+// actorID; the rest wait for a reviewer. Each item is handled on its own.
+func (s *reviewService) ClassifyPending(ctx context.Context, limit int, reclassify bool, actorID uuid.UUID, actorRole domain.Role) (*domain.ClassifyResult, error) {
+	if !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can review crawled questions", domain.ErrForbidden)
+	}
+	if limit <= 0 || limit > maxBulkReview {
+		limit = maxBulkReview
+	}
+	return s.classifyBatch(ctx, limit, reclassify, actorID)
+}
+
+// ClassifyNew classifies the oldest pending items no one has classified yet, auto-approving confident ones
+// as actorID. It is the background classifier's entry point and does not check a role: the account it runs
+// as is configured by the operator.
+func (s *reviewService) ClassifyNew(ctx context.Context, actorID uuid.UUID) (*domain.ClassifyResult, error) {
+	return s.classifyBatch(ctx, maxBulkReview, false, actorID)
+}
+
+func (s *reviewService) classifyBatch(ctx context.Context, limit int, reclassify bool, actorID uuid.UUID) (*domain.ClassifyResult, error) {
+	page, err := s.crawledRepo.List(ctx, domain.CrawledFilter{Status: domain.CrawlStatusPending, Unclassified: !reclassify, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	topics, err := s.topicRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ClassifyResult{Items: []domain.ClassifiedItem{}}
+	for _, item := range page.Items {
+		classification, err := s.classify(ctx, item, topics)
+		if err != nil {
+			result.Failed++
+			result.Items = append(result.Items, domain.ClassifiedItem{ID: item.ID, Error: err.Error()})
+			continue
+		}
+		result.Classified++
+		entry := domain.ClassifiedItem{ID: item.ID, Classification: classification}
+		if s.classification.ShouldAutoApprove(classification) {
+			if questionID, err := s.autoApprove(ctx, item.ID, actorID); err != nil {
+				entry.Error = "auto-approval failed: " + err.Error()
+			} else {
+				entry.QuestionID = &questionID
+				result.AutoApproved++
+			}
+		}
+		result.Items = append(result.Items, entry)
+	}
+	return result, nil
+}
+
+// RunClassifier classifies newly crawled items as they arrive, until ctx is done: it runs a batch, then
+// another straight away while batches come back full, and otherwise waits interval. Auto-approved
+// questions are recorded as actorID.
+func RunClassifier(ctx context.Context, review ports.ReviewService, interval time.Duration, actorID uuid.UUID) {
+	for {
+		result, err := review.ClassifyNew(ctx, actorID)
+		switch {
+		case err != nil:
+			log.Printf("crawled question classifier: %v", err)
+		case result.Classified+result.Failed > 0:
+			log.Printf("crawled question classifier: %d classified, %d auto-approved, %d failed", result.Classified, result.AutoApproved, result.Failed)
+		}
+		// Items that failed stay unclassified and come back in the next batch, so only a clean full batch skips the wait
+		if err == nil && result.Failed == 0 && result.Classified == maxBulkReview {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// autoApprove approves a freshly classified item. Near-duplicates are never let through, so they stay pending for a reviewer.
+func (s *reviewService) autoApprove(ctx context.Context, id, actorID uuid.UUID) (uuid.UUID, error) {
+	item, err := s.crawledRepo.GetByID(ctx, id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	question, _, err := s.approve(ctx, item, false, actorID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return question.ID, nil
+}
+
+// classify runs the keyword rules, consults the AI service when the rules alone are not confident
+// enough to auto-approve, and stores the result
+func (s *reviewService) classify(ctx context.Context, item *domain.CrawledQuestion, topics []*domain.Topic) (*domain.Classification, error) {
+	classification := classifyByRules(item, topics)
+	if s.ai != nil && !s.classification.ShouldAutoApprove(classification) {
+		// The AI service is optional: when it fails, the rules' proposal stands
+		if proposal, err := s.aiClassification(ctx, item, topics); err == nil {
+			classification = mergeClassifications(classification, proposal)
+		}
+	}
+	if err := s.crawledRepo.SaveClassification(ctx, item.ID, classification); err != nil {
+		return nil, err
+	}
+	return classification, nil
+}
+
+// aiClassification asks the AI service to pick among the existing topics, and drops any topic,
+// level or role it returns that the platform does not use
+func (s *reviewService) aiClassification(ctx context.Context, item *domain.CrawledQuestion, topics []*domain.Topic) (*domain.Classification, error) {
+	names := make([]string, len(topics))
+	for i, t := range topics {
+		names[i] = t.Name
+	}
+	proposal, err := s.ai.ClassifyQuestion(ctx, item.Title, item.Content, names)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.TopicID = nil
+	for _, t := range topics {
+		if strings.EqualFold(t.Name, strings.TrimSpace(proposal.Topic)) {
+			id := t.ID
+			proposal.TopicID, proposal.Topic = &id, t.Name
+			break
+		}
+	}
+	proposal.Level = knownValue(proposal.Level, levelRules)
+	proposal.Role = knownValue(proposal.Role, roleRules)
+	proposal.TopicConfidence = clampConfidence(proposal.TopicConfidence)
+	proposal.LevelConfidence = clampConfidence(proposal.LevelConfidence)
+	proposal.RoleConfidence = clampConfidence(proposal.RoleConfidence)
+	return proposal, nil
+}
+
+// knownValue returns the rule value matching v case-insensitively, or "" when no rule has it
+func knownValue(v string, rules []keywordRule) string {
+	for _, rule := range rules {
+		if strings.EqualFold(rule.value, strings.TrimSpace(v)) {
+			return rule.value
+		}
+	}
+	return ""
+}
+
+func clampConfidence(c float64) float64 {
+	return math.Max(0, math.Min(1, c))
+}
+
+// keywordRule proposes a value when any of its keywords appears in a question
+type keywordRule struct {
+	value    string
+	keywords []string
+}
+
+// levelRules and roleRules extend the crawler normalizer's heuristics (English and Vietnamese)
+var levelRules = []keywordRule{
+	{"Fresher", []string{"fresher", "intern", "internship", "new grad", "mới tốt nghiệp", "thực tập"}},
+	{"Junior", []string{"basic", "what is", "define", "difference between", "cơ bản", "là gì", "khác nhau"}},
+	{"Mid", []string{"how would you", "when would you", "implement", "debug", "khi nào", "triển khai"}},
+	{"Senior", []string{"design", "architecture", "trade-off", "trade-offs", "optimize", "scalability", "at scale", "tối ưu", "thiết kế", "kiến trúc"}},
+}
+
+var roleRules = []keywordRule{
+	{"FrontEnd", []string{"frontend", "front-end", "react", "vue", "angular", "css", "html", "browser", "dom"}},
+	{"BackEnd", []string{"backend", "back-end", "api", "database", "server", "golang", "java", "python", "sql"}},
+	{"DevOps", []string{"devops", "sre", "cloud", "aws", "docker", "kubernetes", "ci/cd", "terraform"}},
+	{"Data Engineer", []string{"data engineer", "etl", "spark", "hadoop", "warehouse", "big data", "airflow"}},
+}
+
+// Rule weights: a keyword in the title says more about a question than one in its body, and the
+// crawler's own topic guess counts like a title hit
+const (
+	titleWeight    = 2.0
+	contentWeight  = 1.0
+	detectedWeight = 2.0
+	// saturationScore is the score at which a rule is fully trusted, before competing values are considered
+	saturationScore = 4.0
+	// defaultLevelConfidence is the confidence of falling back to Mid when no level keyword matches
+	defaultLevelConfidence = 0.3
+)
+
+// classifyByRules proposes a topic, level and role from keywords, topic names, slugs and aliases
+func classifyByRules(item *domain.CrawledQuestion, topics []*domain.Topic) *domain.Classification {
+	title := strings.ToLower(item.Title)
+	content := strings.ToLower(item.Content)
+	c := &domain.Classification{Method: domain.ClassifiedByRules, ClassifiedAt: time.Now()}
+
+	topicIDs := make([]uuid.UUID, len(topics))
+	topicScores := make(map[uuid.UUID]float64, len(topics))
+	names := make(map[uuid.UUID]string, len(topics))
+	detected := strings.ToLower(strings.TrimSpace(item.DetectedTopic))
+	for i, t := range topics {
+		topicIDs[i] = t.ID
+		names[t.ID] = t.Name
+		terms := topicTerms(t)
+		score := keywordScore(title, content, terms)
+		if detected != "" && containsString(terms, detected) {
+			score += detectedWeight
+		}
+		if score > 0 {
+			topicScores[t.ID] = score
+		}
+	}
+	if id, confidence := bestScore(topicIDs, topicScores); confidence > 0 {
+		c.TopicID = &id
+		c.Topic = names[id]
+		c.TopicConfidence = confidence
+	}
+
+	c.Level, c.LevelConfidence = classifyKeywords(title, content, levelRules)
+	if c.Level == "" {
+		c.Level, c.LevelConfidence = "Mid", defaultLevelConfidence
+	}
+	c.Role, c.RoleConfidence = classifyKeywords(title, content, roleRules)
+	return c
+}
+
+// classifyKeywords returns the best matching rule value and its confidence, or "" when nothing matches
+func classifyKeywords(title, content string, rules []keywordRule) (string, float64) {
+	values := make([]string, len(rules))
+	scores := make(map[string]float64, len(rules))
+	for i, rule := range rules {
+		values[i] = rule.value
+		if score := keywordScore(title, content, rule.keywords); score > 0 {
+			scores[rule.value] = score
+		}
+	}
+	return bestScore(values, scores)
+}
+
+// bestScore picks the highest score, the first of keys on a tie. Its confidence grows with the score up
+// to saturationScore and shrinks with the share of the total taken by competing values.
+func bestScore[K comparable](keys []K, scores map[K]float64) (K, float64) {
+	var best K
+	bestValue, total := 0.0, 0.0
+	for _, k := range keys {
+		v := scores[k]
+		total += v
+		if v > bestValue {
+			best, bestValue = k, v
+		}
+	}
+	if bestValue == 0 {
+		return best, 0
+	}
+	return best, math.Min(1, bestValue/saturationScore) * bestValue / total
+}
+
+// keywordScore counts whole-word keyword matches, weighting the title above the content
+func keywordScore(title, content string, keywords []string) float64 {
+	score := 0.0
+	for _, k := range keywords {
+		if containsWord(title, k) {
+			score += titleWeight
+		}
+		if containsWord(content, k) {
+			score += contentWeight
+		}
+	}
+	return score
+}
+
+// topicTerms lists the lower-cased strings that name a topic
+func topicTerms(t *domain.Topic) []string {
+	terms := []string{strings.ToLower(t.Name)}
+	if t.Slug != "" {
+		terms = append(terms, strings.ReplaceAll(t.Slug, "-", " "))
+	}
+	return append(terms, t.Aliases...)
+}
+
+// containsWord reports whether term appears in text delimited by non-letters, so "go" does not match "good".
+// Go's regexp \b only understands ASCII, which would split Vietnamese words.
+func containsWord(text, term string) bool {
+	if utf8.RuneCountInString(term) < 2 {
+		return false
+	}
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], term)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(term)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeClassifications combines the rules with the AI service's proposal field by field: agreement
+// raises confidence, and on disagreement the more confident proposal wins
+func mergeClassifications(rules, ai *domain.Classification) *domain.Classification {
+	merged := *rules
+	merged.Method = domain.ClassifiedByAI
+	if ai.TopicID != nil {
+		switch {
+		case rules.TopicID != nil && *rules.TopicID == *ai.TopicID:
+			merged.TopicConfidence = combineConfidence(rules.TopicConfidence, ai.TopicConfidence)
+		case ai.TopicConfidence > rules.TopicConfidence:
+			merged.TopicID, merged.Topic, merged.TopicConfidence = ai.TopicID, ai.Topic, ai.TopicConfidence
+		}
+	}
+	merged.Level, merged.LevelConfidence = mergeField(rules.Level, rules.LevelConfidence, ai.Level, ai.LevelConfidence)
+	merged.Role, merged.RoleConfidence = mergeField(rules.Role, rules.RoleConfidence, ai.Role, ai.RoleConfidence)
+	return &merged
+}
+
+func mergeField(rulesValue string, rulesConfidence float64, aiValue string, aiConfidence float64) (string, float64) {
+	switch {
+	case aiValue == "":
+		return rulesValue, rulesConfidence
+	case strings.EqualFold(rulesValue, aiValue):
+		return rulesValue, combineConfidence(rulesConfidence, aiConfidence)
+	case aiConfidence > rulesConfidence:
+		return aiValue, aiConfidence
+	}
+	return rulesValue, rulesConfidence
+}
+
+// combineConfidence treats two agreeing proposals as independent evidence
+func combineConfidence(a, b float64) float64 {
+	return 1 - (1-a)*(1-b)
+}
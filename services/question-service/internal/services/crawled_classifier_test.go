@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+func TestContainsWord(t *testing.T) {
+	cases := []struct {
+		text, term string
+		want       bool
+	}{
+		{"how does go schedule goroutines?", "go", true},
+		{"a good question", "go", false},
+		{"goroutines and go", "go", true}, // the first hit is inside a word, the second is not
+		{"explain ci/cd pipelines", "ci/cd", true},
+		{"what is k8s?", "k8s", true},
+		{"k8s.", "k8s", true},
+		{"câu hỏi cơ bản về go", "cơ bản", true},
+		{"cơ bảnx", "cơ bản", false},
+		{"đicơ bản", "cơ bản", false}, // a Vietnamese letter before the term is part of the word
+		{"c", "c", false},             // one-letter terms would match too much
+		{"", "go", false},
+	}
+	for _, tc := range cases {
+		if got := containsWord(tc.text, tc.term); got != tc.want {
+			t.Errorf("containsWord(%q, %q) = %v, want %v", tc.text, tc.term, got, tc.want)
+		}
+	}
+}
+
+func TestBestScore(t *testing.T) {
+	keys := []string{"Junior", "Mid", "Senior"}
+
+	if best, confidence := bestScore(keys, map[string]float64{}); best != "" || confidence != 0 {
+		t.Fatalf("expected no pick without scores, got %q %v", best, confidence)
+	}
+	// A lone saturated score is fully trusted
+	if best, confidence := bestScore(keys, map[string]float64{"Senior": 6}); best != "Senior" || confidence != 1 {
+		t.Fatalf("expected Senior at 1, got %q %v", best, confidence)
+	}
+	// Below saturation the confidence scales with the score
+	if best, confidence := bestScore(keys, map[string]float64{"Mid": 2}); best != "Mid" || confidence != 0.5 {
+		t.Fatalf("expected Mid at 0.5, got %q %v", best, confidence)
+	}
+	// Competing values take their share; ties go to the first key
+	best, confidence := bestScore(keys, map[string]float64{"Junior": 4, "Senior": 4})
+	if best != "Junior" || confidence != 0.5 {
+		t.Fatalf("expected Junior at 0.5 on a tie, got %q %v", best, confidence)
+	}
+	best, confidence = bestScore(keys, map[string]float64{"Mid": 1, "Senior": 3})
+	if best != "Senior" || math.Abs(confidence-0.5625) > 1e-9 {
+		t.Fatalf("expected Senior at 0.5625, got %q %v", best, confidence)
+	}
+}
+
+func TestClassifyByRules(t *testing.T) {
+	golang := domain.NewTopic("Go", "", nil, []string{"golang"})
+	react := domain.NewTopic("React", "", nil, nil)
+	topics := []*domain.Topic{golang, react}
+
+	item := newCrawled("Design a scalable Go architecture", "")
+	item.Content = "Explain the trade-offs of this architecture for a backend API."
+	c := classifyByRules(item, topics)
+	if c.Method != domain.ClassifiedByRules || c.TopicID == nil || *c.TopicID != golang.ID || c.Topic != "Go" {
+		t.Fatalf("expected the Go topic, got %+v", c)
+	}
+	if c.Level != "Senior" || c.LevelConfidence != 1 || c.Role != "BackEnd" {
+		t.Fatalf("expected a confident Senior BackEnd question, got %+v", c)
+	}
+
+	// The crawler's topic guess counts like a title hit, and aliases name the topic
+	item = newCrawled("Channels", "golang")
+	item.Content = "Explain channels."
+	if c := classifyByRules(item, topics); c.TopicID == nil || *c.TopicID != golang.ID || c.TopicConfidence != 0.5 {
+		t.Fatalf("expected Go at 0.5 from the detected topic, got %+v", c)
+	}
+
+	// Nothing recognisable: no topic and the Mid fallback at low confidence
+	item = newCrawled("Tell me about yourself", "")
+	item.Content = "Walk me through your CV."
+	c = classifyByRules(item, topics)
+	if c.TopicID != nil || c.Level != "Mid" || c.LevelConfidence != defaultLevelConfidence || c.Role != "" || c.Confidence() != 0 {
+		t.Fatalf("expected an unclassified item, got %+v", c)
+	}
+
+	// Vietnamese keywords count too
+	item = newCrawled("React là gì?", "")
+	item.Content = "Giải thích cơ bản về React."
+	if c := classifyByRules(item, topics); c.TopicID == nil || *c.TopicID != react.ID || c.Level != "Junior" || c.Role != "FrontEnd" {
+		t.Fatalf("expected a Junior FrontEnd React question, got %+v", c)
+	}
+}
+
+func TestRunClassifier_ClassifiesNewItemsWithoutARequest(t *testing.T) {
+	golang := domain.NewTopic("Go", "", nil, []string{"golang"})
+	topics := &fakeTopicRepo{topics: map[uuid.UUID]*domain.Topic{golang.ID: golang}}
+	questions := newFakeQuestionRepo()
+	confident := newCrawled("Design a scalable Go architecture", "go")
+	confident.RawContent = "Explain the trade-offs of this architecture in Go."
+	confident.Content = confident.RawContent
+	unsure := newCrawled("Tell me about yourself", "")
+	crawled := &fakeCrawledRepo{questions: questions, items: map[uuid.UUID]*domain.CrawledQuestion{
+		confident.ID: confident, unsure.ID: unsure,
+	}}
+	svc := NewReviewService(crawled, questions, topics, &fakeRevisionRepo{}, fakeTx{}, nil, domain.DefaultDuplicatePolicy(), domain.DefaultClassificationPolicy())
+	classifier := uuid.New()
+
+	// A cancelled context still runs the first batch, then stops instead of waiting
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		RunClassifier(ctx, svc, time.Hour, classifier)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunClassifier did not stop after its context was cancelled")
+	}
+
+	item := crawled.items[confident.ID]
+	if item.Status != domain.CrawlStatusApproved || questions.questions[*item.QuestionID].CreatedBy != classifier {
+		t.Fatalf("expected the confident item to be approved as the classifier account, got %+v", item)
+	}
+	if item := crawled.items[unsure.ID]; !item.IsPending() || item.Classification == nil {
+		t.Fatalf("expected the unsure item to be classified and left for review, got %+v", item)
+	}
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+type reviewService struct {
+	crawledRepo    ports.CrawledQuestionRepository
+	repo           ports.QuestionRepository
+	topicRepo      ports.TopicRepository
+	revisionRepo   ports.RevisionRepository
+	tx             ports.Transactor
+	ai             ports.AIService // nil classifies by rules alone
+	duplicates     domain.DuplicatePolicy
+	classification domain.ClassificationPolicy
+}
+
+// NewReviewService creates the review workflow for crawled questions. ai may be nil.
+func NewReviewService(crawledRepo ports.CrawledQuestionRepository, repo ports.QuestionRepository, topicRepo ports.TopicRepository, revisionRepo ports.RevisionRepository, tx ports.Transactor, ai ports.AIService, duplicates domain.DuplicatePolicy, classification domain.ClassificationPolicy) ports.ReviewService {
+	return &reviewService{
+		crawledRepo:    crawledRepo,
+		repo:           repo,
+		topicRepo:      topicRepo,
+		revisionRepo:   revisionRepo,
+		tx:             tx,
+		ai:             ai,
+		duplicates:     duplicates,
+		classification: classification,
+	}
+}
+
+// maxBulkReview caps how many items one bulk action may review
+const maxBulkReview = 100
+
+// ListCrawled returns a page of the review queue, oldest first. It lists pending items unless another status is asked for.
+func (s *reviewService) ListCrawled(ctx context.Context, filter domain.CrawledFilter) (*domain.CrawledPage, error) {
+	switch filter.Status {
+	case "":
+		filter.Status = domain.CrawlStatusPending
+	case domain.CrawlStatusPending, domain.CrawlStatusApproved, domain.CrawlStatusRejected:
+	default:
+		return nil, fmt.Errorf("invalid status: %s", filter.Status)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+	if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return s.crawledRepo.List(ctx, filter)
+}
+
+func (s *reviewService) GetCrawled(ctx context.Context, id uuid.UUID) (*domain.CrawledQuestion, error) {
+	return s.crawledRepo.GetByID(ctx, id)
+}
+
+// UpdateCrawled stores a reviewer's edits to a pending item
+func (s *reviewService) UpdateCrawled(ctx context.Context, id uuid.UUID, patch domain.CrawledPatch, actorRole domain.Role) (*domain.CrawledQuestion, error) {
+	if !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can review crawled questions", domain.ErrForbidden)
+	}
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+	item, err := s.pendingItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if patch.TopicID != nil {
+		if _, err := s.topicRepo.GetByID(ctx, *patch.TopicID); err != nil {
+			return nil, err
+		}
+	}
+	item.Apply(patch)
+	if err := s.crawledRepo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// ApproveCrawled promotes a pending item into a published question. The topic is the reviewer's choice or the
+// detected topic resolved by name, slug or alias. Near-duplicates at the reject threshold are refused unless
+// allowDuplicate is set; weaker matches are returned as warnings.
+func (s *reviewService) ApproveCrawled(ctx context.Context, id uuid.UUID, allowDuplicate bool, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, []domain.DuplicateMatch, error) {
+	if !actorRole.IsModerator() {
+		return nil, nil, fmt.Errorf("%w: only moderators can review crawled questions", domain.ErrForbidden)
+	}
+	item, err := s.pendingItem(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.approve(ctx, item, allowDuplicate, actorID)
+}
+
+func (s *reviewService) approve(ctx context.Context, item *domain.CrawledQuestion, allowDuplicate bool, actorID uuid.UUID) (*domain.Question, []domain.DuplicateMatch, error) {
+	if err := item.Validate(); err != nil {
+		return nil, nil, err
+	}
+	topicID, err := s.resolveTopic(ctx, item)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matches, err := s.repo.FindSimilar(ctx, item.Title, item.Content, item.Language, s.duplicates.WarnThreshold, maxDuplicateMatches)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !allowDuplicate && len(matches) > 0 && matches[0].Similarity >= s.duplicates.RejectThreshold {
+		return nil, nil, &domain.DuplicateError{Matches: matches}
+	}
+
+	// Approval is the review, so the question goes straight to published
+	question := item.ToQuestion(topicID, actorID)
+	question.Status = domain.StatusPublished
+	item.MarkApproved(question.ID, actorID)
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.crawledRepo.Promote(ctx, item, question); err != nil {
+			return err
+		}
+		return s.revisionRepo.Create(ctx, domain.NewQuestionRevision(question, actorID))
+	}); err != nil {
+		return nil, nil, err
+	}
+	return question, matches, nil
+}
+
+// resolveTopic returns the reviewer's topic, or the topic the crawler detected
+func (s *reviewService) resolveTopic(ctx context.Context, item *domain.CrawledQuestion) (uuid.UUID, error) {
+	if item.TopicID != nil {
+		return *item.TopicID, nil
+	}
+	if strings.TrimSpace(item.DetectedTopic) == "" {
+		return uuid.Nil, fmt.Errorf("topic not found: no topic detected, set topic_id")
+	}
+	topic, err := s.topicRepo.GetByName(ctx, strings.TrimSpace(item.DetectedTopic))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %s, set topic_id", err, item.DetectedTopic)
+	}
+	return topic.ID, nil
+}
+
+// RejectCrawled rejects a pending item; a reason is required
+func (s *reviewService) RejectCrawled(ctx context.Context, id uuid.UUID, reason string, actorID uuid.UUID, actorRole domain.Role) (*domain.CrawledQuestion, error) {
+	if !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can review crawled questions", domain.ErrForbidden)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("invalid rejection: reason is required")
+	}
+	item, err := s.pendingItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item.MarkRejected(reason, actorID)
+	if err := s.crawledRepo.Reject(ctx, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// BulkApproveCrawled approves each item on its own, so one failure is reported without stopping the rest.
+// Items are approved in the order given, so later items are checked against questions approved earlier.
+func (s *reviewService) BulkApproveCrawled(ctx context.Context, ids []uuid.UUID, allowDuplicate bool, actorID uuid.UUID, actorRole domain.Role) (*domain.BulkReviewResult, error) {
+	if err := checkBulkReview(ids, actorRole); err != nil {
+		return nil, err
+	}
+	result := &domain.BulkReviewResult{Items: []domain.ReviewItemResult{}}
+	for _, id := range uniqueIDs(ids) {
+		item, err := s.pendingItem(ctx, id)
+		if err != nil {
+			result.Add(id, nil, err)
+			continue
+		}
+		question, _, err := s.approve(ctx, item, allowDuplicate, actorID)
+		if err != nil {
+			result.Add(id, nil, err)
+			continue
+		}
+		result.Add(id, &question.ID, nil)
+	}
+	return result, nil
+}
+
+// BulkRejectCrawled rejects each item with the same reason
+func (s *reviewService) BulkRejectCrawled(ctx context.Context, ids []uuid.UUID, reason string, actorID uuid.UUID, actorRole domain.Role) (*domain.BulkReviewResult, error) {
+	if err := checkBulkReview(ids, actorRole); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, fmt.Errorf("invalid rejection: reason is required")
+	}
+	result := &domain.BulkReviewResult{Items: []domain.ReviewItemResult{}}
+	for _, id := range uniqueIDs(ids) {
+		_, err := s.RejectCrawled(ctx, id, reason, actorID, actorRole)
+		result.Add(id, nil, err)
+	}
+	return result, nil
+}
+
+// pendingItem loads an item that still awaits review
+func (s *reviewService) pendingItem(ctx context.Context, id uuid.UUID) (*domain.CrawledQuestion, error) {
+	item, err := s.crawledRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !item.IsPending() {
+		return nil, crawledReviewedError(item.Status)
+	}
+	return item, nil
+}
+
+func checkBulkReview(ids []uuid.UUID, actorRole domain.Role) error {
+	if !actorRole.IsModerator() {
+		return fmt.Errorf("%w: only moderators can review crawled questions", domain.ErrForbidden)
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("invalid bulk review: ids are required")
+	}
+	if len(ids) > maxBulkReview {
+		return fmt.Errorf("invalid bulk review: at most %d ids per request", maxBulkReview)
+	}
+	return nil
+}
+
+// uniqueIDs drops repeated IDs, keeping the first occurrence
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func crawledReviewedError(status string) error {
+	return fmt.Errorf("crawled question already reviewed: %s", status)
+}
@@ -0,0 +1,226 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+type fakeCrawledRepo struct {
+	items     map[uuid.UUID]*domain.CrawledQuestion
+	questions *fakeQuestionRepo
+}
+
+func (r *fakeCrawledRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.CrawledQuestion, error) {
+	item, ok := r.items[id]
+	if !ok {
+		return nil, fmt.Errorf("crawled question not found")
+	}
+	copied := *item
+	return &copied, nil
+}
+func (r *fakeCrawledRepo) List(ctx context.Context, filter domain.CrawledFilter) (*domain.CrawledPage, error) {
+	page := &domain.CrawledPage{Items: []*domain.CrawledQuestion{}}
+	for _, item := range r.items {
+		if item.Status == filter.Status && (!filter.Unclassified || item.Classification == nil) {
+			copied := *item
+			page.Items = append(page.Items, &copied)
+		}
+	}
+	sort.Slice(page.Items, func(i, j int) bool { return page.Items[i].RawTitle < page.Items[j].RawTitle })
+	page.Total = len(page.Items)
+	return page, nil
+}
+func (r *fakeCrawledRepo) Update(ctx context.Context, item *domain.CrawledQuestion) error {
+	return r.save(item)
+}
+func (r *fakeCrawledRepo) Reject(ctx context.Context, item *domain.CrawledQuestion) error {
+	return r.save(item)
+}
+func (r *fakeCrawledRepo) Promote(ctx context.Context, item *domain.CrawledQuestion, question *domain.Question) error {
+	if err := r.save(item); err != nil {
+		return err
+	}
+	return r.questions.Create(ctx, question)
+}
+func (r *fakeCrawledRepo) SaveClassification(ctx context.Context, id uuid.UUID, c *domain.Classification) error {
+	item := r.items[id]
+	if !item.IsPending() {
+		return fmt.Errorf("crawled question already reviewed")
+	}
+	// Like the repository's COALESCE, the proposal shows through the unedited fields
+	item.Classification = c
+	if c.TopicID != nil {
+		item.TopicID = c.TopicID
+	}
+	item.Level, item.Role = c.Level, c.Role
+	return nil
+}
+func (r *fakeCrawledRepo) save(item *domain.CrawledQuestion) error {
+	if !r.items[item.ID].IsPending() {
+		return fmt.Errorf("crawled question already reviewed")
+	}
+	r.items[item.ID] = item
+	return nil
+}
+
+var _ ports.CrawledQuestionRepository = (*fakeCrawledRepo)(nil)
+
+func newCrawled(title, topic string) *domain.CrawledQuestion {
+	return &domain.CrawledQuestion{
+		ID: uuid.New(), Source: "GitHub", RawTitle: title, RawContent: title + "?", DetectedTopic: topic, DetectedLevel: "Mid",
+		Title: title, Content: title + "?", Level: "Mid", Language: "en", Status: domain.CrawlStatusPending,
+	}
+}
+
+func TestReviewCrawled_EditApproveAndReject(t *testing.T) {
+	golang := domain.NewTopic("Go", "", nil, []string{"golang"})
+	topics := &fakeTopicRepo{topics: map[uuid.UUID]*domain.Topic{golang.ID: golang}}
+	questions := newFakeQuestionRepo()
+	channels := newCrawled("Channels", "go")
+	unknown := newCrawled("Lifetimes", "Rust")
+	spam := newCrawled("Buy now", "")
+	crawled := &fakeCrawledRepo{questions: questions, items: map[uuid.UUID]*domain.CrawledQuestion{
+		channels.ID: channels, unknown.ID: unknown, spam.ID: spam,
+	}}
+	svc := NewReviewService(crawled, questions, topics, &fakeRevisionRepo{}, fakeTx{}, nil, domain.DefaultDuplicatePolicy(), domain.DefaultClassificationPolicy())
+	ctx := context.Background()
+	moderator := uuid.New()
+
+	if _, _, err := svc.ApproveCrawled(ctx, channels.ID, false, uuid.New(), domain.RoleContributor); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected contributors to be forbidden, got %v", err)
+	}
+
+	edited, err := svc.UpdateCrawled(ctx, channels.ID, domain.CrawledPatch{Title: strPtr("Go channels"), Level: strPtr("Junior")}, domain.RoleModerator)
+	if err != nil || edited.Title != "Go channels" || edited.RawTitle != "Channels" {
+		t.Fatalf("expected the edit to keep the raw title, got %+v (%v)", edited, err)
+	}
+
+	// The detected topic resolves by slug, and the question is published under the reviewer
+	question, _, err := svc.ApproveCrawled(ctx, channels.ID, false, moderator, domain.RoleModerator)
+	if err != nil {
+		t.Fatalf("expected approval, got %v", err)
+	}
+	if question.Title != "Go channels" || question.Level != "Junior" || question.TopicID != golang.ID || question.Status != domain.StatusPublished || question.CreatedBy != moderator {
+		t.Fatalf("expected the edited item promoted into Go, got %+v", question)
+	}
+	if item := crawled.items[channels.ID]; item.Status != domain.CrawlStatusApproved || *item.QuestionID != question.ID {
+		t.Fatalf("expected the item to be approved and linked, got %+v", item)
+	}
+	if _, _, err := svc.ApproveCrawled(ctx, channels.ID, false, moderator, domain.RoleModerator); err == nil {
+		t.Fatal("expected an approved item not to be approved twice")
+	}
+
+	if _, err := svc.RejectCrawled(ctx, spam.ID, " ", moderator, domain.RoleModerator); err == nil {
+		t.Fatal("expected a rejection without a reason to fail")
+	}
+
+	// Bulk actions report each item: the unknown topic and the reviewed item fail, the rest succeed
+	result, err := svc.BulkApproveCrawled(ctx, []uuid.UUID{unknown.ID, channels.ID}, false, moderator, domain.RoleModerator)
+	if err != nil || result.Succeeded != 0 || result.Failed != 2 {
+		t.Fatalf("expected both items to fail, got %+v (%v)", result, err)
+	}
+	result, err = svc.BulkRejectCrawled(ctx, []uuid.UUID{spam.ID, unknown.ID, spam.ID}, "off topic", moderator, domain.RoleModerator)
+	if err != nil || result.Succeeded != 2 || result.Failed != 0 {
+		t.Fatalf("expected both items rejected once, got %+v (%v)", result, err)
+	}
+	if item := crawled.items[spam.ID]; item.Status != domain.CrawlStatusRejected || item.RejectionReason != "off topic" || *item.ReviewedBy != moderator {
+		t.Fatalf("expected the rejection to be recorded, got %+v", item)
+	}
+}
+
+func TestReviewCrawled_RefusesNearDuplicates(t *testing.T) {
+	golang := domain.NewTopic("Go", "", nil, nil)
+	topics := &fakeTopicRepo{topics: map[uuid.UUID]*domain.Topic{golang.ID: golang}}
+	questions := newFakeQuestionRepo()
+	questions.similar = []domain.DuplicateMatch{{QuestionID: uuid.New(), Similarity: 0.95}}
+	item := newCrawled("Goroutines", "Go")
+	crawled := &fakeCrawledRepo{questions: questions, items: map[uuid.UUID]*domain.CrawledQuestion{item.ID: item}}
+	svc := NewReviewService(crawled, questions, topics, &fakeRevisionRepo{}, fakeTx{}, nil, domain.DefaultDuplicatePolicy(), domain.DefaultClassificationPolicy())
+	ctx := context.Background()
+
+	if _, _, err := svc.ApproveCrawled(ctx, item.ID, false, uuid.New(), domain.RoleModerator); !errors.Is(err, domain.ErrDuplicateQuestion) {
+		t.Fatalf("expected a duplicate error, got %v", err)
+	}
+	if !crawled.items[item.ID].IsPending() || len(questions.questions) != 0 {
+		t.Fatal("expected the item to stay pending")
+	}
+	if _, warnings, err := svc.ApproveCrawled(ctx, item.ID, true, uuid.New(), domain.RoleModerator); err != nil || len(warnings) != 1 {
+		t.Fatalf("expected allow_duplicate to approve with a warning, got %v / %+v", err, warnings)
+	}
+}
+
+type fakeAIService struct {
+	classification *domain.Classification
+}
+
+func (a *fakeAIService) ClassifyQuestion(ctx context.Context, title, content string, topics []string) (*domain.Classification, error) {
+	copied := *a.classification
+	return &copied, nil
+}
+
+func TestClassifyPending_AutoApprovesConfidentItems(t *testing.T) {
+	golang := domain.NewTopic("Go", "", nil, []string{"golang"})
+	k8s := domain.NewTopic("Kubernetes", "", nil, []string{"k8s"})
+	topics := &fakeTopicRepo{topics: map[uuid.UUID]*domain.Topic{golang.ID: golang, k8s.ID: k8s}}
+	questions := newFakeQuestionRepo()
+	architecture := newCrawled("Design a scalable Go architecture", "go")
+	architecture.RawContent = "Explain the trade-offs of this architecture in Go."
+	architecture.Content = architecture.RawContent
+	pods := newCrawled("Pods", "")
+	pods.RawContent, pods.Content = "What is a pod?", "What is a pod?"
+	crawled := &fakeCrawledRepo{questions: questions, items: map[uuid.UUID]*domain.CrawledQuestion{
+		architecture.ID: architecture, pods.ID: pods,
+	}}
+	svc := NewReviewService(crawled, questions, topics, &fakeRevisionRepo{}, fakeTx{}, nil, domain.DefaultDuplicatePolicy(), domain.DefaultClassificationPolicy())
+	ctx := context.Background()
+	moderator := uuid.New()
+
+	if _, err := svc.ClassifyPending(ctx, 0, false, moderator, domain.RoleContributor); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected contributors to be forbidden, got %v", err)
+	}
+
+	// Rules alone: the Go question names its topic and level clearly, the pod question has no topic
+	result, err := svc.ClassifyPending(ctx, 0, false, moderator, domain.RoleModerator)
+	if err != nil || result.Classified != 2 || result.AutoApproved != 1 {
+		t.Fatalf("expected two items classified and one approved, got %+v (%v)", result, err)
+	}
+	item := crawled.items[architecture.ID]
+	if item.Status != domain.CrawlStatusApproved || item.Classification.Level != "Senior" || *item.Classification.TopicID != golang.ID {
+		t.Fatalf("expected a Senior Go question to be auto-approved, got %+v", item)
+	}
+	if question := questions.questions[*item.QuestionID]; question.Level != "Senior" || question.TopicID != golang.ID || question.CreatedBy != moderator {
+		t.Fatalf("expected the promoted question to carry the classification, got %+v", question)
+	}
+	item = crawled.items[pods.ID]
+	if !item.IsPending() || item.Classification.TopicID != nil || item.Classification.Level != "Junior" || item.Classification.Confidence() != 0 {
+		t.Fatalf("expected the pod question to wait for review, got %+v", item.Classification)
+	}
+
+	// Classified items are skipped unless reclassification is asked for
+	if result, err = svc.ClassifyPending(ctx, 0, false, moderator, domain.RoleModerator); err != nil || result.Classified != 0 {
+		t.Fatalf("expected nothing left to classify, got %+v (%v)", result, err)
+	}
+
+	// An agreeing AI proposal raises the rules' level confidence and supplies the topic
+	ai := &fakeAIService{classification: &domain.Classification{Topic: "kubernetes", TopicConfidence: 0.95, Level: "junior", LevelConfidence: 0.9, Role: "Astronaut", RoleConfidence: 1}}
+	svc = NewReviewService(crawled, questions, topics, &fakeRevisionRepo{}, fakeTx{}, ai, domain.DefaultDuplicatePolicy(), domain.DefaultClassificationPolicy())
+	classified, err := svc.ClassifyCrawled(ctx, pods.ID, domain.RoleModerator)
+	if err != nil {
+		t.Fatalf("expected classification, got %v", err)
+	}
+	c := classified.Classification
+	if c.Method != domain.ClassifiedByAI || *c.TopicID != k8s.ID || c.Level != "Junior" || c.LevelConfidence <= 0.9 || c.Role != "" {
+		t.Fatalf("expected the AI's Kubernetes topic and a stronger Junior level, got %+v", c)
+	}
+	result, err = svc.ClassifyPending(ctx, 0, true, moderator, domain.RoleModerator)
+	if err != nil || result.Classified != 1 || result.AutoApproved != 1 {
+		t.Fatalf("expected the reclassified pod question to be approved, got %+v (%v)", result, err)
+	}
+}
@@ -2,6 +2,9 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/question-interviewer/question-service/internal/domain"
@@ -9,43 +12,503 @@ import (
 )
 
 type questionService struct {
-	repo      ports.QuestionRepository
-	topicRepo ports.TopicRepository
+	repo         ports.QuestionRepository
+	topicRepo    ports.TopicRepository
+	tagRepo      ports.TagRepository
+	revisionRepo ports.RevisionRepository
+	tx           ports.Transactor
+	duplicates   domain.DuplicatePolicy
 }
 
 // NewQuestionService creates a new instance of QuestionService
-func NewQuestionService(repo ports.QuestionRepository, topicRepo ports.TopicRepository) ports.QuestionService {
+func NewQuestionService(repo ports.QuestionRepository, topicRepo ports.TopicRepository, tagRepo ports.TagRepository, revisionRepo ports.RevisionRepository, tx ports.Transactor, duplicates domain.DuplicatePolicy) ports.QuestionService {
 	return &questionService{
-		repo:      repo,
-		topicRepo: topicRepo,
+		repo:         repo,
+		topicRepo:    topicRepo,
+		tagRepo:      tagRepo,
+		revisionRepo: revisionRepo,
+		tx:           tx,
+		duplicates:   duplicates,
 	}
 }
 
-func (s *questionService) CreateQuestion(ctx context.Context, title, content, level, language, role, hint, correctAnswer string, topicID, createdBy uuid.UUID) (*domain.Question, error) {
+// maxDuplicateMatches caps how many similar questions are reported for a new question
+const maxDuplicateMatches = 5
+
+// Duplicate clusters are built from at most this many of the most similar pairs
+const (
+	defaultSimilarPairs = 500
+	maxSimilarPairs     = 5000
+)
+
+// CreateQuestion stores a new draft with the given existing tags. Questions at or above the reject threshold
+// are refused with a *domain.DuplicateError unless allowDuplicate is set; weaker matches are returned as warnings.
+func (s *questionService) CreateQuestion(ctx context.Context, title, content, level, language, role, hint, correctAnswer string, topicID, createdBy uuid.UUID, tagSlugs []string, allowDuplicate bool) (*domain.Question, []domain.DuplicateMatch, error) {
+	tags, err := s.resolveTags(ctx, tagSlugs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matches, err := s.repo.FindSimilar(ctx, title, content, language, s.duplicates.WarnThreshold, maxDuplicateMatches)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !allowDuplicate && len(matches) > 0 && matches[0].Similarity >= s.duplicates.RejectThreshold {
+		return nil, nil, &domain.DuplicateError{Matches: matches}
+	}
+
 	question := domain.NewQuestion(title, content, level, language, role, hint, correctAnswer, topicID, createdBy)
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.createWithRevision(ctx, question, tags, createdBy)
+	}); err != nil {
+		return nil, nil, err
+	}
+	question.Tags = tags
+	return question, matches, nil
+}
+
+// createWithRevision stores a new question, its first revision and its tags; callers run it in a transaction
+func (s *questionService) createWithRevision(ctx context.Context, question *domain.Question, tags []*domain.Tag, actorID uuid.UUID) error {
 	if err := s.repo.Create(ctx, question); err != nil {
+		return err
+	}
+	if err := s.revisionRepo.Create(ctx, domain.NewQuestionRevision(question, actorID)); err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		return s.tagRepo.SetQuestionTags(ctx, question.ID, tagIDs(tags))
+	}
+	return nil
+}
+
+func (s *questionService) GetQuestion(ctx context.Context, id uuid.UUID) (*domain.Question, error) {
+	question, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, question); err != nil {
 		return nil, err
 	}
 	return question, nil
 }
 
-func (s *questionService) GetQuestion(ctx context.Context, id uuid.UUID) (*domain.Question, error) {
-	return s.repo.GetByID(ctx, id)
+// GetQuestions returns the existing questions among ids with their tags, for callers that would
+// otherwise fetch them one by one
+func (s *questionService) GetQuestions(ctx context.Context, ids []uuid.UUID) ([]*domain.Question, error) {
+	if len(ids) > domain.MaxBatchQuestions {
+		return nil, fmt.Errorf("invalid batch: at most %d ids", domain.MaxBatchQuestions)
+	}
+	if len(ids) == 0 {
+		return []*domain.Question{}, nil
+	}
+	questions, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, questions...); err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
+
+// attachTags loads the tags of the given questions in one query
+func (s *questionService) attachTags(ctx context.Context, questions ...*domain.Question) error {
+	if len(questions) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(questions))
+	for i, q := range questions {
+		ids[i] = q.ID
+	}
+	tags, err := s.tagRepo.ListByQuestionIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, q := range questions {
+		q.Tags = tags[q.ID]
+		if q.Tags == nil {
+			q.Tags = []*domain.Tag{}
+		}
+	}
+	return nil
+}
+
+// normalizeTagSlugs slugifies tag filters and drops blanks and repeats
+func normalizeTagSlugs(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	slugs := []string{}
+	for _, t := range tags {
+		slug := domain.Slugify(t)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		slugs = append(slugs, slug)
+	}
+	return slugs
+}
+
+// maxPageSize caps how many questions one listing page may return
+const maxPageSize = 100
+
+// ListQuestions lists questions matching filter. Moderators may list any status; everyone else sees
+// published questions, plus any status of their own questions when filtering by created_by themselves.
+func (s *questionService) ListQuestions(ctx context.Context, filter domain.QuestionFilter, actorID uuid.UUID, actorRole domain.Role) (*domain.QuestionPage, error) {
+	status, err := visibleStatus(filter.Status, filter.CreatedBy, actorID, actorRole)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = status
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 10
+	}
+	if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+	switch filter.Sort {
+	case "":
+		filter.Sort = domain.SortCreatedAt
+	case domain.SortCreatedAt, domain.SortUpdatedAt, domain.SortPopularity:
+	default:
+		return nil, fmt.Errorf("invalid sort: %s", filter.Sort)
+	}
+	filter.Tags = normalizeTagSlugs(filter.Tags)
+
+	page, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, page.Items...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// ListPool lists the published questions matching a practice session's filters. Stacks match
+// case-insensitively and tags by slug; a filter whose tags all normalize away matches every tag.
+func (s *questionService) ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error) {
+	if filter.Language == "" {
+		filter.Language = "en"
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	stacks := []string{}
+	for _, stack := range filter.Stacks {
+		if stack = strings.ToLower(strings.TrimSpace(stack)); stack != "" {
+			stacks = append(stacks, stack)
+		}
+	}
+	filter.Stacks = stacks
+	filter.Tags = normalizeTagSlugs(filter.Tags)
+	return s.repo.ListPool(ctx, filter)
+}
+
+// visibleStatus resolves the status a caller may list: non-moderators default to published and may only
+// ask for other statuses on their own questions (createdBy is the caller). Moderators keep any status,
+// including none for every status.
+func visibleStatus(status string, createdBy *uuid.UUID, actorID uuid.UUID, actorRole domain.Role) (string, error) {
+	if actorRole.IsModerator() {
+		return status, nil
+	}
+	if status == "" {
+		return domain.StatusPublished, nil
+	}
+	if status == domain.StatusPublished || (actorID != uuid.Nil && createdBy != nil && *createdBy == actorID) {
+		return status, nil
+	}
+	return "", fmt.Errorf("%w: only moderators can list %s questions of other authors", domain.ErrForbidden, status)
+}
+
+// SearchQuestions runs a ranked full-text search; only published questions are searched
+// unless a moderator asks for another status
+func (s *questionService) SearchQuestions(ctx context.Context, query domain.SearchQuery, actorRole domain.Role) (*domain.SearchResult, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" {
+		return nil, fmt.Errorf("search text is required")
+	}
+	if query.Status == "" {
+		query.Status = domain.StatusPublished
+	}
+	if query.Status != domain.StatusPublished && !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can search %s questions", domain.ErrForbidden, query.Status)
+	}
+	if query.Limit <= 0 {
+		query.Limit = 10
+	}
+	if query.Limit > maxPageSize {
+		query.Limit = maxPageSize
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+	query.Tags = normalizeTagSlugs(query.Tags)
+
+	result, err := s.repo.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	questions := make([]*domain.Question, len(result.Hits))
+	for i, hit := range result.Hits {
+		questions[i] = hit.Question
+	}
+	if err := s.attachTags(ctx, questions...); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func (s *questionService) ListQuestions(ctx context.Context, limit, offset int) ([]*domain.Question, error) {
-	return s.repo.List(ctx, limit, offset)
+// ListDuplicateClusters groups questions into clusters connected by pairwise similarity >= threshold
+// (defaulting to the warn threshold), largest clusters first. Only the limit most similar pairs are
+// considered, so a low threshold over a large catalog returns the strongest clusters rather than all of them.
+func (s *questionService) ListDuplicateClusters(ctx context.Context, threshold float64, limit int) ([]domain.DuplicateCluster, error) {
+	if threshold <= 0 {
+		threshold = s.duplicates.WarnThreshold
+	}
+	if threshold > 1 {
+		return nil, fmt.Errorf("invalid threshold: must be between 0 and 1")
+	}
+	if limit <= 0 {
+		limit = defaultSimilarPairs
+	}
+	if limit > maxSimilarPairs {
+		limit = maxSimilarPairs
+	}
+	pairs, err := s.repo.ListSimilarPairs(ctx, threshold, limit)
+	if err != nil {
+		return nil, err
+	}
+	return clusterPairs(pairs), nil
+}
+
+// clusterPairs merges similar pairs into connected components with a union-find
+func clusterPairs(pairs []domain.SimilarPair) []domain.DuplicateCluster {
+	parent := map[uuid.UUID]uuid.UUID{}
+	members := map[uuid.UUID]domain.DuplicateMatch{}
+	var find func(id uuid.UUID) uuid.UUID
+	find = func(id uuid.UUID) uuid.UUID {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+
+	for _, p := range pairs {
+		for _, m := range []domain.DuplicateMatch{p.A, p.B} {
+			if _, ok := parent[m.QuestionID]; !ok {
+				parent[m.QuestionID] = m.QuestionID
+			}
+			best := members[m.QuestionID]
+			if p.Similarity > best.Similarity {
+				m.Similarity = p.Similarity
+				members[m.QuestionID] = m
+			}
+		}
+		if ra, rb := find(p.A.QuestionID), find(p.B.QuestionID); ra != rb {
+			parent[rb] = ra
+		}
+	}
+
+	byRoot := map[uuid.UUID]*domain.DuplicateCluster{}
+	roots := []uuid.UUID{}
+	for id, m := range members {
+		root := find(id)
+		cluster, ok := byRoot[root]
+		if !ok {
+			cluster = &domain.DuplicateCluster{}
+			byRoot[root] = cluster
+			roots = append(roots, root)
+		}
+		cluster.Questions = append(cluster.Questions, m)
+		if m.Similarity > cluster.MaxSimilarity {
+			cluster.MaxSimilarity = m.Similarity
+		}
+	}
+
+	clusters := make([]domain.DuplicateCluster, 0, len(roots))
+	for _, root := range roots {
+		cluster := byRoot[root]
+		sort.Slice(cluster.Questions, func(i, j int) bool {
+			if cluster.Questions[i].Similarity != cluster.Questions[j].Similarity {
+				return cluster.Questions[i].Similarity > cluster.Questions[j].Similarity
+			}
+			return cluster.Questions[i].QuestionID.String() < cluster.Questions[j].QuestionID.String()
+		})
+		clusters = append(clusters, *cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i].Questions) != len(clusters[j].Questions) {
+			return len(clusters[i].Questions) > len(clusters[j].Questions)
+		}
+		return clusters[i].MaxSimilarity > clusters[j].MaxSimilarity
+	})
+	return clusters
+}
+
+// UpdateQuestion applies a patch and/or status transition.
+// Authors may edit their own draft and review questions and move them between draft and review;
+// moderators may edit any question and make any transition the state machine allows.
+// The question row stays locked from the read until its revision is written, in one transaction.
+func (s *questionService) UpdateQuestion(ctx context.Context, id uuid.UUID, patch domain.QuestionPatch, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error) {
+	var question *domain.Question
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		question, err = s.repo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkCanEdit(question, actorID, actorRole); err != nil {
+			return err
+		}
+
+		isModerator := actorRole.IsModerator()
+		before := domain.NewQuestionRevision(question, actorID)
+		question.Apply(patch)
+
+		if patch.Status != nil && *patch.Status != question.Status {
+			if !isModerator && !isAuthorTransition(question.Status, *patch.Status) {
+				return fmt.Errorf("%w: only moderators can move questions to %s", domain.ErrForbidden, *patch.Status)
+			}
+			if err := question.TransitionTo(*patch.Status); err != nil {
+				return err
+			}
+		}
+
+		if err := s.repo.Update(ctx, question); err != nil {
+			return err
+		}
+
+		// Snapshot the new content whenever a tracked field changed (status-only moves are not revisions)
+		after := domain.NewQuestionRevision(question, actorID)
+		if len(domain.DiffRevisions(before, after)) > 0 {
+			return s.revisionRepo.Create(ctx, after)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, question); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// checkCanEdit allows moderators to edit any question and authors their own draft and review questions
+func checkCanEdit(question *domain.Question, actorID uuid.UUID, actorRole domain.Role) error {
+	if actorRole.IsModerator() {
+		return nil
+	}
+	if question.CreatedBy != actorID {
+		return domain.ErrForbidden
+	}
+	if question.Status != domain.StatusDraft && question.Status != domain.StatusReview {
+		return fmt.Errorf("%w: only moderators can edit %s questions", domain.ErrForbidden, question.Status)
+	}
+	return nil
+}
+
+// isAuthorTransition reports whether a non-moderator author may make this transition (submit or withdraw review)
+func isAuthorTransition(from, to string) bool {
+	return (from == domain.StatusDraft && to == domain.StatusReview) ||
+		(from == domain.StatusReview && to == domain.StatusDraft)
+}
+
+// DeleteQuestion removes a question. Authors may delete their own drafts; moderators may delete anything.
+func (s *questionService) DeleteQuestion(ctx context.Context, id, actorID uuid.UUID, actorRole domain.Role) error {
+	if !actorRole.IsModerator() {
+		question, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if question.CreatedBy != actorID || question.Status != domain.StatusDraft {
+			return fmt.Errorf("%w: authors can only delete their own drafts", domain.ErrForbidden)
+		}
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *questionService) ListRevisions(ctx context.Context, questionID uuid.UUID) ([]*domain.QuestionRevision, error) {
+	if _, err := s.repo.GetByID(ctx, questionID); err != nil {
+		return nil, err
+	}
+	return s.revisionRepo.ListByQuestionID(ctx, questionID)
+}
+
+func (s *questionService) DiffRevisions(ctx context.Context, questionID uuid.UUID, from, to int) ([]domain.FieldDiff, error) {
+	fromRev, err := s.revisionRepo.GetByNumber(ctx, questionID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := s.revisionRepo.GetByNumber(ctx, questionID, to)
+	if err != nil {
+		return nil, err
+	}
+	return domain.DiffRevisions(fromRev, toRev), nil
+}
+
+// RollbackToRevision restores a revision's content through UpdateQuestion, so the same
+// permissions apply and the rollback itself is recorded as a new revision.
+func (s *questionService) RollbackToRevision(ctx context.Context, questionID uuid.UUID, revisionNumber int, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error) {
+	revision, err := s.revisionRepo.GetByNumber(ctx, questionID, revisionNumber)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateQuestion(ctx, questionID, revision.Patch(), actorID, actorRole)
 }
 
 // Topic methods
-func (s *questionService) CreateTopic(ctx context.Context, name, description string) (*domain.Topic, error) {
-	topic := domain.NewTopic(name, description)
+func (s *questionService) CreateTopic(ctx context.Context, name, description string, parentID *uuid.UUID, aliases []string) (*domain.Topic, error) {
+	if parentID != nil {
+		if _, err := s.topicRepo.GetByID(ctx, *parentID); err != nil {
+			return nil, fmt.Errorf("invalid parent: %w", err)
+		}
+	}
+	topic := domain.NewTopic(name, description, parentID, aliases)
 	if err := s.topicRepo.Create(ctx, topic); err != nil {
 		return nil, err
 	}
 	return topic, nil
 }
 
+// UpdateTopic replaces a topic's fields. A topic cannot be moved under itself or one of its descendants.
+func (s *questionService) UpdateTopic(ctx context.Context, id uuid.UUID, name, description string, parentID *uuid.UUID, aliases []string) (*domain.Topic, error) {
+	topic, err := s.topicRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if parentID != nil {
+		if _, err := s.topicRepo.GetByID(ctx, *parentID); err != nil {
+			return nil, fmt.Errorf("invalid parent: %w", err)
+		}
+		subtree, err := s.topicRepo.ListSubtreeIDs(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, descendant := range subtree {
+			if descendant == *parentID {
+				return nil, fmt.Errorf("invalid parent: a topic cannot be nested under itself or its descendants")
+			}
+		}
+	}
+
+	topic.Name = name
+	topic.Description = description
+	topic.ParentID = parentID
+	topic.Aliases = domain.NormalizeAliases(aliases)
+	if err := s.topicRepo.Update(ctx, topic); err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+func (s *questionService) GetTopic(ctx context.Context, id uuid.UUID) (*domain.Topic, error) {
+	return s.topicRepo.GetByID(ctx, id)
+}
+
 func (s *questionService) GetTopicByName(ctx context.Context, name string) (*domain.Topic, error) {
 	return s.topicRepo.GetByName(ctx, name)
 }
@@ -53,3 +516,97 @@ func (s *questionService) GetTopicByName(ctx context.Context, name string) (*dom
 func (s *questionService) ListTopics(ctx context.Context) ([]*domain.Topic, error) {
 	return s.topicRepo.List(ctx)
 }
+
+// Tag methods
+func (s *questionService) CreateTag(ctx context.Context, name, description string) (*domain.Tag, error) {
+	tag := domain.NewTag(name, description)
+	if tag.Slug == "" {
+		return nil, fmt.Errorf("invalid tag name: %q", name)
+	}
+	if err := s.tagRepo.Create(ctx, tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *questionService) GetTag(ctx context.Context, id uuid.UUID) (*domain.Tag, error) {
+	return s.tagRepo.GetByID(ctx, id)
+}
+
+func (s *questionService) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	return s.tagRepo.List(ctx)
+}
+
+func (s *questionService) UpdateTag(ctx context.Context, id uuid.UUID, name, description string) (*domain.Tag, error) {
+	tag, err := s.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tag.Rename(name)
+	if tag.Slug == "" {
+		return nil, fmt.Errorf("invalid tag name: %q", name)
+	}
+	tag.Description = description
+	if err := s.tagRepo.Update(ctx, tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *questionService) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	return s.tagRepo.Delete(ctx, id)
+}
+
+// SetQuestionTags replaces a question's tags by slug (or name)
+func (s *questionService) SetQuestionTags(ctx context.Context, questionID uuid.UUID, slugs []string, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error) {
+	question, err := s.repo.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanEdit(question, actorID, actorRole); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.resolveTags(ctx, slugs)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tagRepo.SetQuestionTags(ctx, questionID, tagIDs(tags)); err != nil {
+		return nil, err
+	}
+	question.Tags = tags
+	return question, nil
+}
+
+// resolveTags looks up existing tags by slug (or name). Unknown tags are an error rather than being
+// created on the fly, so the tag vocabulary stays curated.
+func (s *questionService) resolveTags(ctx context.Context, slugsOrNames []string) ([]*domain.Tag, error) {
+	slugs := normalizeTagSlugs(slugsOrNames)
+	if len(slugs) == 0 {
+		return []*domain.Tag{}, nil
+	}
+	tags, err := s.tagRepo.GetBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != len(slugs) {
+		found := make(map[string]bool, len(tags))
+		for _, t := range tags {
+			found[t.Slug] = true
+		}
+		for _, slug := range slugs {
+			if !found[slug] {
+				return nil, fmt.Errorf("tag not found: %s", slug)
+			}
+		}
+	}
+	return tags, nil
+}
+
+func tagIDs(tags []*domain.Tag) []uuid.UUID {
+	ids := make([]uuid.UUID, len(tags))
+	for i, t := range tags {
+		ids[i] = t.ID
+	}
+	return ids
+}
@@ -0,0 +1,886 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+	"github.com/question-interviewer/question-service/internal/ports"
+)
+
+// fakeTx marks the context it passes on, so fakes can tell which calls ran in a transaction
+type fakeTx struct{}
+
+type inTxKey struct{}
+
+func (fakeTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, inTxKey{}, true))
+}
+
+func inTx(ctx context.Context) bool {
+	return ctx.Value(inTxKey{}) != nil
+}
+
+type fakeQuestionRepo struct {
+	questions  map[uuid.UUID]*domain.Question
+	lastFilter domain.QuestionFilter
+	lastPool   domain.PoolFilter
+	lastSearch domain.SearchQuery
+	deleted    []uuid.UUID
+	similar    []domain.DuplicateMatch
+	pairs      []domain.SimilarPair // most similar first
+	pairLimit  int
+	outsideTx  int // questions created outside a transaction
+}
+
+func newFakeQuestionRepo(questions ...*domain.Question) *fakeQuestionRepo {
+	r := &fakeQuestionRepo{questions: make(map[uuid.UUID]*domain.Question)}
+	for _, q := range questions {
+		r.questions[q.ID] = q
+	}
+	return r
+}
+
+func (r *fakeQuestionRepo) Create(ctx context.Context, question *domain.Question) error {
+	if !inTx(ctx) {
+		r.outsideTx++
+	}
+	r.questions[question.ID] = question
+	return nil
+}
+func (r *fakeQuestionRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Question, error) {
+	q, ok := r.questions[id]
+	if !ok {
+		return nil, fmt.Errorf("question not found")
+	}
+	copied := *q
+	return &copied, nil
+}
+func (r *fakeQuestionRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Question, error) {
+	questions := []*domain.Question{}
+	for _, id := range ids {
+		if q, ok := r.questions[id]; ok {
+			copied := *q
+			questions = append(questions, &copied)
+		}
+	}
+	return questions, nil
+}
+func (r *fakeQuestionRepo) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*domain.Question, error) {
+	if !inTx(ctx) {
+		return nil, errors.New("row lock outside a transaction")
+	}
+	return r.GetByID(ctx, id)
+}
+func (r *fakeQuestionRepo) GetByExternalID(ctx context.Context, externalID string) (*domain.Question, error) {
+	for _, q := range r.questions {
+		if q.ExternalID == externalID || q.ID.String() == externalID {
+			copied := *q
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("question not found")
+}
+
+// List applies the status, author and level filters, newest first, with offset paging
+func (r *fakeQuestionRepo) ListPool(ctx context.Context, filter domain.PoolFilter) ([]domain.PoolQuestion, error) {
+	r.lastPool = filter
+	pool := []domain.PoolQuestion{}
+	for _, q := range r.questions {
+		if q.Status == domain.StatusPublished && q.Language == filter.Language {
+			pool = append(pool, domain.PoolQuestion{ID: q.ID, TranslationGroupID: q.TranslationGroupID, Level: q.Level})
+		}
+	}
+	return pool, nil
+}
+func (r *fakeQuestionRepo) List(ctx context.Context, filter domain.QuestionFilter) (*domain.QuestionPage, error) {
+	r.lastFilter = filter
+	matches := []*domain.Question{}
+	for _, q := range r.questions {
+		if (filter.Status != "" && q.Status != filter.Status) ||
+			(filter.CreatedBy != nil && q.CreatedBy != *filter.CreatedBy) ||
+			(filter.Level != "" && q.Level != filter.Level) {
+			continue
+		}
+		copied := *q
+		matches = append(matches, &copied)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if !matches[i].CreatedAt.Equal(matches[j].CreatedAt) {
+			return matches[i].CreatedAt.After(matches[j].CreatedAt)
+		}
+		return matches[i].ID.String() < matches[j].ID.String()
+	})
+
+	page := &domain.QuestionPage{Items: []*domain.Question{}, Total: len(matches)}
+	for i := filter.Offset; i < len(matches) && len(page.Items) < filter.Limit; i++ {
+		page.Items = append(page.Items, matches[i])
+	}
+	return page, nil
+}
+func (r *fakeQuestionRepo) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchResult, error) {
+	r.lastSearch = query
+	return &domain.SearchResult{Hits: []*domain.SearchHit{}}, nil
+}
+func (r *fakeQuestionRepo) FindSimilar(ctx context.Context, title, content, language string, threshold float64, limit int) ([]domain.DuplicateMatch, error) {
+	return r.similar, nil
+}
+func (r *fakeQuestionRepo) ListSimilarPairs(ctx context.Context, threshold float64, limit int) ([]domain.SimilarPair, error) {
+	r.pairLimit = limit
+	if limit < len(r.pairs) {
+		return r.pairs[:limit], nil
+	}
+	return r.pairs, nil
+}
+func (r *fakeQuestionRepo) Update(ctx context.Context, question *domain.Question) error {
+	r.questions[question.ID] = question
+	return nil
+}
+func (r *fakeQuestionRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	r.deleted = append(r.deleted, id)
+	delete(r.questions, id)
+	return nil
+}
+func (r *fakeQuestionRepo) ListTranslations(ctx context.Context, groupID uuid.UUID) ([]*domain.Question, error) {
+	members := []*domain.Question{}
+	for _, q := range r.questions {
+		if q.TranslationGroupID == groupID {
+			copied := *q
+			members = append(members, &copied)
+		}
+	}
+	return members, nil
+}
+func (r *fakeQuestionRepo) SetTranslationGroup(ctx context.Context, question *domain.Question) error {
+	for _, q := range r.questions {
+		if q.ID != question.ID && q.TranslationGroupID == question.TranslationGroupID && q.Language == question.Language {
+			return fmt.Errorf("translation already exists for language: %s", question.Language)
+		}
+	}
+	r.questions[question.ID].TranslationGroupID = question.TranslationGroupID
+	return nil
+}
+
+type fakeRevisionRepo struct {
+	revisions []*domain.QuestionRevision
+	outsideTx int // revisions written outside a transaction
+}
+
+func (r *fakeRevisionRepo) Create(ctx context.Context, revision *domain.QuestionRevision) error {
+	if !inTx(ctx) {
+		r.outsideTx++
+	}
+	n := 0
+	for _, rev := range r.revisions {
+		if rev.QuestionID == revision.QuestionID {
+			n++
+		}
+	}
+	revision.RevisionNumber = n + 1
+	r.revisions = append(r.revisions, revision)
+	return nil
+}
+func (r *fakeRevisionRepo) ListByQuestionID(ctx context.Context, questionID uuid.UUID) ([]*domain.QuestionRevision, error) {
+	return r.revisions, nil
+}
+func (r *fakeRevisionRepo) GetByNumber(ctx context.Context, questionID uuid.UUID, revisionNumber int) (*domain.QuestionRevision, error) {
+	for _, rev := range r.revisions {
+		if rev.QuestionID == questionID && rev.RevisionNumber == revisionNumber {
+			return rev, nil
+		}
+	}
+	return nil, fmt.Errorf("revision not found")
+}
+
+type fakeTagRepo struct {
+	tags         map[string]*domain.Tag
+	questionTags map[uuid.UUID][]uuid.UUID
+	outsideTx    int // question tags set outside a transaction
+}
+
+func newFakeTagRepo(tags ...*domain.Tag) *fakeTagRepo {
+	r := &fakeTagRepo{tags: make(map[string]*domain.Tag), questionTags: make(map[uuid.UUID][]uuid.UUID)}
+	for _, t := range tags {
+		r.tags[t.Slug] = t
+	}
+	return r
+}
+
+func (r *fakeTagRepo) Create(ctx context.Context, tag *domain.Tag) error {
+	r.tags[tag.Slug] = tag
+	return nil
+}
+func (r *fakeTagRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Tag, error) {
+	for _, t := range r.tags {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("tag not found")
+}
+func (r *fakeTagRepo) GetBySlugs(ctx context.Context, slugs []string) ([]*domain.Tag, error) {
+	tags := []*domain.Tag{}
+	for _, slug := range slugs {
+		if t, ok := r.tags[slug]; ok {
+			tags = append(tags, t)
+		}
+	}
+	return tags, nil
+}
+func (r *fakeTagRepo) List(ctx context.Context) ([]*domain.Tag, error) {
+	return nil, errors.New("not implemented")
+}
+func (r *fakeTagRepo) Update(ctx context.Context, tag *domain.Tag) error {
+	return errors.New("not implemented")
+}
+func (r *fakeTagRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return errors.New("not implemented")
+}
+func (r *fakeTagRepo) SetQuestionTags(ctx context.Context, questionID uuid.UUID, tagIDs []uuid.UUID) error {
+	if !inTx(ctx) {
+		r.outsideTx++
+	}
+	r.questionTags[questionID] = tagIDs
+	return nil
+}
+func (r *fakeTagRepo) ListByQuestionIDs(ctx context.Context, questionIDs []uuid.UUID) (map[uuid.UUID][]*domain.Tag, error) {
+	byQuestion := map[uuid.UUID][]*domain.Tag{}
+	for _, id := range questionIDs {
+		for _, tagID := range r.questionTags[id] {
+			if tag, err := r.GetByID(ctx, tagID); err == nil {
+				byQuestion[id] = append(byQuestion[id], tag)
+			}
+		}
+	}
+	return byQuestion, nil
+}
+
+var _ ports.QuestionRepository = (*fakeQuestionRepo)(nil)
+var _ ports.RevisionRepository = (*fakeRevisionRepo)(nil)
+var _ ports.TagRepository = (*fakeTagRepo)(nil)
+
+func strPtr(s string) *string { return &s }
+
+func TestUpdateQuestion_AuthorSubmitsDraftForReview(t *testing.T) {
+	author := uuid.New()
+	q := domain.NewQuestion("Channels", "What are channels?", "Mid", "en", "BackEnd", "", "", uuid.New(), author)
+	svc := NewQuestionService(newFakeQuestionRepo(q), nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+
+	updated, err := svc.UpdateQuestion(context.Background(), q.ID, domain.QuestionPatch{
+		Content: strPtr("What are buffered channels?"),
+		Status:  strPtr(domain.StatusReview),
+	}, author, domain.RoleContributor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.Status != domain.StatusReview || updated.Content != "What are buffered channels?" {
+		t.Fatalf("expected content and status updated, got %+v", updated)
+	}
+}
+
+func TestUpdateQuestion_OnlyModeratorsPublish(t *testing.T) {
+	author := uuid.New()
+	q := domain.NewQuestion("Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), author)
+	q.Status = domain.StatusReview
+	svc := NewQuestionService(newFakeQuestionRepo(q), nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+
+	_, err := svc.UpdateQuestion(context.Background(), q.ID, domain.QuestionPatch{Status: strPtr(domain.StatusPublished)}, author, domain.RoleContributor)
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected forbidden for author publishing, got %v", err)
+	}
+
+	updated, err := svc.UpdateQuestion(context.Background(), q.ID, domain.QuestionPatch{Status: strPtr(domain.StatusPublished)}, uuid.New(), domain.RoleModerator)
+	if err != nil {
+		t.Fatalf("expected moderator to publish, got %v", err)
+	}
+	if updated.Status != domain.StatusPublished {
+		t.Fatalf("expected published, got %s", updated.Status)
+	}
+}
+
+func TestUpdateQuestion_RejectsSkippingStates(t *testing.T) {
+	q := domain.NewQuestion("Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), uuid.New())
+	svc := NewQuestionService(newFakeQuestionRepo(q), nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+
+	_, err := svc.UpdateQuestion(context.Background(), q.ID, domain.QuestionPatch{Status: strPtr(domain.StatusPublished)}, uuid.New(), domain.RoleAdmin)
+	if !errors.Is(err, domain.ErrInvalidStatusTransition) {
+		t.Fatalf("expected invalid transition draft → published, got %v", err)
+	}
+}
+
+func TestDeleteQuestion_AuthorsOnlyDeleteOwnDrafts(t *testing.T) {
+	author := uuid.New()
+	q := domain.NewQuestion("Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), author)
+	q.Status = domain.StatusPublished
+	repo := newFakeQuestionRepo(q)
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+
+	if err := svc.DeleteQuestion(context.Background(), q.ID, author, domain.RoleContributor); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected forbidden deleting a published question, got %v", err)
+	}
+	if err := svc.DeleteQuestion(context.Background(), q.ID, uuid.New(), domain.RoleModerator); err != nil {
+		t.Fatalf("expected moderator delete to succeed, got %v", err)
+	}
+	if len(repo.deleted) != 1 {
+		t.Fatalf("expected question deleted")
+	}
+}
+
+func TestRevisions_RecordDiffAndRollback(t *testing.T) {
+	author := uuid.New()
+	repo := newFakeQuestionRepo()
+	revisions := &fakeRevisionRepo{}
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), revisions, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	q, _, err := svc.CreateQuestion(ctx, "Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), author, nil, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := svc.UpdateQuestion(ctx, q.ID, domain.QuestionPatch{Content: strPtr("What are buffered channels?")}, author, domain.RoleContributor); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := svc.UpdateQuestion(ctx, q.ID, domain.QuestionPatch{Status: strPtr(domain.StatusReview)}, author, domain.RoleContributor); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(revisions.revisions) != 2 {
+		t.Fatalf("expected 2 revisions (status-only changes are not revisions), got %d", len(revisions.revisions))
+	}
+
+	diffs, err := svc.DiffRevisions(ctx, q.ID, 1, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(diffs) != 1 || diffs[0].Field != "content" || diffs[0].From != "What are channels?" {
+		t.Fatalf("expected content diff, got %+v", diffs)
+	}
+
+	restored, err := svc.RollbackToRevision(ctx, q.ID, 1, author, domain.RoleContributor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if restored.Content != "What are channels?" || len(revisions.revisions) != 3 {
+		t.Fatalf("expected rollback restoring content as revision 3, got %q with %d revisions", restored.Content, len(revisions.revisions))
+	}
+}
+
+func TestCreateQuestion_NearDuplicates(t *testing.T) {
+	existing := domain.DuplicateMatch{QuestionID: uuid.New(), Title: "Channels", Status: domain.StatusPublished, Similarity: 0.95}
+	repo := newFakeQuestionRepo()
+	repo.similar = []domain.DuplicateMatch{existing}
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	_, _, err := svc.CreateQuestion(ctx, "Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), uuid.New(), nil, false)
+	var dupErr *domain.DuplicateError
+	if !errors.As(err, &dupErr) || !errors.Is(err, domain.ErrDuplicateQuestion) || dupErr.Matches[0].QuestionID != existing.QuestionID {
+		t.Fatalf("expected duplicate error naming %s, got %v", existing.QuestionID, err)
+	}
+	if len(repo.questions) != 0 {
+		t.Fatalf("expected rejected question not to be stored")
+	}
+
+	q, warnings, err := svc.CreateQuestion(ctx, "Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), uuid.New(), nil, true)
+	if err != nil || q == nil {
+		t.Fatalf("expected allowDuplicate to create the question, got %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected the match to be reported as a warning, got %+v", warnings)
+	}
+
+	repo.similar = []domain.DuplicateMatch{{QuestionID: uuid.New(), Similarity: 0.7}}
+	if _, warnings, err := svc.CreateQuestion(ctx, "Goroutines", "What is a goroutine?", "Mid", "en", "", "", "", uuid.New(), uuid.New(), nil, false); err != nil || len(warnings) != 1 {
+		t.Fatalf("expected a warning below the reject threshold, got %v / %+v", err, warnings)
+	}
+}
+
+func TestClusterPairs_MergesConnectedQuestions(t *testing.T) {
+	a, b, c, d, e := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	m := func(id uuid.UUID) domain.DuplicateMatch { return domain.DuplicateMatch{QuestionID: id} }
+	clusters := clusterPairs([]domain.SimilarPair{
+		{A: m(a), B: m(b), Similarity: 0.9},
+		{A: m(b), B: m(c), Similarity: 0.7},
+		{A: m(d), B: m(e), Similarity: 0.8},
+	})
+
+	if len(clusters) != 2 {
+		t.Fatalf("expected 2 clusters, got %d", len(clusters))
+	}
+	if len(clusters[0].Questions) != 3 || clusters[0].MaxSimilarity != 0.9 {
+		t.Fatalf("expected a-b-c cluster first with max 0.9, got %+v", clusters[0])
+	}
+	for _, q := range clusters[0].Questions {
+		if q.QuestionID == c && q.Similarity != 0.7 {
+			t.Fatalf("expected c's best similarity to be 0.7, got %v", q.Similarity)
+		}
+	}
+	if len(clusters[1].Questions) != 2 {
+		t.Fatalf("expected d-e cluster, got %+v", clusters[1])
+	}
+}
+
+func TestListDuplicateClusters_BoundsPairs(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	m := func(id uuid.UUID) domain.DuplicateMatch { return domain.DuplicateMatch{QuestionID: id} }
+	repo := newFakeQuestionRepo()
+	repo.pairs = []domain.SimilarPair{
+		{A: m(a), B: m(b), Similarity: 0.95},
+		{A: m(c), B: m(d), Similarity: 0.7},
+	}
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	if _, err := svc.ListDuplicateClusters(ctx, 0, 0); err != nil || repo.pairLimit != defaultSimilarPairs {
+		t.Fatalf("expected the default pair limit, got %d (%v)", repo.pairLimit, err)
+	}
+	if _, err := svc.ListDuplicateClusters(ctx, 0, 1_000_000); err != nil || repo.pairLimit != maxSimilarPairs {
+		t.Fatalf("expected the pair limit capped, got %d (%v)", repo.pairLimit, err)
+	}
+
+	clusters, err := svc.ListDuplicateClusters(ctx, 0.6, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].MaxSimilarity != 0.95 {
+		t.Fatalf("expected only the most similar pair to be clustered, got %+v", clusters)
+	}
+
+	if _, err := svc.ListDuplicateClusters(ctx, 1.5, 0); err == nil {
+		t.Fatal("expected an error for a threshold above 1")
+	}
+}
+
+func TestCreateQuestion_Tags(t *testing.T) {
+	golang, concurrency := domain.NewTag("Golang", ""), domain.NewTag("Concurrency", "")
+	tags := newFakeTagRepo(golang, concurrency)
+	svc := NewQuestionService(newFakeQuestionRepo(), nil, tags, &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	q, _, err := svc.CreateQuestion(ctx, "Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), uuid.New(), []string{"golang", "Concurrency", "golang"}, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(q.Tags) != 2 || len(tags.questionTags[q.ID]) != 2 {
+		t.Fatalf("expected 2 tags linked by slug and name, got %+v", q.Tags)
+	}
+
+	_, _, err = svc.CreateQuestion(ctx, "Goroutines", "What is a goroutine?", "Mid", "en", "", "", "", uuid.New(), uuid.New(), []string{"System Design"}, false)
+	if err == nil || err.Error() != "tag not found: system-design" {
+		t.Fatalf("expected unknown tag to be rejected, got %v", err)
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	for name, want := range map[string]string{
+		"System Design": "system-design",
+		" Node.js ":     "node-js",
+		"C++":           "c-plus-plus",
+		"C#":            "c-sharp",
+		"Bộ nhớ đệm":    "bộ-nhớ-đệm",
+	} {
+		if got := domain.Slugify(name); got != want {
+			t.Errorf("Slugify(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+type fakeTopicRepo struct {
+	topics map[uuid.UUID]*domain.Topic
+}
+
+func (r *fakeTopicRepo) Create(ctx context.Context, topic *domain.Topic) error {
+	r.topics[topic.ID] = topic
+	return nil
+}
+func (r *fakeTopicRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Topic, error) {
+	t, ok := r.topics[id]
+	if !ok {
+		return nil, fmt.Errorf("topic not found")
+	}
+	return t, nil
+}
+func (r *fakeTopicRepo) GetByName(ctx context.Context, name string) (*domain.Topic, error) {
+	for _, t := range r.topics {
+		if t.Name == name || t.Slug == name {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("topic not found")
+}
+func (r *fakeTopicRepo) List(ctx context.Context) ([]*domain.Topic, error) {
+	topics := make([]*domain.Topic, 0, len(r.topics))
+	for _, t := range r.topics {
+		topics = append(topics, t)
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].Name < topics[j].Name })
+	return topics, nil
+}
+func (r *fakeTopicRepo) Update(ctx context.Context, topic *domain.Topic) error {
+	r.topics[topic.ID] = topic
+	return nil
+}
+func (r *fakeTopicRepo) ListSubtreeIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{id}
+	for i := 0; i < len(ids); i++ {
+		for _, t := range r.topics {
+			if t.ParentID != nil && *t.ParentID == ids[i] {
+				ids = append(ids, t.ID)
+			}
+		}
+	}
+	return ids, nil
+}
+
+var _ ports.TopicRepository = (*fakeTopicRepo)(nil)
+
+func TestUpdateTopic_RejectsCycles(t *testing.T) {
+	topics := &fakeTopicRepo{topics: make(map[uuid.UUID]*domain.Topic)}
+	svc := NewQuestionService(newFakeQuestionRepo(), topics, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	js, err := svc.CreateTopic(ctx, "JavaScript", "", nil, []string{" TypeScript ", "typescript"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(js.Aliases) != 1 || js.Aliases[0] != "typescript" || js.Slug != "javascript" {
+		t.Fatalf("expected normalized alias and slug, got %+v", js)
+	}
+	react, err := svc.CreateTopic(ctx, "React", "", &js.ID, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := svc.UpdateTopic(ctx, js.ID, "JavaScript", "", &react.ID, nil); err == nil {
+		t.Fatalf("expected moving a topic under its child to be rejected")
+	}
+	if _, err := svc.UpdateTopic(ctx, js.ID, "JavaScript", "", &js.ID, nil); err == nil {
+		t.Fatalf("expected a topic to be rejected as its own parent")
+	}
+	if _, err := svc.UpdateTopic(ctx, react.ID, "React", "", nil, nil); err != nil {
+		t.Fatalf("expected detaching a child to succeed, got %v", err)
+	}
+}
+
+func TestImportQuestions_UpsertsByExternalID(t *testing.T) {
+	golang := domain.NewTopic("Go", "", nil, nil)
+	topics := &fakeTopicRepo{topics: map[uuid.UUID]*domain.Topic{golang.ID: golang}}
+	existing := domain.NewQuestion("Old title", "What is a goroutine?", "Junior", "en", "", "", "", golang.ID, uuid.New())
+	existing.ExternalID = "go-001"
+	repo := newFakeQuestionRepo(existing)
+	revisions := &fakeRevisionRepo{}
+	svc := NewQuestionService(repo, topics, newFakeTagRepo(domain.NewTag("Concurrency", "")), revisions, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+	moderator := uuid.New()
+
+	records := []domain.QuestionRecord{
+		{Row: 1, ExternalID: "go-001", Title: "Goroutines", Content: "What is a goroutine?", Level: "Junior", Topic: "Go", Status: "review", Tags: []string{"concurrency"}},
+		{Row: 2, ExternalID: "go-002", Title: "Channels", Content: "What are channels?", Level: "Mid", Topic: "go"},
+		{Row: 3, Title: "Channels again", Content: "What are channels?", Level: "Mid", Topic: "Go"},
+		{Row: 4, Title: "Rust", Content: "What is ownership?", Level: "Mid", Topic: "Rust"},
+		{Row: 5, Title: "No level", Content: "What is a slice?", Topic: "Go"},
+	}
+
+	if _, err := svc.ImportQuestions(ctx, records, uuid.New(), domain.RoleContributor, false); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected contributors to be forbidden, got %v", err)
+	}
+
+	dry, err := svc.ImportQuestions(ctx, records, moderator, domain.RoleModerator, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if dry.Created != 1 || dry.Updated != 1 || dry.Failed != 3 || len(repo.questions) != 1 || len(revisions.revisions) != 0 {
+		t.Fatalf("expected a dry run to count rows without writing, got %+v", dry)
+	}
+
+	result, err := svc.ImportQuestions(ctx, records, moderator, domain.RoleModerator, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Created != 1 || result.Updated != 1 || result.Failed != 3 {
+		t.Fatalf("expected 1 created, 1 updated and 3 failed, got %+v", result)
+	}
+	for i, row := range []int{3, 4, 5} {
+		if result.Errors[i].Row != row {
+			t.Fatalf("expected errors for rows 3, 4 and 5, got %+v", result.Errors)
+		}
+	}
+
+	updated := repo.questions[existing.ID]
+	if updated.Title != "Goroutines" || updated.Status != domain.StatusReview || updated.Language != "en" {
+		t.Fatalf("expected the existing question to be updated in place, got %+v", updated)
+	}
+	if len(revisions.revisions) != 2 || revisions.outsideTx != 0 {
+		t.Fatalf("expected a revision for the update and one for the new question, in their transactions, got %d (%d outside)", len(revisions.revisions), revisions.outsideTx)
+	}
+	created, err := repo.GetByExternalID(ctx, "go-002")
+	if err != nil || created.Status != domain.StatusDraft || created.TopicID != golang.ID {
+		t.Fatalf("expected go-002 to be created as a draft in Go, got %+v (%v)", created, err)
+	}
+}
+
+func TestTranslations_CreateLinkAndUnlink(t *testing.T) {
+	author := uuid.New()
+	en := domain.NewQuestion("Channels", "What are channels?", "Mid", "en", "BackEnd", "", "", uuid.New(), author)
+	vi := domain.NewQuestion("Channel", "Channel là gì?", "Mid", "vi", "BackEnd", "", "", uuid.New(), author)
+	repo := newFakeQuestionRepo(en, vi)
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	if _, err := svc.CreateTranslation(ctx, en.ID, "en", "Channels", "Again?", "", "", author); err == nil {
+		t.Fatal("expected a translation into the same language to be rejected")
+	}
+	if _, err := svc.CreateTranslation(ctx, en.ID, "fr", "Canaux", "Que sont les canaux ?", "", "", author); err == nil || !strings.HasPrefix(err.Error(), "invalid translation") {
+		t.Fatalf("expected a translation into an unsupported language to be rejected, got %v", err)
+	}
+
+	linked, err := svc.LinkTranslation(ctx, en.ID, vi.ID, author, domain.RoleContributor)
+	if err != nil {
+		t.Fatalf("expected link, got %v", err)
+	}
+	if linked.TranslationGroupID != en.ID || linked.IsCanonical() {
+		t.Fatalf("expected vi to join the group of en, got %+v", linked)
+	}
+
+	// A second Vietnamese version cannot join the group
+	other := domain.NewQuestion("Channel 2", "Kênh trong Go là gì?", "Mid", "vi", "", "", "", uuid.New(), author)
+	repo.questions[other.ID] = other
+	if _, err := svc.LinkTranslation(ctx, en.ID, other.ID, author, domain.RoleContributor); err == nil {
+		t.Fatal("expected a second vi translation to be rejected")
+	}
+
+	// The canonical question cannot leave while it has translations; the translation can
+	if _, err := svc.UnlinkTranslation(ctx, en.ID, en.ID, author, domain.RoleContributor); err == nil {
+		t.Fatal("expected the canonical question to stay in its group")
+	}
+	unlinked, err := svc.UnlinkTranslation(ctx, en.ID, vi.ID, author, domain.RoleContributor)
+	if err != nil {
+		t.Fatalf("expected unlink, got %v", err)
+	}
+	if !unlinked.IsCanonical() {
+		t.Fatalf("expected vi to be standalone, got group %s", unlinked.TranslationGroupID)
+	}
+}
+
+func TestUpdateQuestion_LocksAndWritesRevisionInOneTransaction(t *testing.T) {
+	author := uuid.New()
+	q := domain.NewQuestion("Maps", "Are maps safe for concurrent use?", "Mid", "en", "", "", "", uuid.New(), author)
+	repo := newFakeQuestionRepo(q)
+	revisions := &fakeRevisionRepo{}
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), revisions, fakeTx{}, domain.DefaultDuplicatePolicy())
+
+	// The fake repository refuses row locks outside a transaction
+	if _, err := svc.UpdateQuestion(context.Background(), q.ID, domain.QuestionPatch{Content: strPtr("Is a Go map safe for concurrent writes?")}, author, domain.RoleContributor); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(revisions.revisions) != 1 || revisions.outsideTx != 0 {
+		t.Fatalf("expected one revision written in the update's transaction, got %d (%d outside)", len(revisions.revisions), revisions.outsideTx)
+	}
+}
+
+func TestListQuestions_NonModeratorsSeePublishedOrTheirOwn(t *testing.T) {
+	author, other := uuid.New(), uuid.New()
+	published := domain.NewQuestion("Goroutines", "What is a goroutine?", "Junior", "en", "", "", "", uuid.New(), other)
+	published.Status = domain.StatusPublished
+	ownDraft := domain.NewQuestion("Select", "How does select pick a case?", "Mid", "en", "", "", "", uuid.New(), author)
+	otherDraft := domain.NewQuestion("Context", "When is a context cancelled?", "Mid", "en", "", "", "", uuid.New(), other)
+	svc := NewQuestionService(newFakeQuestionRepo(published, ownDraft, otherDraft), nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	page, err := svc.ListQuestions(ctx, domain.QuestionFilter{}, uuid.Nil, domain.RoleUser)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if page.Total != 1 || page.Items[0].ID != published.ID {
+		t.Fatalf("expected only the published question for anonymous callers, got %d", page.Total)
+	}
+
+	if _, err := svc.ListQuestions(ctx, domain.QuestionFilter{Status: domain.StatusDraft}, author, domain.RoleContributor); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected forbidden listing every draft, got %v", err)
+	}
+	if _, err := svc.ListQuestions(ctx, domain.QuestionFilter{Status: domain.StatusDraft, CreatedBy: &other}, author, domain.RoleContributor); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected forbidden listing another author's drafts, got %v", err)
+	}
+
+	page, err = svc.ListQuestions(ctx, domain.QuestionFilter{Status: domain.StatusDraft, CreatedBy: &author}, author, domain.RoleContributor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if page.Total != 1 || page.Items[0].ID != ownDraft.ID {
+		t.Fatalf("expected the author's own draft, got %d", page.Total)
+	}
+
+	page, err = svc.ListQuestions(ctx, domain.QuestionFilter{}, uuid.New(), domain.RoleModerator)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if page.Total != 3 {
+		t.Fatalf("expected moderators to see every status, got %d", page.Total)
+	}
+}
+
+func TestListQuestions_OffsetAndLimits(t *testing.T) {
+	repo := newFakeQuestionRepo()
+	for i := 0; i < 3; i++ {
+		q := domain.NewQuestion(fmt.Sprintf("Question %d", i), fmt.Sprintf("Content %d", i), "Mid", "en", "", "", "", uuid.New(), uuid.New())
+		q.Status = domain.StatusPublished
+		q.CreatedAt = q.CreatedAt.Add(time.Duration(i) * time.Minute)
+		repo.questions[q.ID] = q
+	}
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	page, err := svc.ListQuestions(ctx, domain.QuestionFilter{Limit: 2, Offset: 1}, uuid.Nil, domain.RoleUser)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if page.Total != 3 || len(page.Items) != 2 || page.Items[0].Title != "Question 1" || page.Items[1].Title != "Question 0" {
+		t.Fatalf("expected the second and third newest questions, got %+v", page.Items)
+	}
+
+	if _, err := svc.ListQuestions(ctx, domain.QuestionFilter{Limit: 1000, Offset: -5}, uuid.Nil, domain.RoleUser); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.lastFilter.Limit != maxPageSize || repo.lastFilter.Offset != 0 || repo.lastFilter.Sort != domain.SortCreatedAt {
+		t.Fatalf("expected limit capped, offset clamped and default sort, got %+v", repo.lastFilter)
+	}
+
+	if _, err := svc.ListQuestions(ctx, domain.QuestionFilter{Sort: "title"}, uuid.Nil, domain.RoleUser); err == nil {
+		t.Fatal("expected an error for an unknown sort")
+	}
+}
+
+func TestListPool_NormalizesTheFilter(t *testing.T) {
+	published := domain.NewQuestion("Goroutines", "What is a goroutine?", "Junior", "en", "", "", "", uuid.New(), uuid.New())
+	published.Status = domain.StatusPublished
+	draft := domain.NewQuestion("Select", "How does select pick a case?", "Mid", "en", "", "", "", uuid.New(), uuid.New())
+	repo := newFakeQuestionRepo(published, draft)
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	pool, err := svc.ListPool(ctx, domain.PoolFilter{Stacks: []string{" Go ", " "}, Tags: []string{"System Design", "system-design"}})
+	if err != nil || len(pool) != 1 || pool[0].ID != published.ID || pool[0].TranslationGroupID != published.TranslationGroupID {
+		t.Fatalf("expected only the published question, got %+v (%v)", pool, err)
+	}
+	got := repo.lastPool
+	if got.Language != "en" || len(got.Stacks) != 1 || got.Stacks[0] != "go" || len(got.Tags) != 1 || got.Tags[0] != "system-design" {
+		t.Fatalf("expected the default language, lowercased stacks and distinct slugs, got %+v", got)
+	}
+
+	if _, err := svc.ListPool(ctx, domain.PoolFilter{Language: "fr"}); err == nil || !strings.HasPrefix(err.Error(), "invalid pool filter") {
+		t.Fatalf("expected an unknown language to be rejected, got %v", err)
+	}
+}
+
+func TestSearchQuestions_NonPublishedStatusesNeedAModerator(t *testing.T) {
+	repo := newFakeQuestionRepo()
+	svc := NewQuestionService(repo, nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+
+	if _, err := svc.SearchQuestions(ctx, domain.SearchQuery{Text: "  "}, domain.RoleUser); err == nil {
+		t.Fatal("expected an error for empty search text")
+	}
+
+	if _, err := svc.SearchQuestions(ctx, domain.SearchQuery{Text: " goroutine ", Limit: 1000, Offset: -1, Tags: []string{" Go "}}, domain.RoleUser); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := repo.lastSearch
+	if got.Text != "goroutine" || got.Status != domain.StatusPublished || got.Limit != maxPageSize || got.Offset != 0 || len(got.Tags) != 1 || got.Tags[0] != "go" {
+		t.Fatalf("expected a trimmed, published, clamped query, got %+v", got)
+	}
+
+	for _, role := range []domain.Role{domain.RoleUser, domain.RoleContributor} {
+		for _, status := range []string{domain.StatusDraft, domain.StatusReview, domain.StatusArchived} {
+			if _, err := svc.SearchQuestions(ctx, domain.SearchQuery{Text: "goroutine", Status: status}, role); !errors.Is(err, domain.ErrForbidden) {
+				t.Fatalf("%s searching %s: expected ErrForbidden, got %v", role, status, err)
+			}
+		}
+	}
+
+	if _, err := svc.SearchQuestions(ctx, domain.SearchQuery{Text: "goroutine", Status: domain.StatusDraft}, domain.RoleModerator); err != nil {
+		t.Fatalf("expected moderators to search drafts, got %v", err)
+	}
+	if repo.lastSearch.Status != domain.StatusDraft {
+		t.Fatalf("expected the draft status to reach the repository, got %q", repo.lastSearch.Status)
+	}
+}
+
+func TestLoadDuplicatePolicy(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+
+	policy, err := domain.LoadDuplicatePolicy(env(nil))
+	if err != nil || policy != domain.DefaultDuplicatePolicy() {
+		t.Fatalf("expected the defaults, got %+v (%v)", policy, err)
+	}
+	policy, err = domain.LoadDuplicatePolicy(env(map[string]string{"DUPLICATE_WARN_THRESHOLD": "0.5", "DUPLICATE_REJECT_THRESHOLD": "0.8"}))
+	if err != nil || policy.WarnThreshold != 0.5 || policy.RejectThreshold != 0.8 {
+		t.Fatalf("expected 0.5 / 0.8, got %+v (%v)", policy, err)
+	}
+	for _, vars := range []map[string]string{
+		{"DUPLICATE_WARN_THRESHOLD": "high"},
+		{"DUPLICATE_REJECT_THRESHOLD": "0"},
+		{"DUPLICATE_REJECT_THRESHOLD": "1.5"},
+		{"DUPLICATE_WARN_THRESHOLD": "0.95"},
+	} {
+		if _, err := domain.LoadDuplicatePolicy(env(vars)); err == nil {
+			t.Errorf("expected %v to be rejected", vars)
+		}
+	}
+}
+
+func TestCreatePaths_WriteQuestionRevisionAndTagsInOneTransaction(t *testing.T) {
+	golang := domain.NewTopic("Go", "", nil, nil)
+	topics := &fakeTopicRepo{topics: map[uuid.UUID]*domain.Topic{golang.ID: golang}}
+	repo := newFakeQuestionRepo()
+	tags := newFakeTagRepo(domain.NewTag("Concurrency", ""))
+	revisions := &fakeRevisionRepo{}
+	svc := NewQuestionService(repo, topics, tags, revisions, fakeTx{}, domain.DefaultDuplicatePolicy())
+	ctx := context.Background()
+	author := uuid.New()
+
+	question, _, err := svc.CreateQuestion(ctx, "Goroutines", "What is a goroutine?", "Mid", "en", "", "", "", golang.ID, author, []string{"concurrency"}, false)
+	if err != nil {
+		t.Fatalf("CreateQuestion: %v", err)
+	}
+	if _, err := svc.CreateTranslation(ctx, question.ID, "vi", "Goroutine", "Goroutine là gì?", "", "", author); err != nil {
+		t.Fatalf("CreateTranslation: %v", err)
+	}
+	records := []domain.QuestionRecord{{Row: 1, ExternalID: "go-002", Title: "Channels", Content: "What are channels?", Level: "Mid", Topic: "Go", Tags: []string{"concurrency"}}}
+	if result, err := svc.ImportQuestions(ctx, records, author, domain.RoleModerator, false); err != nil || result.Created != 1 {
+		t.Fatalf("ImportQuestions: %+v (%v)", result, err)
+	}
+
+	channels := newCrawled("Select", "Go")
+	crawled := &fakeCrawledRepo{questions: repo, items: map[uuid.UUID]*domain.CrawledQuestion{channels.ID: channels}}
+	review := NewReviewService(crawled, repo, topics, revisions, fakeTx{}, nil, domain.DefaultDuplicatePolicy(), domain.DefaultClassificationPolicy())
+	if _, _, err := review.ApproveCrawled(ctx, channels.ID, false, author, domain.RoleModerator); err != nil {
+		t.Fatalf("ApproveCrawled: %v", err)
+	}
+
+	if len(repo.questions) != 4 || len(revisions.revisions) != 4 || len(tags.questionTags) != 3 {
+		t.Fatalf("expected 4 questions, 4 revisions and 3 tagged questions, got %d, %d and %d", len(repo.questions), len(revisions.revisions), len(tags.questionTags))
+	}
+	if repo.outsideTx != 0 || revisions.outsideTx != 0 || tags.outsideTx != 0 {
+		t.Fatalf("expected every write in a transaction, got %d questions, %d revisions and %d tag sets outside", repo.outsideTx, revisions.outsideTx, tags.outsideTx)
+	}
+}
+
+func TestGetQuestions_LeavesOutMissingAndCapsTheBatch(t *testing.T) {
+	q := domain.NewQuestion("Channels", "What are channels?", "Mid", "en", "", "", "", uuid.New(), uuid.New())
+	svc := NewQuestionService(newFakeQuestionRepo(q), nil, newFakeTagRepo(), &fakeRevisionRepo{}, fakeTx{}, domain.DefaultDuplicatePolicy())
+
+	questions, err := svc.GetQuestions(context.Background(), []uuid.UUID{q.ID, uuid.New()})
+	if err != nil || len(questions) != 1 || questions[0].ID != q.ID {
+		t.Fatalf("expected only the existing question, got %v, %v", questions, err)
+	}
+	if _, err := svc.GetQuestions(context.Background(), make([]uuid.UUID, domain.MaxBatchQuestions+1)); err == nil {
+		t.Fatal("expected an oversized batch to be rejected")
+	}
+}
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// defaultImportLanguage applies to records that leave language blank, as the seed data does
+const defaultImportLanguage = "en"
+
+// ImportQuestions creates or updates questions from file records. Records with an external ID that
+// matches an existing question (or its ID) update it; everything else is created. Each record is
+// handled on its own, so one bad row is reported without failing the rest. With dryRun every check
+// runs but nothing is written.
+func (s *questionService) ImportQuestions(ctx context.Context, records []domain.QuestionRecord, actorID uuid.UUID, actorRole domain.Role, dryRun bool) (*domain.ImportResult, error) {
+	if !actorRole.IsModerator() {
+		return nil, fmt.Errorf("%w: only moderators can import questions", domain.ErrForbidden)
+	}
+
+	result := &domain.ImportResult{DryRun: dryRun, Errors: []domain.ImportRowError{}}
+	topics := map[string]uuid.UUID{}
+	seenContent := map[string]int{}
+	seenExternalID := map[string]int{}
+
+	for i := range records {
+		rec := normalizeRecord(records[i])
+		if err := rec.Validate(); err != nil {
+			result.AddError(rec.Row, rec.ExternalID, err)
+			continue
+		}
+		if row, ok := seenContent[rec.DuplicateKey()]; ok {
+			result.AddError(rec.Row, rec.ExternalID, fmt.Errorf("duplicate content: same question as row %d", row))
+			continue
+		}
+		seenContent[rec.DuplicateKey()] = rec.Row
+		if rec.ExternalID != "" {
+			if row, ok := seenExternalID[rec.ExternalID]; ok {
+				result.AddError(rec.Row, rec.ExternalID, fmt.Errorf("duplicate external_id: already used in row %d", row))
+				continue
+			}
+			seenExternalID[rec.ExternalID] = rec.Row
+		}
+
+		topicID, ok := topics[rec.Topic]
+		if !ok {
+			topic, err := s.topicRepo.GetByName(ctx, rec.Topic)
+			if err != nil {
+				result.AddError(rec.Row, rec.ExternalID, fmt.Errorf("%w: %s", err, rec.Topic))
+				continue
+			}
+			topicID = topic.ID
+			topics[rec.Topic] = topicID
+		}
+
+		created, err := s.importRecord(ctx, rec, topicID, actorID, dryRun, seenExternalID)
+		if err != nil {
+			result.AddError(rec.Row, rec.ExternalID, err)
+			continue
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+	return result, nil
+}
+
+// normalizeRecord trims identifiers and applies the import defaults
+func normalizeRecord(rec domain.QuestionRecord) domain.QuestionRecord {
+	rec.ExternalID = strings.TrimSpace(rec.ExternalID)
+	rec.Title = strings.TrimSpace(rec.Title)
+	rec.Level = strings.TrimSpace(rec.Level)
+	rec.Language = strings.ToLower(strings.TrimSpace(rec.Language))
+	rec.Role = strings.TrimSpace(rec.Role)
+	rec.Topic = strings.TrimSpace(rec.Topic)
+	rec.Status = strings.ToLower(strings.TrimSpace(rec.Status))
+	rec.TranslationOf = strings.TrimSpace(rec.TranslationOf)
+	if rec.Language == "" {
+		rec.Language = defaultImportLanguage
+	}
+	return rec
+}
+
+// importRecord upserts one validated record and reports whether it was (or would be) created.
+// batch holds the external IDs of earlier rows, which a dry run accepts as translation_of targets.
+func (s *questionService) importRecord(ctx context.Context, rec domain.QuestionRecord, topicID, actorID uuid.UUID, dryRun bool, batch map[string]int) (bool, error) {
+	var tags []*domain.Tag
+	if rec.Tags != nil {
+		resolved, err := s.resolveTags(ctx, rec.Tags)
+		if err != nil {
+			return false, err
+		}
+		tags = resolved
+	}
+
+	var original *domain.Question
+	if rec.TranslationOf != "" {
+		question, err := s.repo.GetByExternalID(ctx, rec.TranslationOf)
+		switch {
+		case err == nil:
+			if question.Language == rec.Language {
+				return false, fmt.Errorf("invalid translation_of: %s is also in %s", rec.TranslationOf, rec.Language)
+			}
+			original = question
+		case err.Error() == "question not found" && dryRun && batch[rec.TranslationOf] > 0 && batch[rec.TranslationOf] < rec.Row:
+			// Created by an earlier row of this file in a real run
+		case err.Error() == "question not found":
+			return false, fmt.Errorf("invalid translation_of: question not found: %s", rec.TranslationOf)
+		default:
+			return false, err
+		}
+	}
+
+	var existing *domain.Question
+	if rec.ExternalID != "" {
+		question, err := s.repo.GetByExternalID(ctx, rec.ExternalID)
+		if err != nil && err.Error() != "question not found" {
+			return false, err
+		}
+		existing = question
+	}
+	if existing == nil {
+		return true, s.importCreate(ctx, rec, original, tags, topicID, actorID, dryRun)
+	}
+	return false, s.importUpdate(ctx, existing, rec, original, tags, topicID, actorID, dryRun)
+}
+
+// importCreate stores a new question, joining the translation group of original when it is set
+func (s *questionService) importCreate(ctx context.Context, rec domain.QuestionRecord, original *domain.Question, tags []*domain.Tag, topicID, actorID uuid.UUID, dryRun bool) error {
+	matches, err := s.repo.FindSimilar(ctx, rec.Title, rec.Content, rec.Language, s.duplicates.RejectThreshold, 1)
+	if err != nil {
+		return err
+	}
+	if len(matches) > 0 {
+		return fmt.Errorf("%w: %s (similarity %.2f)", domain.ErrDuplicateQuestion, matches[0].QuestionID, matches[0].Similarity)
+	}
+	if dryRun {
+		return nil
+	}
+
+	question := domain.NewQuestion(rec.Title, rec.Content, rec.Level, rec.Language, rec.Role, rec.Hint, rec.CorrectAnswer, topicID, actorID)
+	question.ExternalID = rec.ExternalID
+	if original != nil {
+		question.TranslationGroupID = original.TranslationGroupID
+	}
+	// Imports carry their final status, so a moderator may load published questions directly
+	if rec.Status != "" {
+		question.Status = rec.Status
+	}
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.createWithRevision(ctx, question, tags, actorID)
+	})
+}
+
+// importUpdate overwrites an existing question with the record. Status changes still go through the
+// state machine; tags are replaced only when the record lists them, and the translation group only
+// when the record names an original. Like UpdateQuestion, it locks the row and writes the question,
+// its revision and its tags in one transaction.
+func (s *questionService) importUpdate(ctx context.Context, question *domain.Question, rec domain.QuestionRecord, original *domain.Question, tags []*domain.Tag, topicID, actorID uuid.UUID, dryRun bool) error {
+	if dryRun {
+		_, err := s.applyRecord(ctx, question, rec, original, topicID)
+		return err
+	}
+
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		question, err := s.repo.GetByIDForUpdate(ctx, question.ID)
+		if err != nil {
+			return err
+		}
+		before := domain.NewQuestionRevision(question, actorID)
+		regroup, err := s.applyRecord(ctx, question, rec, original, topicID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.Update(ctx, question); err != nil {
+			return err
+		}
+		if regroup {
+			question.TranslationGroupID = original.TranslationGroupID
+			if err := s.repo.SetTranslationGroup(ctx, question); err != nil {
+				return err
+			}
+		}
+		after := domain.NewQuestionRevision(question, actorID)
+		if len(domain.DiffRevisions(before, after)) > 0 {
+			if err := s.revisionRepo.Create(ctx, after); err != nil {
+				return err
+			}
+		}
+		if tags != nil {
+			return s.tagRepo.SetQuestionTags(ctx, question.ID, tagIDs(tags))
+		}
+		return nil
+	})
+}
+
+// applyRecord copies the record onto question and reports whether it moves to the original's translation group
+func (s *questionService) applyRecord(ctx context.Context, question *domain.Question, rec domain.QuestionRecord, original *domain.Question, topicID uuid.UUID) (bool, error) {
+	question.Apply(domain.QuestionPatch{
+		Title:         &rec.Title,
+		Content:       &rec.Content,
+		Level:         &rec.Level,
+		Language:      &rec.Language,
+		Role:          &rec.Role,
+		Hint:          &rec.Hint,
+		CorrectAnswer: &rec.CorrectAnswer,
+		TopicID:       &topicID,
+	})
+	if rec.Status != "" {
+		if err := question.TransitionTo(rec.Status); err != nil {
+			return false, err
+		}
+	}
+	// Re-imported exports identify questions by ID; keep that out of external_id
+	if rec.ExternalID != question.ID.String() {
+		question.ExternalID = rec.ExternalID
+	}
+	regroup := original != nil && original.TranslationGroupID != question.TranslationGroupID
+	if regroup {
+		if err := s.checkStandalone(ctx, question); err != nil {
+			return false, err
+		}
+	}
+	return regroup, nil
+}
+
+// ExportQuestions returns every question matching the filter as file records, oldest first.
+// Questions without an external ID are exported under their ID so a re-import updates them.
+func (s *questionService) ExportQuestions(ctx context.Context, filter domain.QuestionFilter) ([]domain.QuestionRecord, error) {
+	topics, err := s.topicRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	topicNames := make(map[uuid.UUID]string, len(topics))
+	for _, t := range topics {
+		topicNames[t.ID] = t.Name
+	}
+
+	filter.Sort = domain.SortCreatedAt
+	filter.Ascending = true
+	filter.Cursor = ""
+	filter.Limit = maxPageSize
+	filter.Tags = normalizeTagSlugs(filter.Tags)
+
+	// translationOf caches the record ID of each translation group's canonical question
+	translationOf := map[uuid.UUID]string{}
+	records := []domain.QuestionRecord{}
+	for {
+		page, err := s.repo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.attachTags(ctx, page.Items...); err != nil {
+			return nil, err
+		}
+		for _, q := range page.Items {
+			rec := toRecord(q, topicNames[q.TopicID])
+			if !q.IsCanonical() {
+				if rec.TranslationOf, err = s.canonicalRecordID(ctx, q.TranslationGroupID, translationOf); err != nil {
+					return nil, err
+				}
+			}
+			records = append(records, rec)
+		}
+		if page.NextCursor == "" {
+			return records, nil
+		}
+		filter.Cursor = page.NextCursor
+	}
+}
+
+// canonicalRecordID returns the record ID of a translation group's canonical question, or "" when
+// the canonical question has been deleted
+func (s *questionService) canonicalRecordID(ctx context.Context, groupID uuid.UUID, cache map[uuid.UUID]string) (string, error) {
+	if id, ok := cache[groupID]; ok {
+		return id, nil
+	}
+	canonical, err := s.repo.GetByID(ctx, groupID)
+	if err != nil && err.Error() != "question not found" {
+		return "", err
+	}
+	id := ""
+	if canonical != nil {
+		id = recordID(canonical)
+	}
+	cache[groupID] = id
+	return id, nil
+}
+
+// recordID identifies a question in transfer files: its external ID, or its ID when it has none
+func recordID(q *domain.Question) string {
+	if q.ExternalID != "" {
+		return q.ExternalID
+	}
+	return q.ID.String()
+}
+
+func toRecord(q *domain.Question, topicName string) domain.QuestionRecord {
+	externalID := recordID(q)
+	tags := make([]string, len(q.Tags))
+	for i, t := range q.Tags {
+		tags[i] = t.Slug
+	}
+	return domain.QuestionRecord{
+		ExternalID:    externalID,
+		Title:         q.Title,
+		Content:       q.Content,
+		Level:         q.Level,
+		Language:      q.Language,
+		Role:          q.Role,
+		Topic:         topicName,
+		Status:        q.Status,
+		Tags:          tags,
+		Hint:          q.Hint,
+		CorrectAnswer: q.CorrectAnswer,
+	}
+}
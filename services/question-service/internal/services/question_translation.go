@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/question-interviewer/question-service/internal/domain"
+)
+
+// ListTranslations returns every language version of a question, canonical first
+func (s *questionService) ListTranslations(ctx context.Context, questionID uuid.UUID) ([]*domain.Question, error) {
+	question, err := s.repo.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	translations, err := s.repo.ListTranslations(ctx, question.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, translations...); err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+// CreateTranslation stores a draft of the question in another language. The draft joins the question's
+// translation group and copies its topic, level, role and tags; near-duplicates in the target language are refused.
+func (s *questionService) CreateTranslation(ctx context.Context, questionID uuid.UUID, language, title, content, hint, correctAnswer string, actorID uuid.UUID) (*domain.Question, error) {
+	source, err := s.repo.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	language = strings.ToLower(strings.TrimSpace(language))
+	if !domain.IsValidLanguage(language) {
+		return nil, fmt.Errorf("invalid translation: language %s (expected en or vi)", language)
+	}
+	if language == source.Language {
+		return nil, fmt.Errorf("invalid translation: language must differ from the question's (%s)", source.Language)
+	}
+
+	matches, err := s.repo.FindSimilar(ctx, title, content, language, s.duplicates.RejectThreshold, maxDuplicateMatches)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) > 0 {
+		return nil, &domain.DuplicateError{Matches: matches}
+	}
+
+	if err := s.attachTags(ctx, source); err != nil {
+		return nil, err
+	}
+	translation := source.NewTranslation(language, title, content, hint, correctAnswer, actorID)
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.createWithRevision(ctx, translation, source.Tags, actorID)
+	}); err != nil {
+		return nil, err
+	}
+	translation.Tags = source.Tags
+	return translation, nil
+}
+
+// LinkTranslation adds an existing question to another question's translation group. Only a question
+// without translations of its own can be linked, and the caller must be allowed to edit it.
+func (s *questionService) LinkTranslation(ctx context.Context, questionID, translationID, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error) {
+	question, err := s.repo.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	translation, err := s.repo.GetByID(ctx, translationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanEdit(translation, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	if translation.TranslationGroupID == question.TranslationGroupID {
+		return translation, s.attachTags(ctx, translation)
+	}
+	if translation.Language == question.Language {
+		return nil, translationExistsError(translation.Language)
+	}
+
+	if err := s.checkStandalone(ctx, translation); err != nil {
+		return nil, err
+	}
+
+	translation.TranslationGroupID = question.TranslationGroupID
+	if err := s.repo.SetTranslationGroup(ctx, translation); err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, translation); err != nil {
+		return nil, err
+	}
+	return translation, nil
+}
+
+// UnlinkTranslation detaches a translation from the question's group, making it canonical in a group of
+// its own. A canonical question cannot leave while the group still has other members.
+func (s *questionService) UnlinkTranslation(ctx context.Context, questionID, translationID, actorID uuid.UUID, actorRole domain.Role) (*domain.Question, error) {
+	question, err := s.repo.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	translation, err := s.repo.GetByID(ctx, translationID)
+	if err != nil {
+		return nil, err
+	}
+	if translation.TranslationGroupID != question.TranslationGroupID {
+		return nil, fmt.Errorf("question not found: %s is not a translation of %s", translationID, questionID)
+	}
+	if err := checkCanEdit(translation, actorID, actorRole); err != nil {
+		return nil, err
+	}
+	if translation.IsCanonical() {
+		members, err := s.repo.ListTranslations(ctx, translation.TranslationGroupID)
+		if err != nil {
+			return nil, err
+		}
+		if len(members) > 1 {
+			return nil, fmt.Errorf("translation group conflict: the canonical question cannot leave a group that has translations")
+		}
+		return translation, s.attachTags(ctx, translation)
+	}
+
+	translation.TranslationGroupID = translation.ID
+	if err := s.repo.SetTranslationGroup(ctx, translation); err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, translation); err != nil {
+		return nil, err
+	}
+	return translation, nil
+}
+
+// checkStandalone refuses to move a question that shares its translation group with others,
+// so moving it cannot strand or split existing translations
+func (s *questionService) checkStandalone(ctx context.Context, question *domain.Question) error {
+	members, err := s.repo.ListTranslations(ctx, question.TranslationGroupID)
+	if err != nil {
+		return err
+	}
+	if len(members) > 1 {
+		return fmt.Errorf("translation group conflict: question already has translations; unlink them first")
+	}
+	return nil
+}
+
+func translationExistsError(language string) error {
+	return fmt.Errorf("translation already exists for language: %s", language)
+}